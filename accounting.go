@@ -0,0 +1,178 @@
+package loop
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+)
+
+// ReportPeriod is the length of the periods that an accounting report is
+// aggregated by.
+type ReportPeriod uint8
+
+const (
+	// ReportPeriodDay aggregates swaps by calendar day.
+	ReportPeriodDay ReportPeriod = iota
+
+	// ReportPeriodWeek aggregates swaps by week, starting on Monday.
+	ReportPeriodWeek
+
+	// ReportPeriodMonth aggregates swaps by calendar month.
+	ReportPeriodMonth
+)
+
+// String returns a string representation of the report period.
+func (p ReportPeriod) String() string {
+	switch p {
+	case ReportPeriodDay:
+		return "day"
+
+	case ReportPeriodWeek:
+		return "week"
+
+	case ReportPeriodMonth:
+		return "month"
+
+	default:
+		return "unknown"
+	}
+}
+
+// start returns the start of the period that contains the given time. The
+// periods are aligned to UTC.
+func (p ReportPeriod) start(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+
+	switch p {
+	case ReportPeriodWeek:
+		daysSinceMonday := (int(t.UTC().Weekday()) + 6) % 7
+		return time.Date(
+			year, month, day-daysSinceMonday, 0, 0, 0, 0, time.UTC,
+		)
+
+	case ReportPeriodMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// AccountingPeriod holds the aggregated volume and fees of the swaps that
+// completed in one period.
+type AccountingPeriod struct {
+	// Start is the start of the period in UTC.
+	Start time.Time
+
+	// SuccessCount is the number of swaps that succeeded in the period.
+	SuccessCount int
+
+	// FailureCount is the number of swaps that failed in the period.
+	FailureCount int
+
+	// LoopOutVolume is the amount that was swapped by successful loop
+	// outs.
+	LoopOutVolume btcutil.Amount
+
+	// LoopInVolume is the amount that was swapped by successful loop ins.
+	LoopInVolume btcutil.Amount
+
+	// SwapFees is the amount that was paid to the server. This includes
+	// prepayments that were lost with failed loop outs.
+	SwapFees btcutil.Amount
+
+	// MinerFees is the amount that was paid in on-chain fees.
+	MinerFees btcutil.Amount
+
+	// RoutingFees is the amount that was paid in off-chain routing fees.
+	RoutingFees btcutil.Amount
+}
+
+// TotalFees returns the sum of all fees that were paid in the period.
+func (a *AccountingPeriod) TotalFees() btcutil.Amount {
+	return a.SwapFees + a.MinerFees + a.RoutingFees
+}
+
+// AccountingReport aggregates the volume and fees of the completed swaps by
+// the given period, so that the costs of liquidity management can be
+// tracked. Swaps are counted in the period in which they reached their final
+// state. A zero start or end time doesn't restrict the report. The periods
+// without completed swaps are omitted.
+func (s *Client) AccountingReport(ctx context.Context, period ReportPeriod,
+	start, end time.Time) ([]*AccountingPeriod, error) {
+
+	if period > ReportPeriodMonth {
+		return nil, fmt.Errorf("unknown report period: %v", period)
+	}
+
+	swaps, err := s.FetchSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountingReport(swaps, period, start, end), nil
+}
+
+// accountingReport aggregates the completed swaps that were finalized
+// between start and end by the given period.
+func accountingReport(swaps []*SwapInfo, period ReportPeriod, start,
+	end time.Time) []*AccountingPeriod {
+
+	periods := make(map[time.Time]*AccountingPeriod)
+	for _, swp := range swaps {
+		stateType := swp.State.Type()
+		if stateType == loopdb.StateTypePending {
+			continue
+		}
+
+		if !start.IsZero() && swp.LastUpdate.Before(start) {
+			continue
+		}
+
+		if !end.IsZero() && !swp.LastUpdate.Before(end) {
+			continue
+		}
+
+		periodStart := period.start(swp.LastUpdate)
+		aggregate, ok := periods[periodStart]
+		if !ok {
+			aggregate = &AccountingPeriod{
+				Start: periodStart,
+			}
+			periods[periodStart] = aggregate
+		}
+
+		switch {
+		case stateType == loopdb.StateTypeFail:
+			aggregate.FailureCount++
+
+		case swp.SwapType == swap.TypeOut:
+			aggregate.SuccessCount++
+			aggregate.LoopOutVolume += swp.AmountRequested
+
+		default:
+			aggregate.SuccessCount++
+			aggregate.LoopInVolume += swp.AmountRequested
+		}
+
+		aggregate.SwapFees += swp.Cost.Server
+		aggregate.MinerFees += swp.Cost.Onchain
+		aggregate.RoutingFees += swp.Cost.Offchain
+	}
+
+	report := make([]*AccountingPeriod, 0, len(periods))
+	for _, aggregate := range periods {
+		report = append(report, aggregate)
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Start.Before(report[j].Start)
+	})
+
+	return report
+}
@@ -0,0 +1,100 @@
+package loop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountingReport tests that the completed swaps are aggregated by the
+// period in which they reached their final state.
+func TestAccountingReport(t *testing.T) {
+	newSwap := func(swapType swap.Type, state loopdb.SwapState,
+		lastUpdate time.Time, amt btcutil.Amount) *SwapInfo {
+
+		info := &SwapInfo{
+			SwapType:   swapType,
+			LastUpdate: lastUpdate,
+		}
+		info.State = state
+		info.AmountRequested = amt
+		info.Cost = loopdb.SwapCost{
+			Server:   10,
+			Onchain:  20,
+			Offchain: 1,
+		}
+
+		return info
+	}
+
+	// Wednesday, 2023-03-01.
+	wednesday := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	nextMonday := wednesday.Add(5 * 24 * time.Hour)
+
+	swaps := []*SwapInfo{
+		newSwap(swap.TypeOut, loopdb.StateSuccess, wednesday, 1000),
+		newSwap(
+			swap.TypeIn, loopdb.StateSuccess,
+			wednesday.Add(time.Hour), 2000,
+		),
+		newSwap(
+			swap.TypeOut, loopdb.StateFailTimeout,
+			wednesday.Add(24*time.Hour), 3000,
+		),
+		newSwap(swap.TypeOut, loopdb.StateSuccess, nextMonday, 4000),
+
+		// Pending swaps are left out.
+		newSwap(
+			swap.TypeOut, loopdb.StateHtlcPublished, wednesday,
+			5000,
+		),
+	}
+
+	report := accountingReport(swaps, ReportPeriodDay, time.Time{},
+		time.Time{})
+	require.Len(t, report, 3)
+	require.Equal(t, &AccountingPeriod{
+		Start:         time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+		SuccessCount:  2,
+		LoopOutVolume: 1000,
+		LoopInVolume:  2000,
+		SwapFees:      20,
+		MinerFees:     40,
+		RoutingFees:   2,
+	}, report[0])
+	require.Equal(t, 1, report[1].FailureCount)
+	require.Equal(t, btcutil.Amount(31), report[1].TotalFees())
+
+	// The weeks start on Monday.
+	report = accountingReport(swaps, ReportPeriodWeek, time.Time{},
+		time.Time{})
+	require.Len(t, report, 2)
+	require.Equal(
+		t, time.Date(2023, 2, 27, 0, 0, 0, 0, time.UTC),
+		report[0].Start,
+	)
+	require.Equal(t, 3, report[0].SuccessCount+report[0].FailureCount)
+	require.Equal(
+		t, time.Date(2023, 3, 6, 0, 0, 0, 0, time.UTC),
+		report[1].Start,
+	)
+
+	report = accountingReport(swaps, ReportPeriodMonth, time.Time{},
+		time.Time{})
+	require.Len(t, report, 1)
+	require.Equal(t, btcutil.Amount(5000), report[0].LoopOutVolume)
+	require.Equal(t, btcutil.Amount(124), report[0].TotalFees())
+
+	// The time range restricts the swaps by their completion time.
+	report = accountingReport(
+		swaps, ReportPeriodMonth, wednesday.Add(time.Minute),
+		nextMonday,
+	)
+	require.Len(t, report, 1)
+	require.Equal(t, 1, report[0].SuccessCount)
+	require.Equal(t, 1, report[0].FailureCount)
+}
@@ -0,0 +1,131 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// bip21Scheme is the scheme of BIP21 payment URIs.
+const bip21Scheme = "bitcoin:"
+
+var (
+	// ErrDestAmountMismatch is returned when a swap is requested for a
+	// different amount than the amount of the destination's BIP21 URI.
+	ErrDestAmountMismatch = errors.New("swap amount doesn't match the " +
+		"amount of the destination URI")
+)
+
+// ParseDestination parses a swap destination, which is either a plain address
+// or a BIP21 URI such as bitcoin:<address>?amount=<btc>. The address must be
+// for the given network. The returned amount is the URI's amount, or zero if
+// the destination doesn't specify one.
+func ParseDestination(dest string, params *chaincfg.Params) (btcutil.Address,
+	btcutil.Amount, error) {
+
+	if len(dest) < len(bip21Scheme) ||
+		!strings.EqualFold(dest[:len(bip21Scheme)], bip21Scheme) {
+
+		addr, err := decodeAddress(dest, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return addr, 0, nil
+	}
+
+	addrPart, query, _ := strings.Cut(dest[len(bip21Scheme):], "?")
+
+	addr, err := decodeAddress(addrPart, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, 0, fmt.Errorf("parse uri parameters: %v", err)
+	}
+
+	var amount btcutil.Amount
+	for key, value := range values {
+		switch {
+		case key == "amount":
+			amount, err = parseBIP21Amount(value[0])
+			if err != nil {
+				return nil, 0, err
+			}
+
+		// Required parameters that we don't understand make the URI
+		// invalid, optional ones such as label and message are ignored.
+		case strings.HasPrefix(key, "req-"):
+			return nil, 0, fmt.Errorf("unsupported required uri "+
+				"parameter %v", key)
+		}
+	}
+
+	return addr, amount, nil
+}
+
+// decodeAddress decodes an address and checks that it is for the given
+// network. The decoder itself accepts segwit addresses of any network.
+func decodeAddress(addr string, params *chaincfg.Params) (btcutil.Address,
+	error) {
+
+	decoded, err := btcutil.DecodeAddress(addr, params)
+	if err != nil {
+		return nil, fmt.Errorf("decode address: %v", err)
+	}
+
+	if !decoded.IsForNet(params) {
+		return nil, fmt.Errorf("%w: %v, active network is %v",
+			ErrDestAddrWrongNetwork, addr, params.Name)
+	}
+
+	return decoded, nil
+}
+
+// parseBIP21Amount parses a BIP21 amount, which is a decimal amount in bitcoin.
+func parseBIP21Amount(value string) (btcutil.Amount, error) {
+	btc, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid uri amount %v: %v", value, err)
+	}
+
+	amount, err := btcutil.NewAmount(btc)
+	if err != nil {
+		return 0, fmt.Errorf("invalid uri amount %v: %v", value, err)
+	}
+
+	if amount <= 0 {
+		return 0, fmt.Errorf("invalid uri amount %v", value)
+	}
+
+	return amount, nil
+}
+
+// DestinationAmount returns the swap amount for a requested amount and the
+// amount of the destination URI. The URI amount is used if no amount is
+// requested, otherwise both have to match.
+func DestinationAmount(requested, uriAmount btcutil.Amount) (btcutil.Amount,
+	error) {
+
+	switch {
+	case uriAmount == 0:
+		return requested, nil
+
+	case requested == 0:
+		return uriAmount, nil
+
+	case requested != uriAmount:
+		return 0, fmt.Errorf("%w: requested %v, uri %v",
+			ErrDestAmountMismatch, requested, uriAmount)
+
+	default:
+		return requested, nil
+	}
+}
@@ -0,0 +1,92 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseDestination tests parsing plain addresses and BIP21 URIs as swap
+// destinations.
+func TestParseDestination(t *testing.T) {
+	params := &chaincfg.TestNet3Params
+
+	addr, err := btcutil.NewAddressTaproot(make([]byte, 32), params)
+	require.NoError(t, err)
+	encoded := addr.EncodeAddress()
+
+	tests := []struct {
+		name   string
+		dest   string
+		amount btcutil.Amount
+		err    bool
+	}{
+		{
+			name: "plain address",
+			dest: encoded,
+		},
+		{
+			name: "uri without amount",
+			dest: "bitcoin:" + encoded,
+		},
+		{
+			name:   "uri with amount",
+			dest:   "BITCOIN:" + encoded + "?amount=0.0025&label=x",
+			amount: 250_000,
+		},
+		{
+			name: "invalid amount",
+			dest: "bitcoin:" + encoded + "?amount=-1",
+			err:  true,
+		},
+		{
+			name: "unknown required parameter",
+			dest: "bitcoin:" + encoded + "?req-unknown=1",
+			err:  true,
+		},
+		{
+			name: "wrong network",
+			dest: "bitcoin:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			destAddr, amount, err := ParseDestination(
+				test.dest, params,
+			)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, encoded, destAddr.EncodeAddress())
+			require.Equal(t, test.amount, amount)
+		})
+	}
+}
+
+// TestDestinationAmount tests that the URI amount is used as the swap amount
+// and that it has to match a requested amount.
+func TestDestinationAmount(t *testing.T) {
+	amount, err := DestinationAmount(1000, 0)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(1000), amount)
+
+	amount, err = DestinationAmount(0, 2000)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(2000), amount)
+
+	amount, err = DestinationAmount(2000, 2000)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(2000), amount)
+
+	_, err = DestinationAmount(1000, 2000)
+	require.ErrorIs(t, err, ErrDestAmountMismatch)
+}
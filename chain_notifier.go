@@ -0,0 +1,652 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// ErrTooManyChainSubscriptions is returned when a new swap is requested while
+// the swaps hold the maximum number of subscriptions that are open with lnd at
+// the same time.
+var ErrTooManyChainSubscriptions = errors.New("maximum number of chain " +
+	"subscriptions reached")
+
+// epochSubscriber is a subscriber to the shared block epoch subscription.
+type epochSubscriber struct {
+	// queue buffers the block heights for the subscriber, so that a slow
+	// subscriber doesn't hold back the others.
+	queue *queue.ConcurrentQueue
+
+	// errChan receives the error of the shared subscription, if it fails.
+	errChan chan error
+
+	// quit is closed when the shared subscription fails, which ends the
+	// subscription of the subscriber.
+	quit chan struct{}
+}
+
+// confKey identifies the confirmation registrations that can share a
+// subscription to lnd.
+type confKey struct {
+	txid     chainhash.Hash
+	pkScript string
+	numConfs int32
+}
+
+// spendKey identifies the spend registrations that can share a subscription
+// to lnd.
+type spendKey struct {
+	outpoint wire.OutPoint
+	pkScript string
+}
+
+// confRegistration is a shared confirmation registration.
+type confRegistration = sharedRegistration[*chainntnfs.TxConfirmation]
+
+// spendRegistration is a shared spend registration.
+type spendRegistration = sharedRegistration[*chainntnfs.SpendDetail]
+
+// sharedChainNotifier wraps lnd's chain notifier so that all callers share a
+// single block epoch subscription to lnd, and registrations that watch for the
+// same confirmation or spend share a single subscription too. The number of
+// confirmation and spend subscriptions that are open with lnd at the same time
+// can be bounded. Registrations are never refused, as a swap that runs must be
+// able to watch the chain, so the limit is checked before a new swap starts.
+type sharedChainNotifier struct {
+	notifier lndclient.ChainNotifierClient
+
+	// maxSubscriptions is the maximum number of open confirmation and
+	// spend subscriptions that count against the limit. Zero doesn't
+	// bound the number of subscriptions.
+	maxSubscriptions int
+
+	// openSubscriptions is the number of open confirmation and spend
+	// subscriptions that count against the limit.
+	openSubscriptions int
+
+	// confRegistrations are the shared confirmation registrations.
+	confRegistrations map[confKey]*confRegistration
+
+	// spendRegistrations are the shared spend registrations.
+	spendRegistrations map[spendKey]*spendRegistration
+
+	// subscribers are the subscribers to the shared block epoch
+	// subscription by subscriber id.
+	subscribers map[int]*epochSubscriber
+
+	// nextID is the id of the next block epoch subscriber.
+	nextID int
+
+	// bestHeight is the last height that the shared block epoch
+	// subscription delivered. It is only set if haveHeight is true.
+	bestHeight int32
+	haveHeight bool
+
+	// cancelEpochs cancels the shared block epoch subscription. It is nil
+	// if there is no shared subscription.
+	cancelEpochs func()
+
+	mu sync.Mutex
+}
+
+// Compile-time check that sharedChainNotifier implements the lndclient
+// interface.
+var _ lndclient.ChainNotifierClient = (*sharedChainNotifier)(nil)
+
+// newSharedChainNotifier returns a chain notifier that shares the
+// subscriptions to the given notifier and allows at most maxSubscriptions open
+// confirmation and spend subscriptions. Zero doesn't bound the number of
+// subscriptions.
+func newSharedChainNotifier(notifier lndclient.ChainNotifierClient,
+	maxSubscriptions int) *sharedChainNotifier {
+
+	return &sharedChainNotifier{
+		notifier:           notifier,
+		maxSubscriptions:   maxSubscriptions,
+		confRegistrations:  make(map[confKey]*confRegistration),
+		spendRegistrations: make(map[spendKey]*spendRegistration),
+		subscribers:        make(map[int]*epochSubscriber),
+	}
+}
+
+// RegisterBlockEpochNtfn registers for block notifications through the
+// shared block epoch subscription, which is started if there is none yet.
+// Like for a subscription to lnd, the current height is delivered first.
+func (s *sharedChainNotifier) RegisterBlockEpochNtfn(ctx context.Context) (
+	chan int32, chan error, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancelEpochs == nil {
+		epochCtx, cancel := context.WithCancel(context.Background())
+		blockChan, errChan, err := s.notifier.RegisterBlockEpochNtfn(
+			epochCtx,
+		)
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+
+		s.cancelEpochs = cancel
+		go s.dispatchEpochs(epochCtx, blockChan, errChan)
+	}
+
+	subscriber := &epochSubscriber{
+		queue:   queue.NewConcurrentQueue(10),
+		errChan: make(chan error, 1),
+		quit:    make(chan struct{}),
+	}
+	subscriber.queue.Start()
+
+	if s.haveHeight {
+		subscriber.queue.ChanIn() <- s.bestHeight
+	}
+
+	id := s.nextID
+	s.nextID++
+	s.subscribers[id] = subscriber
+
+	blockChan := make(chan int32)
+	forwardQueue(ctx, subscriber.queue, blockChan, subscriber.quit,
+		func() {
+			s.unsubscribe(id, subscriber)
+		},
+	)
+
+	return blockChan, subscriber.errChan, nil
+}
+
+// dispatchEpochs delivers the block heights of the shared block epoch
+// subscription to all subscribers. If the subscription fails, the error is
+// delivered to all subscribers and the next registration starts a new
+// subscription.
+func (s *sharedChainNotifier) dispatchEpochs(ctx context.Context,
+	blockChan chan int32, errChan chan error) {
+
+	for {
+		select {
+		case height := <-blockChan:
+			s.mu.Lock()
+			s.bestHeight = height
+			s.haveHeight = true
+			for _, subscriber := range s.subscribers {
+				subscriber.queue.ChanIn() <- height
+			}
+			s.mu.Unlock()
+
+		case err := <-errChan:
+			s.mu.Lock()
+			for _, subscriber := range s.subscribers {
+				subscriber.errChan <- err
+				close(subscriber.quit)
+			}
+
+			// The subscribers are done with the failed
+			// subscription, so we drop them here and leave it to
+			// them to register again. Their forwarding ends with
+			// the quit signal.
+			s.subscribers = make(map[int]*epochSubscriber)
+			s.haveHeight = false
+			s.cancelEpochs()
+			s.cancelEpochs = nil
+			s.mu.Unlock()
+
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// unsubscribe removes a block epoch subscriber and cancels the shared
+// subscription once it has no subscribers left. A subscriber that was already
+// dropped because the shared subscription failed is only stopped.
+func (s *sharedChainNotifier) unsubscribe(id int,
+	subscriber *epochSubscriber) {
+
+	s.mu.Lock()
+	delete(s.subscribers, id)
+	if len(s.subscribers) == 0 && s.cancelEpochs != nil {
+		s.cancelEpochs()
+		s.cancelEpochs = nil
+		s.haveHeight = false
+	}
+	s.mu.Unlock()
+
+	// The subscriber is removed, so no heights are added to its queue
+	// anymore and it's safe to stop it.
+	subscriber.queue.Stop()
+}
+
+// checkLimit returns ErrTooManyChainSubscriptions if the subscriptions that
+// count against the limit are all taken. It is checked before a new swap is
+// started, so that the swaps that run already keep all their subscriptions.
+// A nil notifier doesn't bound the subscriptions.
+func (s *sharedChainNotifier) checkLimit() error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.maxSubscriptions > 0 &&
+		s.openSubscriptions >= s.maxSubscriptions {
+
+		return ErrTooManyChainSubscriptions
+	}
+
+	return nil
+}
+
+// reserveSubscription counts a new subscription against the limit if bounded
+// is true. The returned function releases the subscription. The mutex must be
+// held.
+func (s *sharedChainNotifier) reserveSubscription(bounded bool) func() {
+	if !bounded {
+		return func() {}
+	}
+
+	s.openSubscriptions++
+
+	return func() {
+		s.openSubscriptions--
+	}
+}
+
+// releaseOnCancel releases a subscription once its context is canceled. lnd
+// closes the stream of a subscription with its context, and keeps the streams
+// of confirmation subscriptions that are notified about reorgs open after the
+// confirmation, so the context is the only reliable end of a subscription.
+func (s *sharedChainNotifier) releaseOnCancel(ctx context.Context,
+	release func()) {
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		release()
+		s.mu.Unlock()
+	}()
+}
+
+// RegisterConfirmationsNtfn registers for a confirmation notification. The
+// subscription counts against the limit of subscriptions, but is made even if
+// the limit is reached.
+func (s *sharedChainNotifier) RegisterConfirmationsNtfn(ctx context.Context,
+	txid *chainhash.Hash, pkScript []byte, numConfs, heightHint int32,
+	opts ...lndclient.NotifierOption) (chan *chainntnfs.TxConfirmation,
+	chan error, error) {
+
+	return s.registerConfirmations(
+		ctx, txid, pkScript, numConfs, heightHint, true, opts...,
+	)
+}
+
+// RegisterSpendNtfn registers for a spend notification. The subscription
+// counts against the limit of subscriptions, but is made even if the limit is
+// reached.
+func (s *sharedChainNotifier) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte, heightHint int32) (
+	chan *chainntnfs.SpendDetail, chan error, error) {
+
+	return s.registerSpend(ctx, outpoint, pkScript, heightHint, true)
+}
+
+// registerConfirmations joins the shared confirmation registration for the
+// transaction, or registers with lnd if there is none. Registrations with
+// notifier options, such as a reorg channel, are passed to lnd as they are,
+// because lndclient doesn't expose the options to forward them. If bounded is
+// true, a new subscription counts against the limit of subscriptions.
+func (s *sharedChainNotifier) registerConfirmations(ctx context.Context,
+	txid *chainhash.Hash, pkScript []byte, numConfs, heightHint int32,
+	bounded bool, opts ...lndclient.NotifierOption) (
+	chan *chainntnfs.TxConfirmation, chan error, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := confKey{
+		pkScript: string(pkScript),
+		numConfs: numConfs,
+	}
+	if txid != nil {
+		key.txid = *txid
+	}
+
+	// A registration with a later height hint would miss confirmations
+	// before it, so we only join registrations with an earlier one.
+	reg, ok := s.confRegistrations[key]
+	if ok && len(opts) == 0 && reg.heightHint <= heightHint {
+		confChan, errChan := reg.subscribe(ctx)
+		return confChan, errChan, nil
+	}
+
+	release := s.reserveSubscription(bounded)
+
+	if len(opts) != 0 {
+		confChan, errChan, err := s.notifier.RegisterConfirmationsNtfn(
+			ctx, txid, pkScript, numConfs, heightHint, opts...,
+		)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+		s.releaseOnCancel(ctx, release)
+
+		return confChan, errChan, nil
+	}
+
+	regCtx, cancel := context.WithCancel(context.Background())
+	lndConfChan, lndErrChan, err := s.notifier.RegisterConfirmationsNtfn(
+		regCtx, txid, pkScript, numConfs, heightHint,
+	)
+	if err != nil {
+		cancel()
+		release()
+		return nil, nil, err
+	}
+
+	reg = newSharedRegistration[*chainntnfs.TxConfirmation](
+		s, heightHint, func() {
+			cancel()
+			release()
+		},
+	)
+
+	// If there is a registration with a later height hint, the new one
+	// isn't shared.
+	if !ok {
+		s.confRegistrations[key] = reg
+		reg.remove = func() {
+			delete(s.confRegistrations, key)
+		}
+	}
+	go reg.dispatch(regCtx, lndConfChan, lndErrChan)
+
+	confChan, errChan := reg.subscribe(ctx)
+
+	return confChan, errChan, nil
+}
+
+// registerSpend joins the shared spend registration for the outpoint, or
+// registers with lnd if there is none. If bounded is true, a new subscription
+// counts against the limit of subscriptions.
+func (s *sharedChainNotifier) registerSpend(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte, heightHint int32,
+	bounded bool) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := spendKey{
+		pkScript: string(pkScript),
+	}
+	if outpoint != nil {
+		key.outpoint = *outpoint
+	}
+
+	reg, ok := s.spendRegistrations[key]
+	if ok && reg.heightHint <= heightHint {
+		spendChan, errChan := reg.subscribe(ctx)
+		return spendChan, errChan, nil
+	}
+
+	release := s.reserveSubscription(bounded)
+
+	regCtx, cancel := context.WithCancel(context.Background())
+	lndSpendChan, lndErrChan, err := s.notifier.RegisterSpendNtfn(
+		regCtx, outpoint, pkScript, heightHint,
+	)
+	if err != nil {
+		cancel()
+		release()
+		return nil, nil, err
+	}
+
+	reg = newSharedRegistration[*chainntnfs.SpendDetail](
+		s, heightHint, func() {
+			cancel()
+			release()
+		},
+	)
+	if !ok {
+		s.spendRegistrations[key] = reg
+		reg.remove = func() {
+			delete(s.spendRegistrations, key)
+		}
+	}
+	go reg.dispatch(regCtx, lndSpendChan, lndErrChan)
+
+	spendChan, errChan := reg.subscribe(ctx)
+
+	return spendChan, errChan, nil
+}
+
+// withoutLimit returns a view of the notifier whose confirmation and spend
+// subscriptions share the subscriptions of the notifier, but don't count
+// against its limit. It is meant for callers whose subscriptions shouldn't
+// hold back new swaps, such as the sweep batcher.
+func (s *sharedChainNotifier) withoutLimit() lndclient.ChainNotifierClient {
+	return &unboundedChainNotifier{
+		sharedChainNotifier: s,
+	}
+}
+
+// unboundedChainNotifier is a view of a shared chain notifier whose
+// subscriptions don't count against the limit of subscriptions.
+type unboundedChainNotifier struct {
+	*sharedChainNotifier
+}
+
+// RegisterConfirmationsNtfn registers for a confirmation notification without
+// counting against the limit of subscriptions.
+func (u *unboundedChainNotifier) RegisterConfirmationsNtfn(
+	ctx context.Context, txid *chainhash.Hash, pkScript []byte, numConfs,
+	heightHint int32, opts ...lndclient.NotifierOption) (
+	chan *chainntnfs.TxConfirmation, chan error, error) {
+
+	return u.registerConfirmations(
+		ctx, txid, pkScript, numConfs, heightHint, false, opts...,
+	)
+}
+
+// RegisterSpendNtfn registers for a spend notification without counting
+// against the limit of subscriptions.
+func (u *unboundedChainNotifier) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte, heightHint int32) (
+	chan *chainntnfs.SpendDetail, chan error, error) {
+
+	return u.registerSpend(ctx, outpoint, pkScript, heightHint, false)
+}
+
+// chainSubscriber is a subscriber to a shared confirmation or spend
+// registration.
+type chainSubscriber struct {
+	// queue buffers the notifications and the error of the registration
+	// for the subscriber, so that a slow subscriber doesn't hold back the
+	// others.
+	queue *queue.ConcurrentQueue
+}
+
+// sharedRegistration is a confirmation or spend registration with lnd that is
+// shared by all subscribers that watch for the same event. T is the type of
+// its notifications.
+type sharedRegistration[T any] struct {
+	notifier *sharedChainNotifier
+
+	// heightHint is the height hint that the registration was made with.
+	heightHint int32
+
+	// subscribers are the subscribers of the registration by subscriber
+	// id.
+	subscribers map[int]*chainSubscriber
+
+	// nextID is the id of the next subscriber.
+	nextID int
+
+	// notification is the last notification of the registration, which is
+	// delivered to subscribers that join later. It is only set if notified
+	// is true.
+	notification T
+	notified     bool
+
+	// cancel ends the registration with lnd and releases its
+	// subscription.
+	cancel func()
+
+	// remove removes the registration from the notifier, so that no more
+	// subscribers join it. It is nil if the registration isn't shared.
+	remove func()
+
+	// closed is true once the registration ended.
+	closed bool
+}
+
+// newSharedRegistration returns a registration without subscribers that is
+// ended with the given cancel function.
+func newSharedRegistration[T any](notifier *sharedChainNotifier,
+	heightHint int32, cancel func()) *sharedRegistration[T] {
+
+	return &sharedRegistration[T]{
+		notifier:    notifier,
+		heightHint:  heightHint,
+		subscribers: make(map[int]*chainSubscriber),
+		cancel:      cancel,
+	}
+}
+
+// subscribe adds a subscriber that receives the notifications of the
+// registration until its context is canceled. A notification that was
+// delivered before is delivered to the new subscriber right away. The
+// notifier's mutex must be held.
+func (r *sharedRegistration[T]) subscribe(ctx context.Context) (chan T,
+	chan error) {
+
+	subscriber := &chainSubscriber{
+		queue: queue.NewConcurrentQueue(1),
+	}
+	subscriber.queue.Start()
+
+	if r.notified {
+		subscriber.queue.ChanIn() <- r.notification
+	}
+
+	id := r.nextID
+	r.nextID++
+	r.subscribers[id] = subscriber
+
+	notifyChan := make(chan T, 1)
+	errChan := make(chan error, 1)
+	go r.forward(ctx, subscriber, notifyChan, errChan, func() {
+		r.unsubscribe(id, subscriber)
+	})
+
+	return notifyChan, errChan
+}
+
+// forward delivers the notifications in a subscriber's queue until the
+// context is canceled or the registration fails, and then calls done.
+func (r *sharedRegistration[T]) forward(ctx context.Context,
+	subscriber *chainSubscriber, notifyChan chan T, errChan chan error,
+	done func()) {
+
+	defer done()
+
+	for {
+		select {
+		case item := <-subscriber.queue.ChanOut():
+			if err, ok := item.(error); ok {
+				errChan <- err
+				return
+			}
+
+			select {
+			case notifyChan <- item.(T):
+			case <-ctx.Done():
+				return
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// dispatch delivers the notifications of lnd to all subscribers. If the
+// registration fails, the error is delivered to all subscribers and the
+// registration ends, so that the next registration for the same event
+// registers with lnd again.
+func (r *sharedRegistration[T]) dispatch(ctx context.Context,
+	notifyChan chan T, errChan chan error) {
+
+	for {
+		select {
+		case notification := <-notifyChan:
+			r.notifier.mu.Lock()
+			r.notification = notification
+			r.notified = true
+			for _, subscriber := range r.subscribers {
+				subscriber.queue.ChanIn() <- notification
+			}
+			r.notifier.mu.Unlock()
+
+		case err := <-errChan:
+			r.notifier.mu.Lock()
+			for _, subscriber := range r.subscribers {
+				subscriber.queue.ChanIn() <- err
+			}
+
+			// The subscribers end with the error, so we drop them
+			// here. Their forwarding stops their queues.
+			r.subscribers = make(map[int]*chainSubscriber)
+			r.close()
+			r.notifier.mu.Unlock()
+
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// unsubscribe removes a subscriber and ends the registration once it has no
+// subscribers left.
+func (r *sharedRegistration[T]) unsubscribe(id int,
+	subscriber *chainSubscriber) {
+
+	r.notifier.mu.Lock()
+	delete(r.subscribers, id)
+	if len(r.subscribers) == 0 {
+		r.close()
+	}
+	r.notifier.mu.Unlock()
+
+	// The subscriber is removed, so no notifications are added to its
+	// queue anymore and it's safe to stop it.
+	subscriber.queue.Stop()
+}
+
+// close ends the registration with lnd and removes it from the notifier. The
+// notifier's mutex must be held.
+func (r *sharedRegistration[T]) close() {
+	if r.closed {
+		return
+	}
+	r.closed = true
+
+	if r.remove != nil {
+		r.remove()
+	}
+	r.cancel()
+}
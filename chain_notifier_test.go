@@ -0,0 +1,279 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+)
+
+// countingNotifier counts the block epoch subscriptions to the chain notifier
+// that it wraps.
+type countingNotifier struct {
+	lndclient.ChainNotifierClient
+
+	epochRegistrations int
+}
+
+// RegisterBlockEpochNtfn counts the subscription and registers with the
+// wrapped notifier.
+func (c *countingNotifier) RegisterBlockEpochNtfn(ctx context.Context) (
+	chan int32, chan error, error) {
+
+	c.epochRegistrations++
+
+	return c.ChainNotifierClient.RegisterBlockEpochNtfn(ctx)
+}
+
+// receiveHeight asserts that the given height is received on the block
+// channel.
+func receiveHeight(t *testing.T, blockChan chan int32, height int32) {
+	t.Helper()
+
+	select {
+	case received := <-blockChan:
+		require.Equal(t, height, received)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("no height received")
+	}
+}
+
+// TestSharedBlockEpochs tests that block epoch subscribers share a single
+// subscription and all receive the block heights.
+func TestSharedBlockEpochs(t *testing.T) {
+	lnd := test.NewMockLnd()
+	counting := &countingNotifier{
+		ChainNotifierClient: lnd.ChainNotifier,
+	}
+	notifier := newSharedChainNotifier(counting, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	firstChan, _, err := notifier.RegisterBlockEpochNtfn(ctx)
+	require.NoError(t, err)
+	receiveHeight(t, firstChan, lnd.Height)
+
+	// A later subscriber receives the current height right away.
+	secondChan, _, err := notifier.RegisterBlockEpochNtfn(ctx)
+	require.NoError(t, err)
+	receiveHeight(t, secondChan, lnd.Height)
+
+	require.Equal(t, 1, counting.epochRegistrations)
+
+	height := lnd.Height + 1
+	require.NoError(t, lnd.NotifyHeight(height))
+	receiveHeight(t, firstChan, height)
+	receiveHeight(t, secondChan, height)
+}
+
+// confResult is the result of a confirmation registration.
+type confResult struct {
+	confChan chan *chainntnfs.TxConfirmation
+	err      error
+}
+
+// registerConf registers for the confirmation of the pkScript in a goroutine,
+// as the mock notifier blocks until the test receives the registration.
+func registerConf(ctx context.Context,
+	notifier lndclient.ChainNotifierClient, pkScript []byte) chan confResult {
+
+	resultChan := make(chan confResult, 1)
+	go func() {
+		confChan, _, err := notifier.RegisterConfirmationsNtfn(
+			ctx, nil, pkScript, 1, 0,
+		)
+		resultChan <- confResult{
+			confChan: confChan,
+			err:      err,
+		}
+	}()
+
+	return resultChan
+}
+
+// receiveConf asserts that the confirmation is received on the channel.
+func receiveConf(t *testing.T, confChan chan *chainntnfs.TxConfirmation,
+	conf *chainntnfs.TxConfirmation) {
+
+	t.Helper()
+
+	select {
+	case received := <-confChan:
+		require.Equal(t, conf, received)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("no confirmation received")
+	}
+}
+
+// TestSharedConfirmations tests that registrations for the same confirmation
+// share a single subscription to lnd, and that all of them receive the
+// confirmation, including those that register after it.
+func TestSharedConfirmations(t *testing.T) {
+	lnd := test.NewMockLnd()
+	notifier := newSharedChainNotifier(lnd.ChainNotifier, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pkScript := []byte{1}
+	firstResult := registerConf(ctx, notifier, pkScript)
+	<-lnd.RegisterConfChannel
+	first := <-firstResult
+	require.NoError(t, first.err)
+
+	// The second registration joins the first one without registering
+	// with lnd.
+	second := <-registerConf(ctx, notifier, pkScript)
+	require.NoError(t, second.err)
+
+	select {
+	case <-lnd.RegisterConfChannel:
+		t.Fatalf("unexpected registration")
+
+	default:
+	}
+
+	conf := &chainntnfs.TxConfirmation{
+		Tx: &wire.MsgTx{
+			TxOut: []*wire.TxOut{{PkScript: pkScript}},
+		},
+	}
+	lnd.ConfChannel <- conf
+	receiveConf(t, first.confChan, conf)
+	receiveConf(t, second.confChan, conf)
+
+	// A registration after the confirmation receives it right away.
+	third := <-registerConf(ctx, notifier, pkScript)
+	require.NoError(t, third.err)
+	receiveConf(t, third.confChan, conf)
+}
+
+// TestSubscriptionLimit tests that the limit of subscriptions refuses new
+// swaps but never a registration, that shared and unlimited registrations
+// don't count against the limit, and that a subscription is only released
+// once all of its registrations ended.
+func TestSubscriptionLimit(t *testing.T) {
+	lnd := test.NewMockLnd()
+	notifier := newSharedChainNotifier(lnd.ChainNotifier, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, notifier.checkLimit())
+
+	// The first subscription takes the only slot, also after lnd
+	// returned its registration.
+	firstCtx, cancelFirst := context.WithCancel(ctx)
+	firstResult := registerConf(firstCtx, notifier, []byte{1})
+	<-lnd.RegisterConfChannel
+	require.NoError(t, (<-firstResult).err)
+	require.ErrorIs(t, notifier.checkLimit(), ErrTooManyChainSubscriptions)
+
+	// A registration for another confirmation is still made, as a swap
+	// that runs must be able to watch the chain.
+	secondCtx, cancelSecond := context.WithCancel(ctx)
+	secondResult := registerConf(secondCtx, notifier, []byte{2})
+	<-lnd.RegisterConfChannel
+	require.NoError(t, (<-secondResult).err)
+
+	// A registration for the same confirmation shares the subscription.
+	shared := <-registerConf(firstCtx, notifier, []byte{1})
+	require.NoError(t, shared.err)
+
+	// Unlimited registrations don't count against the limit.
+	unlimitedResult := registerConf(ctx, notifier.withoutLimit(), []byte{3})
+	<-lnd.RegisterConfChannel
+	require.NoError(t, (<-unlimitedResult).err)
+
+	// Once all registrations of the counted subscriptions ended, new swaps
+	// are accepted again.
+	cancelFirst()
+	cancelSecond()
+
+	require.Eventually(t, func() bool {
+		return notifier.checkLimit() == nil
+	}, test.Timeout, time.Millisecond)
+
+	// A nil notifier doesn't bound the subscriptions.
+	var unbounded *sharedChainNotifier
+	require.NoError(t, unbounded.checkLimit())
+}
+
+// failingEpochNotifier is a chain notifier whose block epoch subscriptions
+// are controlled by the test.
+type failingEpochNotifier struct {
+	lndclient.ChainNotifierClient
+
+	blockChans chan chan int32
+	errChans   chan chan error
+}
+
+// RegisterBlockEpochNtfn hands the channels of the new subscription to the
+// test.
+func (f *failingEpochNotifier) RegisterBlockEpochNtfn(ctx context.Context) (
+	chan int32, chan error, error) {
+
+	blockChan := make(chan int32)
+	errChan := make(chan error, 1)
+
+	f.blockChans <- blockChan
+	f.errChans <- errChan
+
+	return blockChan, errChan, nil
+}
+
+// TestSharedBlockEpochsFailure tests that the subscribers of a failed shared
+// block epoch subscription receive the error and are shut down, and that a
+// new subscriber starts a new shared subscription.
+func TestSharedBlockEpochsFailure(t *testing.T) {
+	defer test.Guard(t)()
+
+	failing := &failingEpochNotifier{
+		blockChans: make(chan chan int32, 2),
+		errChans:   make(chan chan error, 2),
+	}
+	notifier := newSharedChainNotifier(failing, 0)
+
+	// The first subscriber is never canceled, so it has to be shut down
+	// by the failure of the shared subscription.
+	_, firstErrChan, err := notifier.RegisterBlockEpochNtfn(
+		context.Background(),
+	)
+	require.NoError(t, err)
+
+	<-failing.blockChans
+	sharedErr := <-failing.errChans
+
+	failure := errors.New("subscription failed")
+	sharedErr <- failure
+	require.Equal(t, failure, <-firstErrChan)
+
+	require.Eventually(t, func() bool {
+		notifier.mu.Lock()
+		defer notifier.mu.Unlock()
+
+		return notifier.cancelEpochs == nil
+	}, test.Timeout, time.Millisecond)
+
+	// A new subscriber starts a new shared subscription.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	secondChan, _, err := notifier.RegisterBlockEpochNtfn(ctx)
+	require.NoError(t, err)
+
+	sharedBlocks := <-failing.blockChans
+	<-failing.errChans
+
+	sharedBlocks <- 100
+	receiveHeight(t, secondChan, 100)
+}
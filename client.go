@@ -1,9 +1,11 @@
 package loop
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -12,6 +14,8 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
@@ -19,9 +23,12 @@ import (
 	"github.com/lightninglabs/loop/sweep"
 	"github.com/lightninglabs/loop/sweepbatcher"
 	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/status"
 )
 
@@ -50,6 +57,53 @@ var (
 	// publish a swap.
 	ErrInsufficientBalance = errors.New("insufficient confirmed balance")
 
+	// ErrMaxOutstandingValueExceeded is returned when a new swap would
+	// push the total amount of all pending swaps over the configured
+	// maximum outstanding value.
+	ErrMaxOutstandingValueExceeded = errors.New("maximum outstanding " +
+		"swap value exceeded")
+
+	// ErrSwapAmountUneconomical is returned when the largest swap amount
+	// that can be made does not cover the costs of the swap.
+	ErrSwapAmountUneconomical = errors.New("swap amount does not cover " +
+		"swap costs")
+
+	// ErrQuoteStale is returned when a swap is initiated with a quote that
+	// is too old or when the server's terms have become worse than
+	// quoted.
+	ErrQuoteStale = errors.New("quote stale")
+
+	// ErrDuplicateClientID is returned when a swap is initiated with a
+	// client id that was already assigned to another swap.
+	ErrDuplicateClientID = errors.New("client id already in use")
+
+	// ErrSwapNotCancelable is returned when a swap is canceled that is
+	// past its initiation phase or isn't being executed.
+	ErrSwapNotCancelable = errors.New("swap can't be canceled")
+
+	// ErrPaused is returned when a swap is initiated while the client is
+	// paused.
+	ErrPaused = errors.New("swap initiation is paused")
+
+	// ErrNoRoute is returned when lnd can't find a route that pays a swap
+	// invoice within the routing fee limit.
+	ErrNoRoute = errors.New("no route within routing fee limit")
+
+	// ErrNoLiquidityEffect is returned when the liquidity effect of a swap
+	// is requested that wasn't recorded.
+	ErrNoLiquidityEffect = errors.New("no liquidity effect recorded " +
+		"for swap")
+
+	// ErrDestAddrWrongNetwork is returned when a loop out is initiated
+	// with a destination address of a different network than the client.
+	ErrDestAddrWrongNetwork = errors.New("destination address is for " +
+		"a different network")
+
+	// ErrDestAddrUnsupported is returned when a loop out is initiated with
+	// a destination address of a type that can't be swept to.
+	ErrDestAddrUnsupported = errors.New("unsupported destination " +
+		"address type")
+
 	// serverRPCTimeout is the maximum time a gRPC request to the server
 	// should be allowed to take.
 	serverRPCTimeout = 30 * time.Second
@@ -62,12 +116,24 @@ var (
 	// probeTimeout is the maximum time until a probe is allowed to take.
 	probeTimeout = 3 * time.Minute
 
-	repushDelay = 1 * time.Second
+	// defaultRepushDelay is the default delay after which a loop out
+	// re-checks whether its htlc can be swept and re-pushes its preimage.
+	defaultRepushDelay = 1 * time.Second
+
+	// resumeRetryDelay is the delay before the first retry of resuming
+	// swaps that failed to be resumed. It is doubled after every retry.
+	resumeRetryDelay = 5 * time.Second
 
 	// MinerFeeEstimationFailed is a magic number that is returned in a
 	// quote call as the miner fee if the fee estimation in lnd's wallet
 	// failed because of insufficient funds.
 	MinerFeeEstimationFailed btcutil.Amount = -1
+
+	// quoteMinerFeeTolerance is the percentage by which the sweep fee that
+	// is estimated when a swap is initiated may exceed the miner fee of
+	// the quote that the swap is based on. Fee estimates move with every
+	// block, so a small increase doesn't make the quote stale.
+	quoteMinerFeeTolerance = 10
 )
 
 // Client performs the client side part of swaps. This interface exists to be
@@ -76,11 +142,19 @@ type Client struct {
 	started uint32 // To be used atomically.
 	errChan chan error
 
+	// paused is non-zero while the initiation of new swaps is paused.
+	paused uint32 // To be used atomically.
+
 	// abandonChans allows for accessing a swap's abandon channel by
 	// providing its swap hash. This map is used to look up the abandon
 	// channel of a swap if the client requests to abandon it.
 	abandonChans map[lntypes.Hash]chan struct{}
 
+	// abandoned holds the swaps that were abandoned in the store while
+	// they weren't executing, so that they aren't resumed anymore. Like
+	// abandonChans, it is guarded by the executor's mutex.
+	abandoned map[lntypes.Hash]struct{}
+
 	lndServices *lndclient.LndServices
 	sweeper     *sweep.Sweeper
 	executor    *executor
@@ -88,6 +162,30 @@ type Client struct {
 	resumeReady chan struct{}
 	wg          sync.WaitGroup
 
+	// outstandingMtx guards reservedValue.
+	outstandingMtx sync.Mutex
+
+	// reservedValue is the total amount of the swaps that are being
+	// initiated and aren't stored yet. It counts towards the maximum
+	// outstanding value, so that concurrent requests cannot jointly
+	// exceed it.
+	reservedValue btcutil.Amount
+
+	// termsHistory records the loop out terms that were observed from the
+	// server.
+	termsHistory *termsHistory
+
+	// liquidity records the local balance of the channels that swaps
+	// target.
+	liquidity *liquidityTracker
+
+	// subscriptions delivers the swap updates to the subscribers.
+	subscriptions *swapSubscriptions
+
+	// chainNotifier is the chain notifier that the swaps share. New swaps
+	// are refused while its subscriptions are all taken.
+	chainNotifier *sharedChainNotifier
+
 	clientConfig
 }
 
@@ -97,6 +195,13 @@ type ClientConfig struct {
 	// ServerAddress is the loop server to connect to.
 	ServerAddress string
 
+	// FallbackServerAddresses are additional endpoints of the same loop
+	// server. They are tried in order if the server can't be reached at
+	// ServerAddress. Swaps aren't bound to the endpoint that accepted
+	// them, their later calls go to whichever endpoint is reachable, so
+	// all addresses must front the same server state.
+	FallbackServerAddresses []string
+
 	// ProxyAddress is the SOCKS proxy that should be used to establish the
 	// connection.
 	ProxyAddress string
@@ -108,6 +213,11 @@ type ClientConfig struct {
 	// connect to the server.
 	TLSPathServer string
 
+	// TLSFingerprintServer is the hex encoded sha256 fingerprint of the
+	// server's TLS certificate. If set, the server certificate is verified
+	// against this fingerprint instead of the system's certificate pool.
+	TLSFingerprintServer string
+
 	// Lnd is an instance of the lnd proxy.
 	Lnd *lndclient.LndServices
 
@@ -131,6 +241,96 @@ type ClientConfig struct {
 	// MaxPaymentRetries is the maximum times we retry an off-chain payment
 	// (used in loop out).
 	MaxPaymentRetries int
+
+	// MaxOutstandingValue is the maximum total amount that may be
+	// committed to pending swaps at any time. New swaps that would push
+	// the total over this value are rejected. Resumed swaps count towards
+	// the total. A zero value disables the limit.
+	MaxOutstandingValue btcutil.Amount
+
+	// RepushDelay is the delay after a new block before a pending loop out
+	// re-checks whether its htlc can be swept and re-pushes its preimage
+	// to the server. Increasing it reduces the number of queries to the
+	// chain backend. A zero value uses the default delay.
+	RepushDelay time.Duration
+
+	// StatusBufferSize is the number of swap status updates that are
+	// queued if the consumer of the status channel falls behind, so that
+	// it doesn't block the execution of swaps. While queued, intermediate
+	// updates of a swap are replaced by newer ones, final states are
+	// always delivered. A zero value disables the buffer.
+	StatusBufferSize int
+
+	// ResumeRetries is the number of times that the resumption of pending
+	// swaps that failed to be resumed at startup is retried, with an
+	// exponentially increasing delay. This covers lnd still starting up
+	// when the client starts. A zero value disables the retries.
+	ResumeRetries int
+
+	// TermsHistorySize is the number of changes of the server's loop out
+	// terms that are kept in the store. A zero value uses the default
+	// size.
+	TermsHistorySize int
+
+	// MaxSweepFeeRate is the highest fee rate that sweep transactions are
+	// published with. Once it is reached, the fee rate of a sweep is no
+	// longer bumped. A zero value doesn't limit the fee rate.
+	MaxSweepFeeRate chainfee.SatPerKWeight
+
+	// MaxSweepFeeBumps is the number of times that the fee rate of a
+	// sweep transaction is bumped when it doesn't confirm. Afterwards, the
+	// sweep is republished with its last fee rate. A zero value doesn't
+	// limit the bumps.
+	MaxSweepFeeBumps int
+
+	// SweepBatchWindow is the delay between a new block and publishing a
+	// sweep transaction, during which the sweeps of swaps that become
+	// ready around the same block are batched into a single transaction.
+	// A zero value uses the network's default delay.
+	SweepBatchWindow time.Duration
+
+	// SweepFeeFunction computes the fee rate of a sweep transaction that
+	// didn't confirm, for example depending on how close the swaps' htlcs
+	// are to their expiry. If nil, the fee rate is bumped by a fixed step
+	// per block.
+	SweepFeeFunction sweepbatcher.FeeFunction
+
+	// SweepStaticFeeRate is a fixed fee rate that is used to estimate
+	// sweep fees instead of lnd's fee estimator. It is meant for
+	// deterministic fees in integration tests and is rejected on networks
+	// other than regtest and simnet. A zero value disables it.
+	SweepStaticFeeRate chainfee.SatPerKWeight
+
+	// MaxChainSubscriptions is the maximum number of confirmation and
+	// spend subscriptions of swaps that are open with lnd at the same
+	// time. Swaps that watch for the same confirmation or spend share a
+	// subscription. Once the limit is reached, new swaps are refused
+	// with ErrTooManyChainSubscriptions until a subscription ends. Swaps
+	// that run already, including resumed ones, are never refused a
+	// subscription. The subscriptions of the sweep batcher don't count
+	// against the limit. A zero value doesn't limit the subscriptions.
+	MaxChainSubscriptions int
+
+	// PreimageGenerator overrides the source of the preimages of new
+	// swaps. It is meant for deterministic swap hashes in tests only, as
+	// a predictable preimage allows anyone to claim the swap's htlc. It is
+	// rejected on networks other than regtest and simnet. If it is nil,
+	// preimages are generated from a secure source of randomness.
+	PreimageGenerator PreimageGenerator
+
+	// DestAddrType is the type of the wallet address that is generated
+	// for loop out requests without a destination address. If unknown,
+	// p2wkh addresses are generated.
+	DestAddrType walletrpc.AddressType
+
+	// PriceSource provides the bitcoin price that the costs of swaps are
+	// annotated with for accounting. If it is nil, the costs aren't
+	// annotated with their fiat value.
+	PriceSource PriceSource
+
+	// FiatCurrency is the currency that the costs of swaps are annotated
+	// in, for example "usd". It is required if a price source is set.
+	FiatCurrency string
 }
 
 // NewClient returns a new instance to initiate swaps with.
@@ -138,6 +338,25 @@ func NewClient(dbDir string, loopDB loopdb.SwapStore,
 	sweeperDb sweepbatcher.BatcherStore, cfg *ClientConfig) (
 	*Client, func(), error) {
 
+	err := sweep.ValidateStaticFeeRate(
+		cfg.SweepStaticFeeRate, cfg.Lnd.ChainParams,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = validatePreimageGenerator(
+		cfg.PreimageGenerator, cfg.Lnd.ChainParams,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cfg.PriceSource != nil && cfg.FiatCurrency == "" {
+		return nil, nil, errors.New("fiat currency required for " +
+			"price source")
+	}
+
 	lsatStore, err := lsat.NewFileStore(dbDir)
 	if err != nil {
 		return nil, nil, err
@@ -148,8 +367,18 @@ func NewClient(dbDir string, loopDB loopdb.SwapStore,
 		return nil, nil, err
 	}
 
+	// Let the swaps share their subscriptions to lnd and count their
+	// confirmation and spend subscriptions, so that new swaps can be
+	// refused once they hit the limit.
+	chainNotifier := newSharedChainNotifier(
+		cfg.Lnd.ChainNotifier, cfg.MaxChainSubscriptions,
+	)
+	lndServices := *cfg.Lnd
+	lndServices.ChainNotifier = chainNotifier
+	lnd := &lndServices
+
 	config := &clientConfig{
-		LndServices: cfg.Lnd,
+		LndServices: lnd,
 		Server:      swapServerClient,
 		Store:       loopDB,
 		Conn:        swapServerClient.conn,
@@ -157,11 +386,19 @@ func NewClient(dbDir string, loopDB loopdb.SwapStore,
 		CreateExpiryTimer: func(d time.Duration) <-chan time.Time {
 			return time.NewTimer(d).C
 		},
-		LoopOutMaxParts: cfg.LoopOutMaxParts,
+		LoopOutMaxParts:     cfg.LoopOutMaxParts,
+		MaxOutstandingValue: cfg.MaxOutstandingValue,
+		StatusBufferSize:    cfg.StatusBufferSize,
+		ResumeRetries:       cfg.ResumeRetries,
+		PreimageGenerator:   cfg.PreimageGenerator,
+		DestAddrType:        cfg.DestAddrType,
+		PriceSource:         cfg.PriceSource,
+		FiatCurrency:        cfg.FiatCurrency,
 	}
 
 	sweeper := &sweep.Sweeper{
-		Lnd: cfg.Lnd,
+		Lnd:           lnd,
+		StaticFeeRate: cfg.SweepStaticFeeRate,
 	}
 
 	verifySchnorrSig := func(pubKey *btcec.PublicKey, hash, sig []byte) error {
@@ -177,14 +414,28 @@ func NewClient(dbDir string, loopDB loopdb.SwapStore,
 		return nil
 	}
 
+	// The subscriptions of the batcher shouldn't hold back new swaps, so
+	// they share the subscriptions without counting against the limit.
 	batcher := sweepbatcher.NewBatcher(
-		cfg.Lnd.WalletKit, cfg.Lnd.ChainNotifier, cfg.Lnd.Signer,
+		lnd.WalletKit, chainNotifier.withoutLimit(), lnd.Signer,
 		swapServerClient.MultiMuSig2SignSweep, verifySchnorrSig,
-		cfg.Lnd.ChainParams, sweeperDb, loopDB,
+		lnd.ChainParams, sweeperDb, loopDB,
+		sweepbatcher.WithMaxFeeRate(cfg.MaxSweepFeeRate),
+		sweepbatcher.WithMaxFeeBumps(cfg.MaxSweepFeeBumps),
+		sweepbatcher.WithPublishDelay(cfg.SweepBatchWindow),
+		sweepbatcher.WithFeeFunction(cfg.SweepFeeFunction),
+		sweepbatcher.WithStaticFeeRate(cfg.SweepStaticFeeRate),
 	)
 
+	repushDelay := cfg.RepushDelay
+	if repushDelay == 0 {
+		repushDelay = defaultRepushDelay
+	}
+
+	liquidity := newLiquidityTracker(lnd)
+
 	executor := newExecutor(&executorConfig{
-		lnd:                 cfg.Lnd,
+		lnd:                 lnd,
 		store:               loopDB,
 		sweeper:             sweeper,
 		batcher:             batcher,
@@ -192,18 +443,30 @@ func NewClient(dbDir string, loopDB loopdb.SwapStore,
 		loopOutMaxParts:     cfg.LoopOutMaxParts,
 		totalPaymentTimeout: cfg.TotalPaymentTimeout,
 		maxPaymentRetries:   cfg.MaxPaymentRetries,
+		repushDelay:         repushDelay,
 		cancelSwap:          swapServerClient.CancelLoopOutSwap,
 		verifySchnorrSig:    verifySchnorrSig,
+		swapDone:            liquidity.swapDone,
 	})
 
+	termsHistorySize := cfg.TermsHistorySize
+	if termsHistorySize == 0 {
+		termsHistorySize = defaultTermsHistorySize
+	}
+
 	client := &Client{
-		errChan:      make(chan error),
-		clientConfig: *config,
-		lndServices:  cfg.Lnd,
-		sweeper:      sweeper,
-		executor:     executor,
-		resumeReady:  make(chan struct{}),
-		abandonChans: make(map[lntypes.Hash]chan struct{}),
+		errChan:       make(chan error),
+		clientConfig:  *config,
+		lndServices:   lnd,
+		sweeper:       sweeper,
+		executor:      executor,
+		resumeReady:   make(chan struct{}),
+		abandonChans:  make(map[lntypes.Hash]chan struct{}),
+		abandoned:     make(map[lntypes.Hash]struct{}),
+		termsHistory:  newTermsHistory(config.Store, termsHistorySize),
+		liquidity:     liquidity,
+		subscriptions: newSwapSubscriptions(),
+		chainNotifier: chainNotifier,
 	}
 
 	cleanup := func() {
@@ -234,78 +497,113 @@ func (s *Client) FetchSwaps(ctx context.Context) ([]*SwapInfo, error) {
 	swaps := make([]*SwapInfo, 0, len(loopInSwaps)+len(loopOutSwaps))
 
 	for _, swp := range loopOutSwaps {
-		swp := swp
-
-		swapInfo := &SwapInfo{
-			SwapType:      swap.TypeOut,
-			SwapContract:  swp.Contract.SwapContract,
-			SwapStateData: swp.State(),
-			SwapHash:      swp.Hash,
-			LastUpdate:    swp.LastUpdateTime(),
+		swapInfo, err := s.loopOutSwapInfo(swp)
+		if err != nil {
+			return nil, err
 		}
 
-		htlc, err := utils.GetHtlc(
-			swp.Hash, &swp.Contract.SwapContract,
-			s.lndServices.ChainParams,
-		)
+		swaps = append(swaps, swapInfo)
+	}
+
+	for _, swp := range loopInSwaps {
+		swapInfo, err := s.loopInSwapInfo(swp)
 		if err != nil {
 			return nil, err
 		}
 
-		switch htlc.OutputType {
-		case swap.HtlcP2WSH:
-			swapInfo.HtlcAddressP2WSH = htlc.Address
+		swaps = append(swaps, swapInfo)
+	}
 
-		case swap.HtlcP2TR:
-			swapInfo.HtlcAddressP2TR = htlc.Address
+	return swaps, nil
+}
 
-		default:
-			return nil, swap.ErrInvalidOutputType
-		}
+// SwapInfo returns the current state of the swap with the given hash, which
+// includes its costs so far and its confirmed htlc tx id.
+func (s *Client) SwapInfo(ctx context.Context, hash lntypes.Hash) (*SwapInfo,
+	error) {
 
-		swaps = append(swaps, swapInfo)
+	loopOut, err := s.Store.FetchLoopOutSwap(ctx, hash)
+	switch {
+	case err == nil:
+		return s.loopOutSwapInfo(loopOut)
+
+	case !errors.Is(err, loopdb.ErrSwapNotFound):
+		return nil, err
 	}
 
-	for _, swp := range loopInSwaps {
-		swp := swp
+	loopIn, err := s.Store.FetchLoopInSwap(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
 
-		swapInfo := &SwapInfo{
-			SwapType:      swap.TypeIn,
-			SwapContract:  swp.Contract.SwapContract,
-			SwapStateData: swp.State(),
-			SwapHash:      swp.Hash,
-			LastUpdate:    swp.LastUpdateTime(),
-		}
+	return s.loopInSwapInfo(loopIn)
+}
 
-		htlc, err := utils.GetHtlc(
-			swp.Hash, &swp.Contract.SwapContract,
-			s.lndServices.ChainParams,
-		)
-		if err != nil {
-			return nil, err
-		}
+// loopOutSwapInfo returns the swap info of a stored loop out swap.
+func (s *Client) loopOutSwapInfo(swp *loopdb.LoopOut) (*SwapInfo, error) {
+	forfeited, err := forfeitedPrepay(
+		swp.State().State, swp.Contract, s.lndServices.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		switch htlc.OutputType {
-		case swap.HtlcP2WSH:
-			swapInfo.HtlcAddressP2WSH = htlc.Address
+	return s.newSwapInfo(&SwapInfo{
+		SwapType:           swap.TypeOut,
+		SwapContract:       swp.Contract.SwapContract,
+		SwapStateData:      swp.State(),
+		SwapHash:           swp.Hash,
+		LastUpdate:         swp.LastUpdateTime(),
+		PrepaySettled:      swp.Contract.PrepaySettled,
+		SwapPaymentSettled: swp.Contract.SwapPaymentSettled,
+		PrepayForfeited:    forfeited,
+		SweepVerified:      swp.Contract.SweepVerified,
+		SweepDiscrepancy:   swp.Contract.SweepDiscrepancy,
+	})
+}
 
-		case swap.HtlcP2TR:
-			swapInfo.HtlcAddressP2TR = htlc.Address
+// loopInSwapInfo returns the swap info of a stored loop in swap.
+func (s *Client) loopInSwapInfo(swp *loopdb.LoopIn) (*SwapInfo, error) {
+	return s.newSwapInfo(&SwapInfo{
+		SwapType:      swap.TypeIn,
+		SwapContract:  swp.Contract.SwapContract,
+		SwapStateData: swp.State(),
+		SwapHash:      swp.Hash,
+		LastUpdate:    swp.LastUpdateTime(),
+	})
+}
 
-		default:
-			return nil, swap.ErrInvalidOutputType
-		}
+// newSwapInfo completes a swap info with the address of the swap's htlc.
+func (s *Client) newSwapInfo(swapInfo *SwapInfo) (*SwapInfo, error) {
+	htlc, err := utils.GetHtlc(
+		swapInfo.SwapHash, &swapInfo.SwapContract,
+		s.lndServices.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		swaps = append(swaps, swapInfo)
+	switch htlc.OutputType {
+	case swap.HtlcP2WSH:
+		swapInfo.HtlcAddressP2WSH = htlc.Address
+
+	case swap.HtlcP2TR:
+		swapInfo.HtlcAddressP2TR = htlc.Address
+
+	default:
+		return nil, swap.ErrInvalidOutputType
 	}
 
-	return swaps, nil
+	return swapInfo, nil
 }
 
 // Run is a blocking call that executes all swaps. Any pending swaps are
 // restored from persistent storage and resumed.  Subsequent updates will be
-// sent through the passed in statusChan. The function can be terminated by
-// cancelling the context.
+// sent through the passed in statusChan. The updates of a single swap are
+// delivered in the order in which they happened, updates of different swaps
+// may be interleaved in any order. If statusChan is nil, the updates are only
+// delivered to the subscribers of SubscribeSwaps. The function can be
+// terminated by cancelling the context.
 func (s *Client) Run(ctx context.Context, statusChan chan<- SwapInfo) error {
 	if !atomic.CompareAndSwapUint32(&s.started, 0, 1) {
 		return errors.New("swap client can only be started once")
@@ -332,22 +630,61 @@ func (s *Client) Run(ctx context.Context, statusChan chan<- SwapInfo) error {
 		return err
 	}
 
+	// Make sure that we don't re-drive swaps from a stale stored state,
+	// which may happen if the store was restored from an old backup.
+	err = s.reconcileResumedSwaps(
+		mainCtx, pendingLoopOutSwaps, pendingLoopInSwaps,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to reconcile pending swaps: %v", err)
+	}
+
 	// Start goroutine to deliver all pending swaps to the main loop.
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
 
-		s.resumeSwaps(mainCtx, pendingLoopOutSwaps, pendingLoopInSwaps)
+		failedLoopOuts, failedLoopIns := s.resumeSwaps(
+			mainCtx, pendingLoopOutSwaps, pendingLoopInSwaps,
+		)
 
 		// Signal that new requests can be accepted. Otherwise, the new
 		// swap could already have been added to the store and read in
 		// this goroutine as being a swap that needs to be resumed.
 		// Resulting in two goroutines executing the same swap.
 		close(s.resumeReady)
+
+		// Only the swaps that failed to be resumed are retried, so new
+		// swaps don't need to wait for the retries.
+		s.retryResumeSwaps(mainCtx, failedLoopOuts, failedLoopIns)
+	}()
+
+	// If configured, decouple the swaps from a slow status consumer.
+	if s.StatusBufferSize > 0 && statusChan != nil {
+		buffer := newStatusBuffer(s.StatusBufferSize, statusChan)
+		statusChan = buffer.in
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			buffer.run(mainCtx)
+		}()
+	}
+
+	// Publish the updates to the subscribers before they are delivered
+	// to the status channel.
+	publishChan := make(chan SwapInfo)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		s.subscriptions.forward(mainCtx, publishChan, statusChan)
 	}()
 
 	// Main event loop.
-	err = s.executor.run(mainCtx, statusChan, s.abandonChans)
+	err = s.executor.run(mainCtx, publishChan, s.abandonChans)
 
 	// Consider canceled as happy flow.
 	if errors.Is(err, context.Canceled) {
@@ -375,43 +712,168 @@ func (s *Client) Run(ctx context.Context, statusChan chan<- SwapInfo) error {
 	return err
 }
 
-// resumeSwaps restarts all pending swaps from the provided list.
-func (s *Client) resumeSwaps(ctx context.Context,
-	loopOutSwaps []*loopdb.LoopOut, loopInSwaps []*loopdb.LoopIn) {
+// pendingResume is a pending swap that is waiting to be resumed. Exactly one
+// of its fields is set.
+type pendingResume struct {
+	loopOut *loopdb.LoopOut
+	loopIn  *loopdb.LoopIn
+}
 
-	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+// cltvExpiry returns the expiry height of the swap's htlc.
+func (p pendingResume) cltvExpiry() int32 {
+	if p.loopOut != nil {
+		return p.loopOut.Contract.CltvExpiry
+	}
+
+	return p.loopIn.Contract.CltvExpiry
+}
+
+// resumeOrder returns the pending swaps of the provided lists, ordered by the
+// expiry of their htlcs. Swaps that expire soonest are the most urgent to be
+// driven after a long downtime, so they come first. Swaps with the same
+// expiry keep their store order.
+func resumeOrder(loopOutSwaps []*loopdb.LoopOut,
+	loopInSwaps []*loopdb.LoopIn) []pendingResume {
 
+	var pending []pendingResume
 	for _, pend := range loopOutSwaps {
 		if pend.State().State.Type() != loopdb.StateTypePending {
 			continue
 		}
-		swap, err := resumeLoopOutSwap(swapCfg, pend)
-		if err != nil {
-			log.Errorf("resuming loop out swap: %v", err)
-			continue
-		}
 
-		s.executor.initiateSwap(ctx, swap)
+		pending = append(pending, pendingResume{loopOut: pend})
 	}
 
 	for _, pend := range loopInSwaps {
 		if pend.State().State.Type() != loopdb.StateTypePending {
 			continue
 		}
-		swap, err := resumeLoopInSwap(ctx, swapCfg, pend)
+
+		pending = append(pending, pendingResume{loopIn: pend})
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].cltvExpiry() < pending[j].cltvExpiry()
+	})
+
+	return pending
+}
+
+// resumeSwaps restarts all pending swaps from the provided list, starting
+// with the swaps that expire soonest. It returns the swaps that failed to be
+// resumed.
+func (s *Client) resumeSwaps(ctx context.Context,
+	loopOutSwaps []*loopdb.LoopOut, loopInSwaps []*loopdb.LoopIn) (
+	[]*loopdb.LoopOut, []*loopdb.LoopIn) {
+
+	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+
+	var (
+		failedLoopOuts []*loopdb.LoopOut
+		failedLoopIns  []*loopdb.LoopIn
+	)
+
+	for _, pend := range resumeOrder(loopOutSwaps, loopInSwaps) {
+		if pend.loopOut != nil {
+			swap, err := resumeLoopOutSwap(swapCfg, pend.loopOut)
+			if err != nil {
+				newSwapLogger(pend.loopOut.Hash).Errorf(
+					"Unable to resume loop out: %v", err,
+				)
+				failedLoopOuts = append(
+					failedLoopOuts, pend.loopOut,
+				)
+				continue
+			}
+
+			if !s.registerAbandonChan(swap.hash, swap.abandonChan) {
+				continue
+			}
+
+			s.executor.initiateSwap(ctx, swap)
+			continue
+		}
+
+		swap, err := resumeLoopInSwap(ctx, swapCfg, pend.loopIn)
 		if err != nil {
-			log.Errorf("resuming loop in swap: %v", err)
+			newSwapLogger(pend.loopIn.Hash).Errorf(
+				"Unable to resume loop in: %v", err,
+			)
+			failedLoopIns = append(failedLoopIns, pend.loopIn)
 			continue
 		}
 
-		// Store the swap's abandon channel so that the client can
-		// abandon the swap by providing the swap hash.
-		s.executor.Lock()
-		s.abandonChans[swap.hash] = swap.abandonChan
-		s.executor.Unlock()
+		if !s.registerAbandonChan(swap.hash, swap.abandonChan) {
+			continue
+		}
 
 		s.executor.initiateSwap(ctx, swap)
 	}
+
+	return failedLoopOuts, failedLoopIns
+}
+
+// registerAbandonChan stores the abandon channel of a resumed swap, so that
+// the client can abandon the swap by providing the swap hash. It returns false
+// if the swap was abandoned while it waited to be resumed, in which case it
+// must not be resumed.
+func (s *Client) registerAbandonChan(hash lntypes.Hash,
+	abandonChan chan struct{}) bool {
+
+	s.executor.Lock()
+	defer s.executor.Unlock()
+
+	if _, ok := s.abandoned[hash]; ok {
+		newSwapLogger(hash).Infof("Not resuming abandoned swap")
+
+		return false
+	}
+
+	s.abandonChans[hash] = abandonChan
+
+	return true
+}
+
+// retryResumeSwaps retries to resume the swaps that failed to be resumed at
+// startup, for example because lnd wasn't fully started yet. The delay
+// between the attempts is doubled after every attempt. Swaps that still can't
+// be resumed after the configured number of retries are given up on until the
+// next restart.
+func (s *Client) retryResumeSwaps(ctx context.Context,
+	loopOutSwaps []*loopdb.LoopOut, loopInSwaps []*loopdb.LoopIn) {
+
+	delay := resumeRetryDelay
+	for i := 0; i < s.ResumeRetries; i++ {
+		if len(loopOutSwaps) == 0 && len(loopInSwaps) == 0 {
+			return
+		}
+
+		log.Infof("Retrying to resume %v loop out and %v loop in "+
+			"swaps in %v", len(loopOutSwaps), len(loopInSwaps),
+			delay)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+
+		delay *= 2
+
+		loopOutSwaps, loopInSwaps = s.resumeSwaps(
+			ctx, loopOutSwaps, loopInSwaps,
+		)
+	}
+
+	for _, pend := range loopOutSwaps {
+		newSwapLogger(pend.Hash).Errorf("Unable to resume loop out, " +
+			"giving up until the next restart")
+	}
+
+	for _, pend := range loopInSwaps {
+		newSwapLogger(pend.Hash).Errorf("Unable to resume loop in, " +
+			"giving up until the next restart")
+	}
 }
 
 // LoopOut initiates a loop out swap. It blocks until the swap is initiation
@@ -430,26 +892,107 @@ func (s *Client) LoopOut(globalCtx context.Context,
 		request.Amount, request.DestAddr, request.OutgoingChanSet,
 	)
 
+	if s.Paused() {
+		return nil, ErrPaused
+	}
+
+	// The swap would hold chain subscriptions until it completes, so it
+	// is refused before the server is contacted if they are all taken.
+	if err := s.chainNotifier.checkLimit(); err != nil {
+		return nil, err
+	}
+
 	if err := s.waitForInitialized(globalCtx); err != nil {
 		return nil, err
 	}
 
-	// Calculate htlc expiry height.
-	terms, err := s.Server.GetLoopOutTerms(globalCtx, request.Initiator)
+	if err := checkQuoteAge(request, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := validateSweepFeeRate(request.SweepFeeRate); err != nil {
+		return nil, err
+	}
+
+	if len(request.Metadata) > loopdb.MaxMetadataSize {
+		return nil, loopdb.ErrMetadataTooLarge
+	}
+
+	if request.ClientID != "" {
+		_, err := s.Store.FetchLoopOutSwapByClientID(
+			globalCtx, request.ClientID,
+		)
+		switch {
+		case err == nil:
+			return nil, ErrDuplicateClientID
+
+		case !errors.Is(err, loopdb.ErrSwapNotFound):
+			return nil, err
+		}
+	}
+
+	terms, err := s.LoopOutTerms(globalCtx, request.Initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.RoundAmount {
+		request.Amount, err = roundSwapAmount(request.Amount, terms)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	release, err := s.reserveOutstandingValue(globalCtx, request.Amount)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
+	// Calculate htlc expiry height.
 	initiationHeight := s.executor.height()
 	request.Expiry, err = s.getExpiry(
 		initiationHeight, terms, request.SweepConfTarget,
+		request.SwapExpiryDelta,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := s.resolveOutgoingPeers(globalCtx, request); err != nil {
+		return nil, err
+	}
+
+	if err := s.setWalletDestAddr(globalCtx, request); err != nil {
+		return nil, err
+	}
+
+	// The destination is checked before the swap is registered with the
+	// server, so that no payment is made for a swap that can't be swept.
+	err = validateDestAddr(request.DestAddr, s.lndServices.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkQuoteMinerFee(globalCtx, request); err != nil {
+		return nil, err
+	}
+
+	// If requested, make sure that the swap can be paid before the swap
+	// is registered with the server.
+	if request.ProbeBeforePay {
+		err := probeLoopOut(
+			globalCtx, s.lndServices, s.Server, request,
+			s.LoopOutMaxParts,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Create a new swap object for this swap.
 	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+	swapCfg.preimages = s.PreimageGenerator
 	initResult, err := newLoopOutSwap(
 		globalCtx, swapCfg, initiationHeight, request,
 	)
@@ -458,6 +1001,16 @@ func (s *Client) LoopOut(globalCtx context.Context,
 	}
 	swap := initResult.swap
 
+	s.executor.Lock()
+	s.abandonChans[swap.hash] = swap.abandonChan
+	s.executor.Unlock()
+
+	// Record the balance of the targeted channels before the swap pays
+	// the server.
+	s.liquidity.swapInitiated(
+		globalCtx, swap.hash, swap.OutgoingChanSet, nil,
+	)
+
 	// Post swap to the main loop.
 	s.executor.initiateSwap(globalCtx, swap)
 
@@ -465,112 +1018,703 @@ func (s *Client) LoopOut(globalCtx context.Context,
 	// stream.
 	return &LoopOutSwapInfo{
 		SwapHash:      swap.hash,
+		Amount:        request.Amount,
 		HtlcAddress:   swap.htlc.Address,
 		ServerMessage: initResult.serverMessage,
 	}, nil
 }
 
-// getExpiry returns an absolute expiry height based on the sweep confirmation
-// target, constrained by the server terms.
-func (s *Client) getExpiry(height int32, terms *LoopOutTerms,
-	confTarget int32) (int32, error) {
+// resolveOutgoingPeers adds the open channels with the request's outgoing
+// peers to its outgoing channel set. It fails if there is no channel with one
+// of the peers.
+func (s *Client) resolveOutgoingPeers(ctx context.Context,
+	request *OutRequest) error {
 
-	switch {
-	case confTarget < terms.MinCltvDelta:
-		return height + terms.MinCltvDelta, nil
+	if len(request.OutgoingPeers) == 0 {
+		return nil
+	}
 
-	case confTarget > terms.MaxCltvDelta:
-		return 0, fmt.Errorf("confirmation target %v exceeds maximum "+
-			"server cltv delta of %v", confTarget,
-			terms.MaxCltvDelta)
+	channels, err := s.lndServices.Client.ListChannels(ctx, false, false)
+	if err != nil {
+		return err
 	}
 
-	return height + confTarget, nil
-}
+	chanSet := make(map[uint64]struct{}, len(request.OutgoingChanSet))
+	for _, chanID := range request.OutgoingChanSet {
+		chanSet[chanID] = struct{}{}
+	}
 
-// LoopOutQuote takes a LoopOut amount and returns a break down of estimated
-// costs for the client. Both the swap server and the on-chain fee estimator
-// are queried to get to build the quote response.
-func (s *Client) LoopOutQuote(ctx context.Context,
-	request *LoopOutQuoteRequest) (*LoopOutQuote, error) {
+	for _, peer := range request.OutgoingPeers {
+		found := false
+		for _, channel := range channels {
+			if channel.PubKeyBytes != peer {
+				continue
+			}
+
+			found = true
+			if _, ok := chanSet[channel.ChannelID]; ok {
+				continue
+			}
+
+			chanSet[channel.ChannelID] = struct{}{}
+			request.OutgoingChanSet = append(
+				request.OutgoingChanSet, channel.ChannelID,
+			)
+		}
 
-	terms, err := s.Server.GetLoopOutTerms(ctx, request.Initiator)
-	if err != nil {
-		return nil, err
+		if !found {
+			return fmt.Errorf("no open channels with peer %v", peer)
+		}
 	}
 
-	if request.Amount < terms.MinSwapAmount {
-		return nil, ErrSwapAmountTooLow
-	}
+	return nil
+}
 
-	if request.Amount > terms.MaxSwapAmount {
-		return nil, ErrSwapAmountTooHigh
+// setWalletDestAddr sets the destination of a loop out request without one to
+// a new address of lnd's wallet, so that the swapped funds return to the node.
+func (s *Client) setWalletDestAddr(ctx context.Context,
+	request *OutRequest) error {
+
+	if request.DestAddr != nil {
+		return nil
 	}
 
-	height := s.executor.height()
-	expiry, err := s.getExpiry(height, terms, request.SweepConfTarget)
-	if err != nil {
-		return nil, err
+	addrType := s.DestAddrType
+	if addrType == walletrpc.AddressType_UNKNOWN {
+		addrType = walletrpc.AddressType_WITNESS_PUBKEY_HASH
 	}
 
-	quote, err := s.Server.GetLoopOutQuote(
-		ctx, request.Amount, expiry, request.SwapPublicationDeadline,
-		request.Initiator,
-	)
+	addr, err := s.lndServices.WalletKit.NextAddr(ctx, "", addrType, false)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("NextAddr error: %v", err)
 	}
 
-	log.Infof("Offchain swap destination: %x", quote.SwapPaymentDest)
+	log.Infof("Loop out destination set to wallet address %v", addr)
 
-	minerFee, err := s.getLoopOutSweepFee(ctx, request.SweepConfTarget)
-	if err != nil {
-		return nil, err
-	}
+	request.DestAddr = addr
+	request.IsExternalAddr = false
 
-	return &LoopOutQuote{
-		SwapFee:         quote.SwapFee,
+	return nil
+}
+
+// validateDestAddr checks that the destination address is a well-formed
+// address of a supported type for the given network. Taproot addresses are
+// expected in their bech32m encoding.
+func validateDestAddr(addr btcutil.Address, params *chaincfg.Params) error {
+	if !addr.IsForNet(params) {
+		return fmt.Errorf("%w: %v, active network is %v",
+			ErrDestAddrWrongNetwork, addr, params.Name)
+	}
+
+	switch addr.(type) {
+	case *btcutil.AddressTaproot,
+		*btcutil.AddressWitnessScriptHash,
+		*btcutil.AddressWitnessPubKeyHash,
+		*btcutil.AddressScriptHash,
+		*btcutil.AddressPubKeyHash:
+
+	default:
+		return fmt.Errorf("%w: %T", ErrDestAddrUnsupported, addr)
+	}
+
+	// Decoding the encoded address checks its checksum and witness program
+	// with the encoding rules of its witness version, bech32 for v0 and
+	// bech32m for taproot.
+	decoded, err := btcutil.DecodeAddress(addr.EncodeAddress(), params)
+	if err != nil {
+		return fmt.Errorf("invalid destination address %v: %v",
+			addr, err)
+	}
+
+	if !bytes.Equal(decoded.ScriptAddress(), addr.ScriptAddress()) {
+		return fmt.Errorf("invalid destination address %v", addr)
+	}
+
+	return nil
+}
+
+// checkQuoteAge returns ErrQuoteStale if the request is based on a quote that
+// is older than the request's maximum quote age.
+func checkQuoteAge(request *OutRequest, now time.Time) error {
+	if request.Quote == nil || request.MaxQuoteAge == 0 {
+		return nil
+	}
+
+	age := now.Sub(request.Quote.Time)
+	if age > request.MaxQuoteAge {
+		log.Warnf("Quote age %v exceeds maximum of %v", age,
+			request.MaxQuoteAge)
+
+		return ErrQuoteStale
+	}
+
+	return nil
+}
+
+// checkQuoteMinerFee re-estimates the sweep fee of a swap that is based on a
+// quote and returns ErrQuoteStale if it exceeds the quoted miner fee by more
+// than quoteMinerFeeTolerance percent.
+func (s *Client) checkQuoteMinerFee(ctx context.Context,
+	request *OutRequest) error {
+
+	if request.Quote == nil {
+		return nil
+	}
+
+	minerFee, err := s.getSweepFee(
+		ctx, request.SweepConfTarget, request.SweepFeeRate,
+		request.DestAddr,
+	)
+	if err != nil {
+		return err
+	}
+
+	quoted := request.Quote.MinerFee
+	maxMinerFee := quoted + quoted*quoteMinerFeeTolerance/100
+	if minerFee > maxMinerFee {
+		log.Warnf("Miner fee %v exceeding quoted fee of %v", minerFee,
+			quoted)
+
+		return ErrQuoteStale
+	}
+
+	return nil
+}
+
+// roundSwapAmount rounds the given amount down to the nearest multiple of the
+// amount increment of the terms. A zero increment leaves the amount unchanged.
+// An error is returned if the rounded amount is below the server minimum.
+func roundSwapAmount(amt btcutil.Amount, terms *LoopOutTerms) (btcutil.Amount,
+	error) {
+
+	if terms.AmountIncrement > 0 {
+		amt -= amt % terms.AmountIncrement
+	}
+
+	if amt <= 0 || amt < terms.MinSwapAmount {
+		return 0, ErrSwapAmountTooLow
+	}
+
+	return amt, nil
+}
+
+// reserveOutstandingValue reserves the amount of a new swap against the
+// maximum outstanding value. It returns an error if the swap would push the
+// total value of all pending swaps and reservations over the configured
+// maximum. The returned function releases the reservation. It must be called
+// once the swap was stored, from where it counts towards the outstanding
+// value, or once its initiation failed. The lock is only held for the check,
+// so that swaps are initiated with the server concurrently.
+func (s *Client) reserveOutstandingValue(ctx context.Context,
+	amt btcutil.Amount) (func(), error) {
+
+	if s.MaxOutstandingValue == 0 {
+		return func() {}, nil
+	}
+
+	s.outstandingMtx.Lock()
+	defer s.outstandingMtx.Unlock()
+
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outstanding := outstandingSwapValue(loopOutSwaps, loopInSwaps) +
+		s.reservedValue
+	if outstanding+amt > s.MaxOutstandingValue {
+		log.Warnf("Swap amount %v with %v outstanding exceeds "+
+			"maximum outstanding value of %v", amt, outstanding,
+			s.MaxOutstandingValue)
+
+		return nil, ErrMaxOutstandingValueExceeded
+	}
+
+	s.reservedValue += amt
+
+	return func() {
+		s.outstandingMtx.Lock()
+		defer s.outstandingMtx.Unlock()
+
+		s.reservedValue -= amt
+	}, nil
+}
+
+// outstandingSwapValue returns the total amount requested by all swaps in the
+// given sets that are still pending.
+func outstandingSwapValue(loopOutSwaps []*loopdb.LoopOut,
+	loopInSwaps []*loopdb.LoopIn) btcutil.Amount {
+
+	var total btcutil.Amount
+	for _, out := range loopOutSwaps {
+		if out.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		total += out.Contract.AmountRequested
+	}
+
+	for _, in := range loopInSwaps {
+		if in.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		total += in.Contract.AmountRequested
+	}
+
+	return total
+}
+
+// getExpiry returns an absolute expiry height based on the sweep confirmation
+// target and the preferred expiry delta, constrained by the server terms.
+func (s *Client) getExpiry(height int32, terms *LoopOutTerms,
+	confTarget, preferredDelta int32) (int32, error) {
+
+	if confTarget > terms.MaxCltvDelta {
+		return 0, fmt.Errorf("confirmation target %v exceeds maximum "+
+			"server cltv delta of %v", confTarget,
+			terms.MaxCltvDelta)
+	}
+
+	// We can't accept an expiry that is sooner than our sweep
+	// confirmation target, because we may not be able to sweep in time.
+	delta := confTarget
+	if preferredDelta > delta {
+		delta = preferredDelta
+	}
+
+	switch {
+	case delta < terms.MinCltvDelta:
+		delta = terms.MinCltvDelta
+
+	case delta > terms.MaxCltvDelta:
+		log.Infof("Preferred expiry delta %v exceeds maximum server "+
+			"cltv delta, using %v", delta, terms.MaxCltvDelta)
+
+		delta = terms.MaxCltvDelta
+	}
+
+	return height + delta, nil
+}
+
+// LoopOutQuote takes a LoopOut amount and returns a break down of estimated
+// costs for the client. Both the swap server and the on-chain fee estimator
+// are queried to get to build the quote response.
+func (s *Client) LoopOutQuote(ctx context.Context,
+	request *LoopOutQuoteRequest) (*LoopOutQuote, error) {
+
+	terms, err := s.LoopOutTerms(ctx, request.Initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSweepFeeRate(request.SweepFeeRate); err != nil {
+		return nil, err
+	}
+
+	amt := request.Amount
+	if request.RoundAmount {
+		amt, err = roundSwapAmount(amt, terms)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if amt < terms.MinSwapAmount {
+		return nil, ErrSwapAmountTooLow
+	}
+
+	if amt > terms.MaxSwapAmount {
+		return nil, ErrSwapAmountTooHigh
+	}
+
+	height := s.executor.height()
+	expiry, err := s.getExpiry(
+		height, terms, request.SweepConfTarget,
+		request.SwapExpiryDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.Server.GetLoopOutQuote(
+		ctx, amt, expiry, request.SwapPublicationDeadline,
+		request.Initiator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Offchain swap destination: %x", quote.SwapPaymentDest)
+
+	minerFee, err := s.getLoopOutSweepFee(
+		ctx, request.SweepConfTarget, request.SweepFeeRate,
+		request.DestAddrType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoopOutQuote{
+		Amount:          amt,
+		SwapFee:         quote.SwapFee,
 		MinerFee:        minerFee,
 		PrepayAmount:    quote.PrepayAmount,
 		SwapPaymentDest: quote.SwapPaymentDest,
+		Time:            time.Now(),
 	}, nil
 }
 
 // getLoopOutSweepFee is a helper method to estimate the loop out htlc sweep
-// fee to a p2wsh address.
-func (s *Client) getLoopOutSweepFee(ctx context.Context, confTarget int32) (
+// fee to an address of the given type. If a fee rate is given, the fee is
+// calculated with it instead of the fee rate estimated for the conf target.
+func (s *Client) getLoopOutSweepFee(ctx context.Context, confTarget int32,
+	feeRate chainfee.SatPerKWeight, addrType walletrpc.AddressType) (
 	btcutil.Amount, error) {
 
-	// Generate dummy p2wsh address for fee estimation. The p2wsh address
-	// type is chosen because it adds the most weight of all output types
-	// and we want the quote to return a worst case value.
+	sweepAddress, err := s.quoteSweepAddressOfType(addrType)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.getSweepFee(ctx, confTarget, feeRate, sweepAddress)
+}
+
+// getSweepFee estimates the loop out htlc sweep fee to the given address. If a
+// fee rate is given, the fee is calculated with it instead of the fee rate
+// estimated for the conf target.
+func (s *Client) getSweepFee(ctx context.Context, confTarget int32,
+	feeRate chainfee.SatPerKWeight, sweepAddress btcutil.Address) (
+	btcutil.Amount, error) {
+
+	if feeRate != 0 {
+		return s.sweeper.GetSweepFeeForRate(
+			quoteHtlc().AddSuccessToEstimator, sweepAddress,
+			feeRate,
+		)
+	}
+
+	return s.sweeper.GetSweepFee(
+		ctx, quoteHtlc().AddSuccessToEstimator, sweepAddress,
+		confTarget,
+	)
+}
+
+// validateSweepFeeRate checks that a requested sweep fee rate can be relayed.
+// A zero fee rate is valid, as it means that the fee rate is estimated.
+func validateSweepFeeRate(feeRate chainfee.SatPerKWeight) error {
+	if feeRate != 0 && feeRate < chainfee.FeePerKwFloor {
+		return fmt.Errorf("sweep fee rate %v is below the minimum "+
+			"relay fee rate %v", feeRate, chainfee.FeePerKwFloor)
+	}
+
+	return nil
+}
+
+// quoteSweepAddress returns a dummy p2wsh address for fee estimation. The
+// p2wsh address type is chosen because it adds the most weight of all output
+// types and we want the quote to return a worst case value.
+func (s *Client) quoteSweepAddress() (btcutil.Address, error) {
 	wsh := [32]byte{}
-	p2wshAddress, err := btcutil.NewAddressWitnessScriptHash(
+	return btcutil.NewAddressWitnessScriptHash(
 		wsh[:], s.lndServices.ChainParams,
 	)
+}
+
+// quoteSweepAddressOfType returns a dummy address of the given type for fee
+// estimation. Unknown address types fall back to the worst case p2wsh address
+// of quoteSweepAddress.
+func (s *Client) quoteSweepAddressOfType(addrType walletrpc.AddressType) (
+	btcutil.Address, error) {
+
+	params := s.lndServices.ChainParams
+
+	switch addrType {
+	case walletrpc.AddressType_WITNESS_PUBKEY_HASH:
+		wpkh := [20]byte{}
+		return btcutil.NewAddressWitnessPubKeyHash(wpkh[:], params)
+
+	case walletrpc.AddressType_NESTED_WITNESS_PUBKEY_HASH:
+		sh := [20]byte{}
+		return btcutil.NewAddressScriptHashFromHash(sh[:], params)
+
+	case walletrpc.AddressType_TAPROOT_PUBKEY:
+		tr := [32]byte{}
+		return btcutil.NewAddressTaproot(tr[:], params)
+
+	default:
+		return s.quoteSweepAddress()
+	}
+}
+
+// EstimateBatchMinerFees returns the total on-chain fee that is expected for
+// sweeping the htlcs of the given loop out requests. Like in the sweep
+// batcher, sweeps to wallet addresses are expected to share a single sweep tx
+// while sweeps to external addresses are published on their own. The shared
+// sweep tx is swept with the highest fee rate that the requests ask for, or
+// else estimated with their lowest confirmation target. Its output is
+// estimated for the destination address of the first request that takes part
+// in it, or for a wallet address of the configured type.
+func (s *Client) EstimateBatchMinerFees(ctx context.Context,
+	requests []*OutRequest) (btcutil.Amount, error) {
+
+	var (
+		totalFee        btcutil.Amount
+		batched         int
+		batchConfTarget int32
+		batchFeeRate    chainfee.SatPerKWeight
+		batchDestAddr   btcutil.Address
+	)
+	for i, request := range requests {
+		if request.IsExternalAddr {
+			if request.DestAddr == nil {
+				return 0, fmt.Errorf("request %v: external "+
+					"address not set", i)
+			}
+
+			fee, err := s.getSweepFee(
+				ctx, request.SweepConfTarget,
+				request.SweepFeeRate, request.DestAddr,
+			)
+			if err != nil {
+				return 0, err
+			}
+
+			totalFee += fee
+
+			continue
+		}
+
+		if batched == 0 || request.SweepConfTarget < batchConfTarget {
+			batchConfTarget = request.SweepConfTarget
+		}
+		if request.SweepFeeRate > batchFeeRate {
+			batchFeeRate = request.SweepFeeRate
+		}
+		if batched == 0 {
+			batchDestAddr = request.DestAddr
+		}
+		batched++
+	}
+
+	if batched == 0 {
+		return totalFee, nil
+	}
+
+	// Without an address, the batch sweeps to a new wallet address of the
+	// same type that setWalletDestAddr generates.
+	if batchDestAddr == nil {
+		addrType := s.DestAddrType
+		if addrType == walletrpc.AddressType_UNKNOWN {
+			addrType = walletrpc.AddressType_WITNESS_PUBKEY_HASH
+		}
+
+		var err error
+		batchDestAddr, err = s.quoteSweepAddressOfType(addrType)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var (
+		batchFee btcutil.Amount
+		err      error
+	)
+	if batchFeeRate != 0 {
+		batchFee, err = s.sweeper.GetBatchSweepFeeForRate(
+			quoteHtlc().AddSuccessToEstimator, batched,
+			batchDestAddr, batchFeeRate,
+		)
+	} else {
+		batchFee, err = s.sweeper.GetBatchSweepFee(
+			ctx, quoteHtlc().AddSuccessToEstimator, batched,
+			batchDestAddr, batchConfTarget,
+		)
+	}
 	if err != nil {
 		return 0, err
 	}
 
+	return totalFee + batchFee, nil
+}
+
+// LoopOutSweepFees estimates the loop out htlc sweep fee for each of the
+// given destination addresses. This allows comparing the sweep costs of
+// different destination address types with a single fee rate query. The
+// returned fees are in the same order as the passed addresses.
+func (s *Client) LoopOutSweepFees(ctx context.Context, confTarget int32,
+	destAddrs []btcutil.Address) ([]btcutil.Amount, error) {
+
+	return s.sweeper.GetSweepFees(
+		ctx, quoteHtlc().AddSuccessToEstimator, destAddrs, confTarget,
+	)
+}
+
+// quoteHtlc returns the dummy htlc that is used to estimate the weight of the
+// htlc input of a loop out sweep for the current protocol version.
+func quoteHtlc() *swap.Htlc {
 	scriptVersion := utils.GetHtlcScriptVersion(
 		loopdb.CurrentProtocolVersion(),
 	)
 
-	htlc := swap.QuoteHtlcP2TR
 	if scriptVersion != swap.HtlcV3 {
-		htlc = swap.QuoteHtlcP2WSH
+		return swap.QuoteHtlcP2WSH
 	}
 
-	return s.sweeper.GetSweepFee(
-		ctx, htlc.AddSuccessToEstimator, p2wshAddress, confTarget,
+	return swap.QuoteHtlcP2TR
+}
+
+// MaxLoopOutAmount returns the largest loop out amount that is accepted by the
+// server, can be routed with the local balance of our active channels and
+// still covers the swap and miner fees for sweeping with the given
+// confirmation target.
+func (s *Client) MaxLoopOutAmount(ctx context.Context, confTarget int32) (
+	btcutil.Amount, error) {
+
+	terms, err := s.LoopOutTerms(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+
+	channels, err := s.lndServices.Client.ListChannels(ctx, true, false)
+	if err != nil {
+		return 0, err
+	}
+
+	maxParts := s.LoopOutMaxParts
+	if maxParts == 0 {
+		maxParts = 1
+	}
+
+	routable := routableBalance(channels, int(maxParts))
+
+	amt := routable
+	if amt > terms.MaxSwapAmount {
+		amt = terms.MaxSwapAmount
+	}
+
+	if amt < terms.MinSwapAmount {
+		return 0, ErrSwapAmountTooLow
+	}
+
+	height := s.executor.height()
+	expiry, err := s.getExpiry(height, terms, confTarget, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	quote, err := s.Server.GetLoopOutQuote(
+		ctx, amt, expiry, time.Time{}, "",
 	)
+	if err != nil {
+		return 0, err
+	}
+
+	// The off-chain payments include the swap fee on top of the swap
+	// amount. The swap fee for a smaller amount won't be higher, so we
+	// can safely deduct the fee quoted for the larger amount.
+	if amt+quote.SwapFee > routable {
+		amt = routable - quote.SwapFee
+	}
+
+	if amt < terms.MinSwapAmount {
+		return 0, ErrSwapAmountTooLow
+	}
+
+	minerFee, err := s.getLoopOutSweepFee(
+		ctx, confTarget, 0, walletrpc.AddressType_UNKNOWN,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if amt <= quote.SwapFee+minerFee {
+		return 0, ErrSwapAmountUneconomical
+	}
+
+	return amt, nil
+}
+
+// routableBalance returns the amount that we can send off-chain using at most
+// maxParts of the given channels, assuming that each part is sent over a
+// different channel. The channel reserve is deducted from the local balance
+// of every channel.
+func routableBalance(channels []lndclient.ChannelInfo,
+	maxParts int) btcutil.Amount {
+
+	balances := make([]btcutil.Amount, 0, len(channels))
+	for _, channel := range channels {
+		balance := channel.LocalBalance
+		if channel.LocalConstraints != nil {
+			balance -= channel.LocalConstraints.Reserve
+		}
+
+		if balance <= 0 {
+			continue
+		}
+
+		balances = append(balances, balance)
+	}
+
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i] > balances[j]
+	})
+
+	if len(balances) > maxParts {
+		balances = balances[:maxParts]
+	}
+
+	var total btcutil.Amount
+	for _, balance := range balances {
+		total += balance
+	}
+
+	return total
 }
 
-// LoopOutTerms returns the terms on which the server executes swaps.
+// LoopOutTerms returns the terms on which the server executes swaps. The terms
+// are recorded in the terms history if they changed.
 func (s *Client) LoopOutTerms(ctx context.Context, initiator string) (
 	*LoopOutTerms, error) {
 
-	return s.Server.GetLoopOutTerms(ctx, initiator)
+	terms, err := s.Server.GetLoopOutTerms(ctx, initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	// The history is only kept for auditing, so failing to record the
+	// terms doesn't fail the request.
+	err = s.termsHistory.add(ctx, terms, time.Now())
+	if err != nil {
+		log.Warnf("Unable to record loop out terms: %v", err)
+	}
+
+	return terms, nil
+}
+
+// SubscribeSwaps returns a stream of the swap updates that pass the filter.
+// The last update of every matching swap that was updated since the client
+// started is delivered first. Each subscriber has its own stream, which is
+// buffered so that a slow subscriber doesn't hold back the swaps. The
+// subscription ends when the context is canceled.
+func (s *Client) SubscribeSwaps(ctx context.Context,
+	filter SwapFilter) <-chan SwapInfo {
+
+	return s.subscriptions.subscribe(ctx, filter)
+}
+
+// TermsHistory returns the loop out terms that were observed from the
+// server, oldest first. A snapshot is only recorded when the terms changed,
+// along with the time at which the new terms were first fetched. The history
+// is stored, so it includes the terms that were observed before the client
+// was started.
+func (s *Client) TermsHistory(ctx context.Context) ([]TermsSnapshot, error) {
+	return s.termsHistory.history(ctx)
 }
 
 // waitForInitialized for swaps to be resumed and executor ready.
@@ -590,6 +1734,97 @@ func (s *Client) waitForInitialized(ctx context.Context) error {
 	return nil
 }
 
+// Pause stops the initiation of new swaps until Unpause is called. Swaps that
+// are already in flight keep running. Because autoloop dispatches its swaps
+// through LoopOut and LoopIn, it is paused as well.
+func (s *Client) Pause() {
+	if atomic.CompareAndSwapUint32(&s.paused, 0, 1) {
+		log.Infof("Swap initiation paused")
+	}
+}
+
+// Unpause allows the initiation of new swaps again after a call to Pause.
+func (s *Client) Unpause() {
+	if atomic.CompareAndSwapUint32(&s.paused, 1, 0) {
+		log.Infof("Swap initiation resumed")
+	}
+}
+
+// Paused returns whether the initiation of new swaps is currently paused.
+func (s *Client) Paused() bool {
+	return atomic.LoadUint32(&s.paused) == 1
+}
+
+// LiquidityEffect returns the local balance of the channels that the swap
+// targeted, as recorded at initiation and at completion of the swap. It is
+// only available for swaps with an outgoing channel set or a last hop that
+// were initiated since the client was started. Otherwise,
+// ErrNoLiquidityEffect is returned.
+func (s *Client) LiquidityEffect(hash lntypes.Hash) (*LiquidityEffect,
+	error) {
+
+	effect, ok := s.liquidity.effect(hash)
+	if !ok {
+		return nil, ErrNoLiquidityEffect
+	}
+
+	return effect, nil
+}
+
+// ClientStatus is a snapshot of the health of the client.
+type ClientStatus struct {
+	// Height is the latest block height that the client learned of. It
+	// is zero until the executor received its first block from lnd.
+	Height int32
+
+	// ChainSynced indicates whether the executor is subscribed to lnd's
+	// block notifications and knows the current block height.
+	ChainSynced bool
+
+	// ServerConnState is the state of the connection to the swap server.
+	ServerConnState connectivity.State
+
+	// ActiveSwaps is the number of swaps that are being executed.
+	ActiveSwaps int
+
+	// Paused indicates whether the initiation of new swaps is paused.
+	Paused bool
+
+	// LastError is the last error that a swap execution failed with, or
+	// nil if no swap failed since the client was started.
+	LastError error
+}
+
+// Status returns a snapshot of the health of the client.
+func (s *Client) Status() *ClientStatus {
+	clientStatus := &ClientStatus{
+		Height:          s.executor.height(),
+		ServerConnState: connectivity.Shutdown,
+		ActiveSwaps:     s.executor.activeSwapCount(),
+		Paused:          s.Paused(),
+		LastError:       s.executor.lastError(),
+	}
+
+	select {
+	case <-s.executor.ready:
+		clientStatus.ChainSynced = true
+	default:
+	}
+
+	if s.clientConfig.Conn != nil {
+		clientStatus.ServerConnState = s.clientConfig.Conn.GetState()
+	}
+
+	return clientStatus
+}
+
+// DebugDump returns a snapshot of the internal state of the swap executor,
+// which includes the swaps that are being executed and the swaps that are
+// queued for execution. It is safe to call concurrently.
+func (s *Client) DebugDump() *ExecutorDebugState {
+	return s.executor.debugState()
+}
+
 // LoopIn initiates a loop in swap.
 func (s *Client) LoopIn(globalCtx context.Context,
 	request *LoopInRequest) (*LoopInSwapInfo, error) {
@@ -599,13 +1834,40 @@ func (s *Client) LoopIn(globalCtx context.Context,
 		request.LastHop,
 	)
 
+	if s.Paused() {
+		return nil, ErrPaused
+	}
+
+	if len(request.Metadata) > loopdb.MaxMetadataSize {
+		return nil, loopdb.ErrMetadataTooLarge
+	}
+
+	// The memo is checked before the swap is created, so that no key is
+	// derived for a swap whose invoice lnd would reject.
+	if len(request.InvoiceMemo) > MaxInvoiceMemoSize {
+		return nil, ErrInvoiceMemoTooLong
+	}
+
 	if err := s.waitForInitialized(globalCtx); err != nil {
 		return nil, err
 	}
 
+	// The swap would hold chain subscriptions until it completes, so it
+	// is refused before the server is contacted if they are all taken.
+	if err := s.chainNotifier.checkLimit(); err != nil {
+		return nil, err
+	}
+
+	release, err := s.reserveOutstandingValue(globalCtx, request.Amount)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	// Create a new swap object for this swap.
 	initiationHeight := s.executor.height()
 	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+	swapCfg.preimages = s.PreimageGenerator
 	initResult, err := newLoopInSwap(
 		globalCtx, swapCfg, initiationHeight, request,
 	)
@@ -618,6 +1880,10 @@ func (s *Client) LoopIn(globalCtx context.Context,
 	s.abandonChans[swap.hash] = swap.abandonChan
 	s.executor.Unlock()
 
+	// Record the balance of the channels with the last hop before the
+	// server pays us.
+	s.liquidity.swapInitiated(globalCtx, swap.hash, nil, swap.LastHop)
+
 	// Post swap to the main loop.
 	s.executor.initiateSwap(globalCtx, swap)
 
@@ -794,8 +2060,12 @@ func (s *Client) Probe(ctx context.Context, req *ProbeRequest) error {
 	)
 }
 
-// AbandonSwap sends a signal on the abandon channel of the swap identified by
-// the passed swap hash. This will cause the swap to abandon itself.
+// AbandonSwap moves the pending swap identified by the passed swap hash to the
+// abandoned state, so that it isn't resumed anymore. An executing swap is
+// signaled to abandon itself. A swap that isn't executing, for example because
+// it failed to resume, is abandoned in the store directly. A loop out can only
+// be abandoned while it waits for the server's htlc, as its preimage may be
+// revealed afterwards.
 func (s *Client) AbandonSwap(ctx context.Context,
 	req *AbandonSwapRequest) error {
 
@@ -803,16 +2073,339 @@ func (s *Client) AbandonSwap(ctx context.Context,
 		return errors.New("no request provided")
 	}
 
+	info, err := s.SwapInfo(ctx, req.SwapHash)
+	if err != nil {
+		return err
+	}
+
+	if !info.State.IsPending() {
+		return fmt.Errorf("cannot abandon swap in state %v", info.State)
+	}
+
+	// Once the server's htlc is published, the preimage of a loop out may
+	// be revealed and the swap must be swept.
+	if info.SwapType.IsOut() && info.State != loopdb.StateInitiated {
+		return fmt.Errorf("cannot abandon loop out in state %v",
+			info.State)
+	}
+
+	s.executor.Lock()
+
+	abandonChan, executing := s.abandonChans[req.SwapHash]
+	if executing {
+		defer s.executor.Unlock()
+
+		select {
+		case abandonChan <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			// This is to avoid writing to a full channel.
+		}
+
+		return nil
+	}
+
+	// The swap isn't executing, so we record its abandonment ourselves.
+	// Holding the executor's lock keeps a pending retry from resuming the
+	// swap in the meantime.
+	info.State = loopdb.StateFailAbandoned
+	info.LastUpdate = time.Now()
+	stateData := loopdb.SwapStateData{
+		State:      info.State,
+		Cost:       info.Cost,
+		HtlcTxHash: info.HtlcTxHash,
+	}
+
+	if info.SwapType.IsOut() {
+		err = s.Store.UpdateLoopOut(
+			ctx, req.SwapHash, info.LastUpdate, stateData,
+		)
+	} else {
+		err = s.Store.UpdateLoopIn(
+			ctx, req.SwapHash, info.LastUpdate, stateData,
+		)
+	}
+	if err == nil {
+		s.abandoned[req.SwapHash] = struct{}{}
+	}
+
+	s.executor.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	newSwapLogger(req.SwapHash).Infof("Abandoned swap in state %v",
+		info.State)
+
+	return s.executor.sendStatus(ctx, *info)
+}
+
+// CancelSwap cancels a loop out swap whose htlc hasn't confirmed yet. The
+// swap is failed with StateFailAbandoned right away and its preimage is never
+// revealed, so the server can't settle the swap payment. Payments that are
+// still in flight fail once the server cancels them, until then the swap
+// remains in the executor. A prepayment that the server already settled is
+// lost.
+func (s *Client) CancelSwap(ctx context.Context, hash lntypes.Hash) error {
+	swp, err := s.Store.FetchLoopOutSwap(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	if state := swp.State().State; state != loopdb.StateInitiated {
+		return fmt.Errorf("%w: swap %v in state %v",
+			ErrSwapNotCancelable, hash, state)
+	}
+
 	s.executor.Lock()
 	defer s.executor.Unlock()
 
+	abandonChan, ok := s.abandonChans[hash]
+	if !ok {
+		return fmt.Errorf("%w: swap %v is not executing",
+			ErrSwapNotCancelable, hash)
+	}
+
 	select {
-	case s.abandonChans[req.SwapHash] <- struct{}{}:
-	case <-ctx.Done():
-		return ctx.Err()
+	case abandonChan <- struct{}{}:
 	default:
-		// This is to avoid writing to a full channel.
+		// The swap was already signaled.
 	}
 
 	return nil
 }
+
+// UpdateSwap changes the sweep confirmation target of a pending loop out swap,
+// and with it the fee rate of the sweep. The executing swap and the sweep
+// batcher pick up the new target on the next block. If the swap's htlc is
+// close to its expiry, a lower confirmation target is still enforced.
+func (s *Client) UpdateSwap(ctx context.Context,
+	req *UpdateSwapRequest) error {
+
+	if req == nil {
+		return errors.New("no request provided")
+	}
+
+	if req.SweepConfTarget < 1 {
+		return fmt.Errorf("sweep conf target must be at least 1, got %v",
+			req.SweepConfTarget)
+	}
+
+	swp, err := s.Store.FetchLoopOutSwap(ctx, req.SwapHash)
+	if err != nil {
+		return err
+	}
+
+	if state := swp.State().State; !state.IsPending() {
+		return fmt.Errorf("cannot update swap %v in state %v",
+			req.SwapHash, state)
+	}
+
+	return s.Store.UpdateLoopOutSweepConfTarget(
+		ctx, req.SwapHash, req.SweepConfTarget,
+	)
+}
+
+// BumpSweepFee raises the fee rate of the pending sweep of the loop out with
+// the given hash to the given sat/vbyte and republishes it right away. This
+// lets an operator escalate a sweep whose automatically estimated fee rate is
+// too low. The sweep is batched with other sweeps, which are bumped along. The
+// fee rate must be higher than the current one and must not exceed the max
+// sweep fee rate.
+func (s *Client) BumpSweepFee(ctx context.Context, hash lntypes.Hash,
+	satPerVByte uint64) error {
+
+	swp, err := s.Store.FetchLoopOutSwap(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	if state := swp.State().State; !state.IsPending() {
+		return fmt.Errorf("swap %v in state %v has no pending sweep",
+			hash, state)
+	}
+
+	feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+
+	return s.executor.batcher.BumpSweepFee(ctx, hash, feeRate)
+}
+
+// SweepTxid returns the txid of the confirmed sweep of the loop out with the
+// given hash. It returns nil while the sweep hasn't confirmed.
+func (s *Client) SweepTxid(ctx context.Context, hash lntypes.Hash) (
+	*chainhash.Hash, error) {
+
+	return s.executor.batcher.SweepTxid(ctx, hash)
+}
+
+// SwapsNearExpiry returns all pending loop out swaps whose htlc expires within
+// the given number of blocks of the current height. The swaps are sorted by
+// expiry height, soonest first.
+func (s *Client) SwapsNearExpiry(ctx context.Context, withinBlocks int32) (
+	[]*loopdb.LoopOut, error) {
+
+	if withinBlocks < 0 {
+		return nil, errors.New("block window must not be negative")
+	}
+
+	// Wait for the executor to know the current height.
+	if err := s.waitForInitialized(ctx); err != nil {
+		return nil, err
+	}
+
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return swapsNearExpiry(
+		loopOutSwaps, s.executor.height(), withinBlocks,
+	), nil
+}
+
+// swapsNearExpiry filters the pending swaps that expire at or before the
+// given height plus the block window and sorts them by expiry height.
+func swapsNearExpiry(swaps []*loopdb.LoopOut, height,
+	withinBlocks int32) []*loopdb.LoopOut {
+
+	var nearExpiry []*loopdb.LoopOut
+	for _, swp := range swaps {
+		if swp.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		if swp.Contract.CltvExpiry-height > withinBlocks {
+			continue
+		}
+
+		nearExpiry = append(nearExpiry, swp)
+	}
+
+	sort.SliceStable(nearExpiry, func(i, j int) bool {
+		return nearExpiry[i].Contract.CltvExpiry <
+			nearExpiry[j].Contract.CltvExpiry
+	})
+
+	return nearExpiry
+}
+
+// GetSwapHistory returns the ordered state transitions of the swap with the
+// given hash, starting with its initiation. The history is built from the
+// state updates that are persisted for each swap.
+func (s *Client) GetSwapHistory(ctx context.Context, hash lntypes.Hash) (
+	[]SwapTransition, error) {
+
+	loopOut, err := s.Store.FetchLoopOutSwap(ctx, hash)
+	switch {
+	case err == nil:
+		return swapHistory(
+			loopOut.Contract.InitiationTime, loopOut.Events,
+		), nil
+
+	case !errors.Is(err, loopdb.ErrSwapNotFound):
+		return nil, err
+	}
+
+	loopIn, err := s.Store.FetchLoopInSwap(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return swapHistory(loopIn.Contract.InitiationTime, loopIn.Events), nil
+}
+
+// GetSwapByClientID returns the loop out swap that was assigned the given
+// client id when it was initiated.
+func (s *Client) GetSwapByClientID(ctx context.Context,
+	clientID string) (*loopdb.LoopOut, error) {
+
+	return s.Store.FetchLoopOutSwapByClientID(ctx, clientID)
+}
+
+// swapHistory converts the stored events of a swap into its transitions. The
+// initiation of a swap isn't always stored as an event, so it is added from
+// the contract if it is missing.
+func swapHistory(initiationTime time.Time,
+	events []*loopdb.LoopEvent) []SwapTransition {
+
+	history := make([]SwapTransition, 0, len(events)+1)
+
+	if len(events) == 0 || events[0].State != loopdb.StateInitiated {
+		history = append(history, SwapTransition{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateInitiated,
+			},
+			Time: initiationTime,
+		})
+	}
+
+	for _, event := range events {
+		history = append(history, SwapTransition{
+			SwapStateData: event.SwapStateData,
+			Time:          event.Time,
+		})
+	}
+
+	for i := 0; i < len(history)-1; i++ {
+		history[i].Duration = history[i+1].Time.Sub(history[i].Time)
+	}
+
+	return history
+}
+
+// UnrecoveredFunds returns the total costs of all swaps that failed after
+// funds were already spent on them, for example a paid prepay or the fees of
+// a published htlc, together with the hashes of these swaps. These costs are
+// sunk, because the swaps didn't complete.
+func (s *Client) UnrecoveredFunds(ctx context.Context) (btcutil.Amount,
+	[]lntypes.Hash, error) {
+
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var loops []*loopdb.Loop
+	for _, swp := range loopOutSwaps {
+		loops = append(loops, &swp.Loop)
+	}
+	for _, swp := range loopInSwaps {
+		loops = append(loops, &swp.Loop)
+	}
+
+	total, hashes := unrecoveredFunds(loops)
+
+	return total, hashes, nil
+}
+
+// unrecoveredFunds sums up the costs of all failed swaps that have accrued
+// costs and returns their hashes.
+func unrecoveredFunds(swaps []*loopdb.Loop) (btcutil.Amount, []lntypes.Hash) {
+	var (
+		total  btcutil.Amount
+		hashes []lntypes.Hash
+	)
+	for _, swp := range swaps {
+		state := swp.State()
+		if state.State.Type() != loopdb.StateTypeFail {
+			continue
+		}
+
+		cost := state.Cost.Total()
+		if cost <= 0 {
+			continue
+		}
+
+		total += cost
+		hashes = append(hashes, swp.Hash)
+	}
+
+	return total, hashes
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/sha256"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -12,12 +14,16 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/sweep"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightninglabs/loop/utils"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/status"
 )
 
@@ -394,6 +400,8 @@ func testLoopOutSuccess(ctx *testContext, amt btcutil.Amount, hash lntypes.Hash,
 	ctx.NotifySpend(sweepTx, 0)
 
 	ctx.AssertRegisterConf(true, 3)
+	// The swap completes once the batcher notifies it of the confirmation.
+	ctx.NotifyConf(sweepTx)
 
 	ctx.assertStatus(loopdb.StateSuccess)
 
@@ -437,3 +445,1101 @@ func TestWrapGrpcError(t *testing.T) {
 		})
 	}
 }
+
+// TestOutstandingSwapValue tests that only the amounts of pending swaps are
+// counted towards the outstanding swap value.
+func TestOutstandingSwapValue(t *testing.T) {
+	newLoopOut := func(amt btcutil.Amount,
+		state loopdb.SwapState) *loopdb.LoopOut {
+
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{
+					{
+						SwapStateData: loopdb.SwapStateData{
+							State: state,
+						},
+					},
+				},
+			},
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: amt,
+				},
+			},
+		}
+	}
+
+	newLoopIn := func(amt btcutil.Amount,
+		state loopdb.SwapState) *loopdb.LoopIn {
+
+		return &loopdb.LoopIn{
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{
+					{
+						SwapStateData: loopdb.SwapStateData{
+							State: state,
+						},
+					},
+				},
+			},
+			Contract: &loopdb.LoopInContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: amt,
+				},
+			},
+		}
+	}
+
+	loopOuts := []*loopdb.LoopOut{
+		newLoopOut(1000, loopdb.StateInitiated),
+		newLoopOut(2000, loopdb.StatePreimageRevealed),
+		newLoopOut(4000, loopdb.StateSuccess),
+	}
+
+	loopIns := []*loopdb.LoopIn{
+		newLoopIn(8000, loopdb.StateHtlcPublished),
+		newLoopIn(16000, loopdb.StateFailTimeout),
+	}
+
+	require.Equal(
+		t, btcutil.Amount(11000),
+		outstandingSwapValue(loopOuts, loopIns),
+	)
+	require.Zero(t, outstandingSwapValue(nil, nil))
+}
+
+// TestReserveOutstandingValue tests that the amounts of swaps that are being
+// initiated count towards the maximum outstanding value until their
+// reservation is released.
+func TestReserveOutstandingValue(t *testing.T) {
+	ctx := context.Background()
+	store := loopdb.NewStoreMock(t)
+
+	client := &Client{
+		clientConfig: clientConfig{
+			Store:               store,
+			MaxOutstandingValue: 10000,
+		},
+	}
+
+	pending := lntypes.Hash{1}
+	store.LoopOutSwaps[pending] = &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			AmountRequested: 4000,
+		},
+	}
+	store.LoopOutUpdates[pending] = []loopdb.SwapStateData{
+		{State: loopdb.StateInitiated},
+	}
+
+	release, err := client.reserveOutstandingValue(ctx, 5000)
+	require.NoError(t, err)
+
+	// The reservation and the stored swap leave room for 1000 sat.
+	_, err = client.reserveOutstandingValue(ctx, 2000)
+	require.ErrorIs(t, err, ErrMaxOutstandingValueExceeded)
+
+	releaseSmall, err := client.reserveOutstandingValue(ctx, 1000)
+	require.NoError(t, err)
+	releaseSmall()
+
+	// Once the first swap failed to initiate, its amount is free again.
+	release()
+
+	release, err = client.reserveOutstandingValue(ctx, 6000)
+	require.NoError(t, err)
+	release()
+	require.Zero(t, client.reservedValue)
+}
+
+// TestRoutableBalance tests that the routable balance is limited to the
+// largest spendable channel balances that can be used with the given number of
+// parts.
+func TestRoutableBalance(t *testing.T) {
+	channels := []lndclient.ChannelInfo{
+		{
+			LocalBalance: 10000,
+		},
+		{
+			LocalBalance: 50000,
+			LocalConstraints: &lndclient.ChannelConstraints{
+				Reserve: 5000,
+			},
+		},
+		{
+			LocalBalance: 30000,
+		},
+		{
+			// This channel doesn't have any balance above its
+			// reserve, so it can't be used.
+			LocalBalance: 1000,
+			LocalConstraints: &lndclient.ChannelConstraints{
+				Reserve: 1000,
+			},
+		},
+	}
+
+	require.Equal(t, btcutil.Amount(45000), routableBalance(channels, 1))
+	require.Equal(t, btcutil.Amount(75000), routableBalance(channels, 2))
+	require.Equal(t, btcutil.Amount(85000), routableBalance(channels, 5))
+	require.Zero(t, routableBalance(nil, 5))
+}
+
+// TestRoundSwapAmount tests rounding of swap amounts to the amount increment
+// of the terms.
+func TestRoundSwapAmount(t *testing.T) {
+	terms := &LoopOutTerms{
+		MinSwapAmount:   1000,
+		AmountIncrement: 1000,
+	}
+
+	amt, err := roundSwapAmount(1999, terms)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(1000), amt)
+
+	amt, err = roundSwapAmount(2000, terms)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(2000), amt)
+
+	// Amounts that are rounded below the minimum are rejected.
+	_, err = roundSwapAmount(999, terms)
+	require.ErrorIs(t, err, ErrSwapAmountTooLow)
+
+	terms.MinSwapAmount = 0
+	_, err = roundSwapAmount(999, terms)
+	require.ErrorIs(t, err, ErrSwapAmountTooLow)
+
+	// Without an increment, the amount is left unchanged.
+	terms.AmountIncrement = 0
+	amt, err = roundSwapAmount(1999, terms)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(1999), amt)
+}
+
+// TestSwapsNearExpiry tests that only pending swaps that expire within the
+// block window are returned, sorted by expiry.
+func TestSwapsNearExpiry(t *testing.T) {
+	newSwap := func(expiry int32, state loopdb.SwapState) *loopdb.LoopOut {
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{
+					{
+						SwapStateData: loopdb.SwapStateData{
+							State: state,
+						},
+					},
+				},
+			},
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					CltvExpiry: expiry,
+				},
+			},
+		}
+	}
+
+	var (
+		later    = newSwap(130, loopdb.StatePreimageRevealed)
+		soonest  = newSwap(105, loopdb.StateInitiated)
+		finished = newSwap(101, loopdb.StateSuccess)
+		tooLate  = newSwap(131, loopdb.StateInitiated)
+	)
+
+	swaps := []*loopdb.LoopOut{later, soonest, finished, tooLate}
+
+	require.Equal(
+		t, []*loopdb.LoopOut{soonest, later},
+		swapsNearExpiry(swaps, 100, 30),
+	)
+	require.Empty(t, swapsNearExpiry(swaps, 100, 4))
+}
+
+// TestResumeOrder tests that pending swaps are resumed in the order of their
+// expiry, regardless of their type.
+func TestResumeOrder(t *testing.T) {
+	events := func(state loopdb.SwapState) []*loopdb.LoopEvent {
+		return []*loopdb.LoopEvent{
+			{
+				SwapStateData: loopdb.SwapStateData{
+					State: state,
+				},
+			},
+		}
+	}
+
+	newLoopOut := func(expiry int32,
+		state loopdb.SwapState) *loopdb.LoopOut {
+
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Events: events(state),
+			},
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					CltvExpiry: expiry,
+				},
+			},
+		}
+	}
+
+	newLoopIn := func(expiry int32,
+		state loopdb.SwapState) *loopdb.LoopIn {
+
+		return &loopdb.LoopIn{
+			Loop: loopdb.Loop{
+				Events: events(state),
+			},
+			Contract: &loopdb.LoopInContract{
+				SwapContract: loopdb.SwapContract{
+					CltvExpiry: expiry,
+				},
+			},
+		}
+	}
+
+	var (
+		outLater  = newLoopOut(300, loopdb.StatePreimageRevealed)
+		outSoon   = newLoopOut(100, loopdb.StateInitiated)
+		outDone   = newLoopOut(50, loopdb.StateSuccess)
+		inMiddle  = newLoopIn(200, loopdb.StateHtlcPublished)
+		inSameExp = newLoopIn(300, loopdb.StateInitiated)
+		inFailed  = newLoopIn(10, loopdb.StateFailTimeout)
+	)
+
+	require.Equal(t, []pendingResume{
+		{loopOut: outSoon},
+		{loopIn: inMiddle},
+		{loopOut: outLater},
+		{loopIn: inSameExp},
+	}, resumeOrder(
+		[]*loopdb.LoopOut{outLater, outSoon, outDone},
+		[]*loopdb.LoopIn{inSameExp, inFailed, inMiddle},
+	))
+
+	require.Empty(t, resumeOrder(nil, nil))
+}
+
+// TestSwapHistory tests that the swap history starts with the initiation of
+// the swap and lists the stored state updates in order with the time spent in
+// each state.
+func TestSwapHistory(t *testing.T) {
+	initiationTime := time.Unix(100, 0)
+
+	// Without any stored updates, the history only contains the
+	// initiation.
+	require.Equal(t, []SwapTransition{
+		{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateInitiated,
+			},
+			Time: initiationTime,
+		},
+	}, swapHistory(initiationTime, nil))
+
+	events := []*loopdb.LoopEvent{
+		{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StatePreimageRevealed,
+			},
+			Time: time.Unix(200, 0),
+		},
+		{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateSuccess,
+				Cost: loopdb.SwapCost{
+					Onchain: 500,
+				},
+			},
+			Time: time.Unix(300, 0),
+		},
+	}
+
+	history := swapHistory(initiationTime, events)
+	require.Len(t, history, 3)
+	require.Equal(t, loopdb.StateInitiated, history[0].State)
+	require.Equal(t, loopdb.StatePreimageRevealed, history[1].State)
+	require.Equal(t, loopdb.StateSuccess, history[2].State)
+	require.Equal(t, btcutil.Amount(500), history[2].Cost.Onchain)
+	require.Equal(t, time.Unix(300, 0), history[2].Time)
+
+	// The time spent in each state is measured until the next transition.
+	require.Equal(t, 100*time.Second, history[0].Duration)
+	require.Equal(t, 100*time.Second, history[1].Duration)
+	require.Zero(t, history[2].Duration)
+}
+
+// TestUnrecoveredFunds tests that only the costs of failed swaps are counted
+// as unrecovered funds.
+func TestUnrecoveredFunds(t *testing.T) {
+	newLoop := func(hash lntypes.Hash, state loopdb.SwapState,
+		cost loopdb.SwapCost) *loopdb.Loop {
+
+		return &loopdb.Loop{
+			Hash: hash,
+			Events: []*loopdb.LoopEvent{
+				{
+					SwapStateData: loopdb.SwapStateData{
+						State: state,
+						Cost:  cost,
+					},
+				},
+			},
+		}
+	}
+
+	swaps := []*loopdb.Loop{
+		newLoop(lntypes.Hash{1}, loopdb.StateFailTimeout, loopdb.SwapCost{
+			Server:   1000,
+			Offchain: 10,
+		}),
+		newLoop(lntypes.Hash{2}, loopdb.StateSuccess, loopdb.SwapCost{
+			Server: 2000,
+		}),
+		newLoop(lntypes.Hash{3}, loopdb.StateFailOffchainPayments,
+			loopdb.SwapCost{},
+		),
+		newLoop(lntypes.Hash{4}, loopdb.StateFailSweepTimeout,
+			loopdb.SwapCost{
+				Onchain: 300,
+			},
+		),
+	}
+
+	total, hashes := unrecoveredFunds(swaps)
+	require.Equal(t, btcutil.Amount(1310), total)
+	require.Equal(t, []lntypes.Hash{{1}, {4}}, hashes)
+}
+
+// TestCheckQuoteAge tests that swaps based on quotes that are older than the
+// maximum quote age are rejected.
+func TestCheckQuoteAge(t *testing.T) {
+	quoteTime := time.Unix(1000, 0)
+
+	request := &OutRequest{
+		Quote: &LoopOutQuote{
+			Time: quoteTime,
+		},
+		MaxQuoteAge: time.Minute,
+	}
+
+	require.NoError(t, checkQuoteAge(request, quoteTime.Add(time.Minute)))
+	require.ErrorIs(
+		t, checkQuoteAge(request, quoteTime.Add(time.Minute+1)),
+		ErrQuoteStale,
+	)
+
+	// Without a maximum age or a quote, the age isn't checked.
+	request.MaxQuoteAge = 0
+	require.NoError(t, checkQuoteAge(request, quoteTime.Add(time.Hour)))
+	require.NoError(t, checkQuoteAge(&OutRequest{}, quoteTime))
+}
+
+// TestCheckQuoteMinerFee tests that swaps are rejected if the sweep fee that is
+// estimated at initiation exceeds the quoted miner fee beyond the tolerance.
+func TestCheckQuoteMinerFee(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	lnd.SetFeeEstimate(2, 1000)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		sweeper: &sweep.Sweeper{
+			Lnd: &lnd.LndServices,
+		},
+	}
+
+	ctx := context.Background()
+	destAddr, err := client.quoteSweepAddress()
+	require.NoError(t, err)
+
+	minerFee, err := client.getSweepFee(ctx, 2, 0, destAddr)
+	require.NoError(t, err)
+
+	request := &OutRequest{
+		DestAddr:        destAddr,
+		SweepConfTarget: 2,
+		Quote: &LoopOutQuote{
+			MinerFee: minerFee,
+		},
+	}
+	require.NoError(t, client.checkQuoteMinerFee(ctx, request))
+
+	// An increase within the tolerance is accepted.
+	lnd.SetFeeEstimate(2, 1100)
+	require.NoError(t, client.checkQuoteMinerFee(ctx, request))
+
+	lnd.SetFeeEstimate(2, 1200)
+	require.ErrorIs(
+		t, client.checkQuoteMinerFee(ctx, request), ErrQuoteStale,
+	)
+
+	// Without a quote, the fee isn't checked.
+	request.Quote = nil
+	require.NoError(t, client.checkQuoteMinerFee(ctx, request))
+}
+
+// TestGetExpiry tests that the preferred expiry delta is applied within the
+// server's cltv delta limits and never below the sweep confirmation target.
+func TestGetExpiry(t *testing.T) {
+	client := &Client{}
+	terms := &LoopOutTerms{
+		MinCltvDelta: 20,
+		MaxCltvDelta: 100,
+	}
+
+	tests := []struct {
+		name           string
+		confTarget     int32
+		preferredDelta int32
+		expiry         int32
+	}{
+		{
+			name:       "min delta",
+			confTarget: 6,
+			expiry:     1020,
+		},
+		{
+			name:       "conf target",
+			confTarget: 50,
+			expiry:     1050,
+		},
+		{
+			name:           "preferred delta",
+			confTarget:     6,
+			preferredDelta: 80,
+			expiry:         1080,
+		},
+		{
+			name:           "preferred delta below conf target",
+			confTarget:     50,
+			preferredDelta: 30,
+			expiry:         1050,
+		},
+		{
+			name:           "preferred delta above max",
+			confTarget:     6,
+			preferredDelta: 200,
+			expiry:         1100,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			expiry, err := client.getExpiry(
+				1000, terms, testCase.confTarget,
+				testCase.preferredDelta,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expiry, expiry)
+		})
+	}
+
+	_, err := client.getExpiry(1000, terms, 101, 0)
+	require.Error(t, err)
+}
+
+// TestPause tests that no new swaps can be initiated while the client is
+// paused.
+func TestPause(t *testing.T) {
+	defer test.Guard(t)()
+
+	client := &Client{}
+	require.False(t, client.Paused())
+
+	client.Pause()
+	require.True(t, client.Paused())
+
+	ctx := context.Background()
+
+	_, err := client.LoopOut(ctx, testRequest)
+	require.ErrorIs(t, err, ErrPaused)
+
+	_, err = client.LoopIn(ctx, &LoopInRequest{Amount: 50000})
+	require.ErrorIs(t, err, ErrPaused)
+
+	client.Unpause()
+	require.False(t, client.Paused())
+}
+
+// TestInvoiceMemoTooLong tests that a loop in with an invoice memo that lnd
+// would reject is refused before the swap is created.
+func TestInvoiceMemoTooLong(t *testing.T) {
+	defer test.Guard(t)()
+
+	client := &Client{}
+	_, err := client.LoopIn(context.Background(), &LoopInRequest{
+		Amount:      50000,
+		InvoiceMemo: strings.Repeat("a", MaxInvoiceMemoSize+1),
+	})
+	require.ErrorIs(t, err, ErrInvoiceMemoTooLong)
+}
+
+// TestStatus tests that the client status reflects the state of the executor
+// and the client.
+func TestStatus(t *testing.T) {
+	defer test.Guard(t)()
+
+	client := &Client{
+		executor: newExecutor(&executorConfig{}),
+	}
+
+	clientStatus := client.Status()
+	require.Equal(t, &ClientStatus{
+		ServerConnState: connectivity.Shutdown,
+	}, clientStatus)
+
+	swapErr := errors.New("swap failed")
+	client.executor.lastErr = swapErr
+	client.executor.currentHeight = 600
+	client.executor.activeSwaps = map[lntypes.Hash]*activeSwap{
+		{1}: {},
+		{2}: {},
+	}
+	close(client.executor.ready)
+	client.Pause()
+
+	clientStatus = client.Status()
+	require.Equal(t, &ClientStatus{
+		Height:          600,
+		ChainSynced:     true,
+		ServerConnState: connectivity.Shutdown,
+		ActiveSwaps:     2,
+		Paused:          true,
+		LastError:       swapErr,
+	}, clientStatus)
+}
+
+// TestEstimateBatchMinerFees tests that sweeps to wallet addresses share the
+// overhead of a single sweep tx, while sweeps to external addresses are
+// estimated on their own.
+func TestEstimateBatchMinerFees(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	lnd.SetFeeEstimate(2, 1000)
+	lnd.SetFeeEstimate(6, 250)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		sweeper: &sweep.Sweeper{
+			Lnd: &lnd.LndServices,
+		},
+	}
+
+	ctx := context.Background()
+
+	walletRequest := &OutRequest{
+		SweepConfTarget: 6,
+	}
+	urgentRequest := &OutRequest{
+		SweepConfTarget: 2,
+	}
+	externalRequest := &OutRequest{
+		DestAddr:        test.GetDestAddr(t, 0),
+		IsExternalAddr:  true,
+		SweepConfTarget: 6,
+	}
+
+	// Sweeps to wallet addresses are estimated for the p2wkh addresses
+	// that the wallet generates by default.
+	singleFee, err := client.getLoopOutSweepFee(
+		ctx, 2, 0, walletrpc.AddressType_WITNESS_PUBKEY_HASH,
+	)
+	require.NoError(t, err)
+
+	fee, err := client.EstimateBatchMinerFees(
+		ctx, []*OutRequest{urgentRequest},
+	)
+	require.NoError(t, err)
+	require.Equal(t, singleFee, fee)
+
+	// A batch of two sweeps is estimated with the lower confirmation
+	// target and costs less than two separate sweeps.
+	batchFee, err := client.EstimateBatchMinerFees(
+		ctx, []*OutRequest{walletRequest, urgentRequest},
+	)
+	require.NoError(t, err)
+	require.Greater(t, batchFee, singleFee)
+	require.Less(t, batchFee, 2*singleFee)
+
+	// The external sweep adds its own fee on top.
+	externalFee, err := client.sweeper.GetSweepFee(
+		ctx, quoteHtlc().AddSuccessToEstimator,
+		externalRequest.DestAddr, externalRequest.SweepConfTarget,
+	)
+	require.NoError(t, err)
+
+	fee, err = client.EstimateBatchMinerFees(
+		ctx, []*OutRequest{
+			walletRequest, urgentRequest, externalRequest,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, batchFee+externalFee, fee)
+
+	// A requested fee rate is used instead of the estimate, for the batch
+	// as well as for external sweeps.
+	fee, err = client.EstimateBatchMinerFees(
+		ctx, []*OutRequest{{
+			SweepConfTarget: 6,
+			SweepFeeRate:    1000,
+		}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, singleFee, fee)
+
+	externalRateFee, err := client.sweeper.GetSweepFeeForRate(
+		quoteHtlc().AddSuccessToEstimator, externalRequest.DestAddr,
+		1000,
+	)
+	require.NoError(t, err)
+
+	fee, err = client.EstimateBatchMinerFees(
+		ctx, []*OutRequest{{
+			DestAddr:        externalRequest.DestAddr,
+			IsExternalAddr:  true,
+			SweepConfTarget: 6,
+			SweepFeeRate:    1000,
+		}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, externalRateFee, fee)
+
+	// An external sweep needs its destination address.
+	_, err = client.EstimateBatchMinerFees(
+		ctx, []*OutRequest{{
+			IsExternalAddr:  true,
+			SweepConfTarget: 6,
+		}},
+	)
+	require.Error(t, err)
+
+	fee, err = client.EstimateBatchMinerFees(ctx, nil)
+	require.NoError(t, err)
+	require.Zero(t, fee)
+}
+
+// TestCancelSwap tests that only executing loop outs in their initiation
+// phase can be canceled.
+func TestCancelSwap(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := context.Background()
+	store := loopdb.NewStoreMock(t)
+
+	client := &Client{
+		executor:     newExecutor(&executorConfig{}),
+		abandonChans: make(map[lntypes.Hash]chan struct{}),
+		clientConfig: clientConfig{
+			Store: store,
+		},
+	}
+
+	initiated := lntypes.Hash{1}
+	store.LoopOutSwaps[initiated] = &loopdb.LoopOutContract{}
+	store.LoopOutUpdates[initiated] = []loopdb.SwapStateData{
+		{State: loopdb.StateInitiated},
+	}
+
+	revealed := lntypes.Hash{2}
+	store.LoopOutSwaps[revealed] = &loopdb.LoopOutContract{}
+	store.LoopOutUpdates[revealed] = []loopdb.SwapStateData{
+		{State: loopdb.StatePreimageRevealed},
+	}
+
+	// The swap can't be canceled while it isn't executing.
+	err := client.CancelSwap(ctx, initiated)
+	require.ErrorIs(t, err, ErrSwapNotCancelable)
+
+	abandonChan := make(chan struct{}, 1)
+	client.abandonChans[initiated] = abandonChan
+	client.abandonChans[revealed] = make(chan struct{}, 1)
+
+	require.NoError(t, client.CancelSwap(ctx, initiated))
+	require.Len(t, abandonChan, 1)
+
+	// Canceling twice doesn't block.
+	require.NoError(t, client.CancelSwap(ctx, initiated))
+
+	// Once the preimage is revealed, the swap has to be completed.
+	err = client.CancelSwap(ctx, revealed)
+	require.ErrorIs(t, err, ErrSwapNotCancelable)
+	require.Empty(t, client.abandonChans[revealed])
+}
+
+// TestAbandonSwap tests that executing swaps are signaled to abandon
+// themselves and that swaps which aren't executing are abandoned in the store.
+func TestAbandonSwap(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := context.Background()
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+
+	client := &Client{
+		lndServices:  &lnd.LndServices,
+		executor:     newExecutor(&executorConfig{}),
+		abandonChans: make(map[lntypes.Hash]chan struct{}),
+		abandoned:    make(map[lntypes.Hash]struct{}),
+		clientConfig: clientConfig{
+			Store: store,
+		},
+	}
+
+	_, senderPubKey := test.CreateKey(1)
+	var senderKey [33]byte
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+
+	_, receiverPubKey := test.CreateKey(2)
+	var receiverKey [33]byte
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	contract := loopdb.SwapContract{
+		AmountRequested: 50000,
+		CltvExpiry:      1000,
+		HtlcKeys: loopdb.HtlcKeys{
+			SenderScriptKey:        senderKey,
+			SenderInternalPubKey:   senderKey,
+			ReceiverScriptKey:      receiverKey,
+			ReceiverInternalPubKey: receiverKey,
+		},
+		ProtocolVersion: loopdb.CurrentProtocolVersion(),
+	}
+
+	// This loop in failed to resume, so it isn't executing.
+	stuck := lntypes.Hash{1}
+	store.LoopInSwaps[stuck] = &loopdb.LoopInContract{
+		SwapContract: contract,
+	}
+	store.LoopInUpdates[stuck] = []loopdb.SwapStateData{
+		{State: loopdb.StateHtlcPublished},
+	}
+
+	// This loop out is executing past its initiation phase.
+	revealed := lntypes.Hash{2}
+	store.LoopOutSwaps[revealed] = &loopdb.LoopOutContract{
+		SwapContract: contract,
+	}
+	store.LoopOutUpdates[revealed] = []loopdb.SwapStateData{
+		{State: loopdb.StatePreimageRevealed},
+	}
+	client.abandonChans[revealed] = make(chan struct{}, 1)
+
+	// This loop out failed to resume after its htlc was published.
+	published := lntypes.Hash{5}
+	store.LoopOutSwaps[published] = &loopdb.LoopOutContract{
+		SwapContract: contract,
+	}
+	store.LoopOutUpdates[published] = []loopdb.SwapStateData{
+		{State: loopdb.StateHtlcPublished},
+	}
+
+	// This loop out already completed.
+	completed := lntypes.Hash{3}
+	store.LoopOutSwaps[completed] = &loopdb.LoopOutContract{
+		SwapContract: contract,
+	}
+	store.LoopOutUpdates[completed] = []loopdb.SwapStateData{
+		{State: loopdb.StateSuccess},
+	}
+
+	err := client.AbandonSwap(ctx, &AbandonSwapRequest{SwapHash: stuck})
+	require.NoError(t, err)
+
+	store.AssertLoopInState(loopdb.StateFailAbandoned)
+
+	// The abandoned swap isn't resumed by a later retry.
+	require.False(t, client.registerAbandonChan(stuck, nil))
+	require.NotContains(t, client.abandonChans, stuck)
+
+	err = client.AbandonSwap(ctx, &AbandonSwapRequest{SwapHash: revealed})
+	require.Error(t, err)
+	require.Empty(t, client.abandonChans[revealed])
+
+	// A loop out that isn't executing can't be abandoned after its
+	// htlc was published either, as it still needs to be swept.
+	err = client.AbandonSwap(ctx, &AbandonSwapRequest{SwapHash: published})
+	require.Error(t, err)
+	require.Equal(t, []loopdb.SwapStateData{
+		{State: loopdb.StateHtlcPublished},
+	}, store.LoopOutUpdates[published])
+
+	err = client.AbandonSwap(ctx, &AbandonSwapRequest{SwapHash: completed})
+	require.Error(t, err)
+
+	err = client.AbandonSwap(
+		ctx, &AbandonSwapRequest{SwapHash: lntypes.Hash{4}},
+	)
+	require.ErrorIs(t, err, loopdb.ErrSwapNotFound)
+}
+
+// TestUpdateSwap tests that the sweep conf target of pending loop outs can be
+// changed.
+func TestUpdateSwap(t *testing.T) {
+	ctx := context.Background()
+	store := loopdb.NewStoreMock(t)
+
+	client := &Client{
+		clientConfig: clientConfig{
+			Store: store,
+		},
+	}
+
+	pending := lntypes.Hash{1}
+	store.LoopOutSwaps[pending] = &loopdb.LoopOutContract{
+		SweepConfTarget: 9,
+	}
+	store.LoopOutUpdates[pending] = []loopdb.SwapStateData{
+		{State: loopdb.StatePreimageRevealed},
+	}
+
+	completed := lntypes.Hash{2}
+	store.LoopOutSwaps[completed] = &loopdb.LoopOutContract{
+		SweepConfTarget: 9,
+	}
+	store.LoopOutUpdates[completed] = []loopdb.SwapStateData{
+		{State: loopdb.StateSuccess},
+	}
+
+	err := client.UpdateSwap(ctx, &UpdateSwapRequest{
+		SwapHash:        pending,
+		SweepConfTarget: 3,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, store.LoopOutSwaps[pending].SweepConfTarget)
+
+	err = client.UpdateSwap(ctx, &UpdateSwapRequest{
+		SwapHash:        pending,
+		SweepConfTarget: 0,
+	})
+	require.Error(t, err)
+
+	err = client.UpdateSwap(ctx, &UpdateSwapRequest{
+		SwapHash:        completed,
+		SweepConfTarget: 3,
+	})
+	require.Error(t, err)
+	require.EqualValues(t, 9, store.LoopOutSwaps[completed].SweepConfTarget)
+}
+
+// TestLoopOutSweepFeeAddrType tests that the quoted sweep fee accounts for the
+// weight of the destination address type.
+func TestLoopOutSweepFeeAddrType(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	lnd.SetFeeEstimate(2, 1000)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		sweeper: &sweep.Sweeper{
+			Lnd: &lnd.LndServices,
+		},
+	}
+
+	ctx := context.Background()
+	sweepFee := func(addrType walletrpc.AddressType) btcutil.Amount {
+		fee, err := client.getLoopOutSweepFee(ctx, 2, 0, addrType)
+		require.NoError(t, err)
+
+		return fee
+	}
+
+	p2wkhFee := sweepFee(walletrpc.AddressType_WITNESS_PUBKEY_HASH)
+	np2wkhFee := sweepFee(walletrpc.AddressType_NESTED_WITNESS_PUBKEY_HASH)
+	p2trFee := sweepFee(walletrpc.AddressType_TAPROOT_PUBKEY)
+	worstCaseFee := sweepFee(walletrpc.AddressType_UNKNOWN)
+
+	require.Less(t, p2wkhFee, np2wkhFee)
+	require.Less(t, np2wkhFee, p2trFee)
+	require.LessOrEqual(t, p2trFee, worstCaseFee)
+}
+
+// TestSetWalletDestAddr tests that loop out requests without a destination
+// sweep to a new wallet address, while given destinations are kept.
+func TestSetWalletDestAddr(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	client := &Client{
+		lndServices: &lnd.LndServices,
+	}
+
+	ctx := context.Background()
+
+	request := &OutRequest{
+		IsExternalAddr: true,
+	}
+	require.NoError(t, client.setWalletDestAddr(ctx, request))
+	require.NotNil(t, request.DestAddr)
+	require.False(t, request.IsExternalAddr)
+
+	destAddr := test.GetDestAddr(t, 0)
+	request = &OutRequest{
+		DestAddr:       destAddr,
+		IsExternalAddr: true,
+	}
+	require.NoError(t, client.setWalletDestAddr(ctx, request))
+	require.Equal(t, destAddr, request.DestAddr)
+	require.True(t, request.IsExternalAddr)
+}
+
+// TestValidateDestAddr tests that destination addresses of other networks and
+// unsupported types are rejected.
+func TestValidateDestAddr(t *testing.T) {
+	params := &chaincfg.TestNet3Params
+
+	p2tr, err := btcutil.NewAddressTaproot(make([]byte, 32), params)
+	require.NoError(t, err)
+	require.NoError(t, validateDestAddr(p2tr, params))
+
+	p2wkh, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), params,
+	)
+	require.NoError(t, err)
+	require.NoError(t, validateDestAddr(p2wkh, params))
+
+	mainnetP2tr, err := btcutil.NewAddressTaproot(
+		make([]byte, 32), &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+	require.ErrorIs(
+		t, validateDestAddr(mainnetP2tr, params),
+		ErrDestAddrWrongNetwork,
+	)
+
+	_, key := test.CreateKey(1)
+	pubKey, err := btcutil.NewAddressPubKey(
+		key.SerializeCompressed(), params,
+	)
+	require.NoError(t, err)
+	require.ErrorIs(
+		t, validateDestAddr(pubKey, params), ErrDestAddrUnsupported,
+	)
+}
+
+// TestResolveOutgoingPeers tests that outgoing peers are resolved to their
+// channels.
+func TestResolveOutgoingPeers(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	client := &Client{
+		lndServices: &lnd.LndServices,
+	}
+
+	peer1 := route.Vertex{1}
+	peer2 := route.Vertex{2}
+	lnd.Channels = []lndclient.ChannelInfo{
+		{ChannelID: 1, PubKeyBytes: peer1},
+		{ChannelID: 2, PubKeyBytes: peer1},
+		{ChannelID: 3, PubKeyBytes: peer2},
+	}
+
+	ctx := context.Background()
+
+	// The peer's channels are added to the channel set, without
+	// duplicating channels that are already in it.
+	request := &OutRequest{
+		OutgoingChanSet: loopdb.ChannelSet{2, 3},
+		OutgoingPeers:   []route.Vertex{peer1},
+	}
+	require.NoError(t, client.resolveOutgoingPeers(ctx, request))
+	require.Equal(t, loopdb.ChannelSet{2, 3, 1}, request.OutgoingChanSet)
+
+	// A peer without channels can't be looped out to.
+	request = &OutRequest{
+		OutgoingPeers: []route.Vertex{{3}},
+	}
+	require.Error(t, client.resolveOutgoingPeers(ctx, request))
+}
+
+// TestSwapInfo tests looking up the current state of a single swap.
+func TestSwapInfo(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+	client := &Client{
+		clientConfig: clientConfig{
+			Store: store,
+		},
+		lndServices: &lnd.LndServices,
+	}
+
+	_, senderPubKey := test.CreateKey(1)
+	var senderKey [33]byte
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+
+	_, receiverPubKey := test.CreateKey(2)
+	var receiverKey [33]byte
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	contract := loopdb.SwapContract{
+		AmountRequested: 50000,
+		CltvExpiry:      744,
+		HtlcKeys: loopdb.HtlcKeys{
+			SenderScriptKey:        senderKey,
+			SenderInternalPubKey:   senderKey,
+			ReceiverScriptKey:      receiverKey,
+			ReceiverInternalPubKey: receiverKey,
+		},
+	}
+
+	hash := lntypes.Hash{1}
+	store.LoopInSwaps[hash] = &loopdb.LoopInContract{
+		SwapContract: contract,
+	}
+
+	htlcTxHash := &chainhash.Hash{2}
+	store.LoopInUpdates[hash] = []loopdb.SwapStateData{
+		{
+			State: loopdb.StateInitiated,
+		},
+		{
+			State:      loopdb.StateHtlcPublished,
+			Cost:       loopdb.SwapCost{Onchain: 300},
+			HtlcTxHash: htlcTxHash,
+		},
+	}
+
+	ctx := context.Background()
+
+	info, err := client.SwapInfo(ctx, hash)
+	require.NoError(t, err)
+	require.Equal(t, swap.TypeIn, info.SwapType)
+	require.Equal(t, loopdb.StateHtlcPublished, info.State)
+	require.Equal(t, btcutil.Amount(300), info.Cost.Onchain)
+	require.Equal(t, htlcTxHash, info.HtlcTxHash)
+	require.True(
+		t, info.HtlcAddressP2WSH != nil || info.HtlcAddressP2TR != nil,
+	)
+
+	// The forfeited prepayment of a stored loop out is derived from its
+	// prepay invoice.
+	failedHash := lntypes.Hash{4}
+	prepayInvoice, err := getInvoice(failedHash, 1000, prepayInvoiceDesc)
+	require.NoError(t, err)
+
+	store.LoopOutSwaps[failedHash] = &loopdb.LoopOutContract{
+		SwapContract:  contract,
+		PrepayInvoice: prepayInvoice,
+		PrepaySettled: true,
+	}
+	store.LoopOutUpdates[failedHash] = []loopdb.SwapStateData{
+		{State: loopdb.StateFailOffchainPayments},
+	}
+
+	info, err = client.SwapInfo(ctx, failedHash)
+	require.NoError(t, err)
+	require.True(t, info.PrepaySettled)
+	require.False(t, info.SwapPaymentSettled)
+	require.Equal(t, btcutil.Amount(1000), info.PrepayForfeited)
+
+	_, err = client.SwapInfo(ctx, lntypes.Hash{3})
+	require.ErrorIs(t, err, loopdb.ErrSwapNotFound)
+}
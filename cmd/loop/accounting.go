@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var accountingCommand = cli.Command{
+	Name:  "accounting",
+	Usage: "show the volume and fees of completed swaps",
+	Description: "Aggregates the volume and the swap, miner and routing " +
+		"fees of completed swaps by day, week or month. Swaps are " +
+		"counted in the period in which they completed.",
+	Action: accounting,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "period",
+			Usage: "the period to aggregate the swaps by, one of " +
+				"day, week or month",
+			Value: "day",
+		},
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "only count swaps that completed at or after " +
+				"this unix time",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "only count swaps that completed before this " +
+				"unix time",
+		},
+	},
+}
+
+func accounting(ctx *cli.Context) error {
+	var period looprpc.ReportPeriod
+	switch ctx.String("period") {
+	case "day":
+		period = looprpc.ReportPeriod_REPORT_PERIOD_DAY
+
+	case "week":
+		period = looprpc.ReportPeriod_REPORT_PERIOD_WEEK
+
+	case "month":
+		period = looprpc.ReportPeriod_REPORT_PERIOD_MONTH
+
+	default:
+		return fmt.Errorf("unknown period: %v", ctx.String("period"))
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetAccountingReport(
+		context.Background(), &looprpc.AccountingReportRequest{
+			Period:    period,
+			StartTime: ctx.Int64("start"),
+			EndTime:   ctx.Int64("end"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
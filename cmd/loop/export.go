@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var exportCSVCommand = cli.Command{
+	Name:  "exportcsv",
+	Usage: "export all swaps as csv",
+	Description: "Writes the pending and completed swaps in the local " +
+		"database as csv, ordered by their initiation time. Amounts " +
+		"and fees are in satoshis, timestamps in UTC.",
+	Action: exportCSV,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "output",
+			Usage: "the file to write the csv to, if not set the " +
+				"csv is written to stdout",
+		},
+		cli.BoolFlag{
+			Name:  "completed_only",
+			Usage: "only export swaps that reached a final state",
+		},
+	},
+}
+
+// swapsCSVHeader is the header row of the swap export.
+var swapsCSVHeader = []string{
+	"swap_hash", "type", "state", "failure_reason", "label",
+	"amount_sat", "cost_server_sat", "cost_onchain_sat",
+	"cost_offchain_sat", "initiation_time", "last_update_time",
+	"htlc_address", "htlc_txid", "sweep_txid",
+}
+
+func exportCSV(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListSwaps(
+		context.Background(), &looprpc.ListSwapsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	swaps := resp.Swaps
+	if ctx.Bool("completed_only") {
+		swaps = make([]*looprpc.SwapStatus, 0, len(resp.Swaps))
+		for _, swp := range resp.Swaps {
+			if swp.State == looprpc.SwapState_SUCCESS ||
+				swp.State == looprpc.SwapState_FAILED {
+
+				swaps = append(swaps, swp)
+			}
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if ctx.IsSet("output") {
+		file, err := os.Create(ctx.String("output"))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	return writeSwapsCSV(w, swaps)
+}
+
+// writeSwapsCSV writes the swaps as csv rows, ordered by their initiation
+// time.
+func writeSwapsCSV(w io.Writer, swaps []*looprpc.SwapStatus) error {
+	sort.SliceStable(swaps, func(i, j int) bool {
+		return swaps[i].InitiationTime < swaps[j].InitiationTime
+	})
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(swapsCSVHeader); err != nil {
+		return err
+	}
+
+	for _, swp := range swaps {
+		failureReason := ""
+		if swp.State == looprpc.SwapState_FAILED {
+			failureReason = strings.TrimPrefix(
+				swp.FailureReason.String(), "FAILURE_REASON_",
+			)
+		}
+
+		htlcAddress := swp.HtlcAddressP2Tr
+		if htlcAddress == "" {
+			htlcAddress = swp.HtlcAddressP2Wsh
+		}
+
+		err := csvWriter.Write([]string{
+			swp.Id,
+			swp.Type.String(),
+			swp.State.String(),
+			failureReason,
+			swp.Label,
+			strconv.FormatInt(swp.Amt, 10),
+			strconv.FormatInt(swp.CostServer, 10),
+			strconv.FormatInt(swp.CostOnchain, 10),
+			strconv.FormatInt(swp.CostOffchain, 10),
+			formatCSVTime(swp.InitiationTime),
+			formatCSVTime(swp.LastUpdateTime),
+			htlcAddress,
+			swp.HtlcTxid,
+			swp.SweepTxid,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
+
+// formatCSVTime formats a unix timestamp in nanoseconds as RFC3339 in UTC.
+func formatCSVTime(unixNano int64) string {
+	return time.Unix(0, unixNano).UTC().Format(time.RFC3339)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/urfave/cli"
@@ -34,3 +35,37 @@ func getInfo(ctx *cli.Context) error {
 
 	return nil
 }
+
+var healthCommand = cli.Command{
+	Name:  "health",
+	Usage: "check the health of the loop daemon",
+	Description: "Checks that lnd and the swap server are reachable, " +
+		"that the swap store accepts writes and that the swap " +
+		"executor is alive.",
+	Action: health,
+}
+
+func health(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.Health(
+		context.Background(), &looprpc.HealthRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	// Exit with an error if a subsystem is unhealthy, so that the command
+	// can be used in health checks.
+	if !resp.Healthy {
+		return errors.New("loop daemon is unhealthy")
+	}
+
+	return nil
+}
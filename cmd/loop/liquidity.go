@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/looprpc"
@@ -67,6 +68,16 @@ var setLiquidityRuleCommand = cli.Command{
 			Usage: "the minimum percentage of outbound liquidity " +
 				"that we do not want to drop below.",
 		},
+		cli.Uint64Flag{
+			Name: "min_amt",
+			Usage: "the minimum amount in satoshis that swaps " +
+				"dispatched for this rule may have.",
+		},
+		cli.Uint64Flag{
+			Name: "max_amt",
+			Usage: "the maximum amount in satoshis that swaps " +
+				"dispatched for this rule may have.",
+		},
 		cli.BoolFlag{
 			Name: "clear",
 			Usage: "remove the rule currently set for the " +
@@ -116,6 +127,8 @@ func setRule(ctx *cli.Context) error {
 	var (
 		inboundSet  = ctx.IsSet("incoming_threshold")
 		outboundSet = ctx.IsSet("outgoing_threshold")
+		minAmtSet   = ctx.IsSet("min_amt")
+		maxAmtSet   = ctx.IsSet("max_amt")
 		ruleSet     bool
 		otherRules  []*looprpc.LiquidityRule
 	)
@@ -150,7 +163,7 @@ func setRule(ctx *cli.Context) error {
 				"set at present", chanID)
 		}
 
-		if inboundSet || outboundSet {
+		if inboundSet || outboundSet || minAmtSet || maxAmtSet {
 			return fmt.Errorf("do not set other flags with clear " +
 				"flag")
 		}
@@ -206,6 +219,9 @@ func setRule(ctx *cli.Context) error {
 		)
 	}
 
+	newRule.MinSwapAmount = ctx.Uint64("min_amt")
+	newRule.MaxSwapAmount = ctx.Uint64("max_amt")
+
 	// Just set the rules on our current set of parameters and leave the
 	// other values untouched.
 	otherRules = append(otherRules, newRule)
@@ -350,6 +366,18 @@ var setParamsCommand = cli.Command{
 			Usage: "the target size of total local balance in " +
 				"satoshis, used by easy autoloop.",
 		},
+		cli.StringFlag{
+			Name: "excludechannels",
+			Usage: "the comma-separated list of short channel " +
+				"IDs of the channels that automated swaps " +
+				"never use, set to \"\" to clear the list.",
+		},
+		cli.StringFlag{
+			Name: "excludepeers",
+			Usage: "the comma-separated list of pubkeys of the " +
+				"peers whose channels automated swaps never " +
+				"use, set to \"\" to clear the list.",
+		},
 	},
 	Action: setParams,
 }
@@ -526,6 +554,41 @@ func setParams(ctx *cli.Context) error {
 		flagSet = true
 	}
 
+	if ctx.IsSet("excludechannels") {
+		params.ExcludedChannels = nil
+
+		channels := ctx.String("excludechannels")
+		for _, chanStr := range splitList(channels) {
+			chanID, err := strconv.ParseUint(chanStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing channel id "+
+					"%q: %v", chanStr, err)
+			}
+
+			params.ExcludedChannels = append(
+				params.ExcludedChannels, chanID,
+			)
+		}
+		flagSet = true
+	}
+
+	if ctx.IsSet("excludepeers") {
+		params.ExcludedPeers = nil
+
+		for _, peerStr := range splitList(ctx.String("excludepeers")) {
+			pubkey, err := route.NewVertexFromStr(peerStr)
+			if err != nil {
+				return fmt.Errorf("error parsing peer %q: %v",
+					peerStr, err)
+			}
+
+			params.ExcludedPeers = append(
+				params.ExcludedPeers, pubkey[:],
+			)
+		}
+		flagSet = true
+	}
+
 	if !flagSet {
 		return fmt.Errorf("at least one flag required to set params")
 	}
@@ -562,6 +625,16 @@ func setParams(ctx *cli.Context) error {
 	return err
 }
 
+// splitList splits a comma-separated list. An empty string results in an
+// empty list.
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+
+	return strings.Split(list, ",")
+}
+
 // ppmFromPercentage converts a percentage, expressed as a float, to parts
 // per million.
 func ppmFromPercentage(percentage float64) (uint64, error) {
@@ -610,3 +683,30 @@ func suggestSwap(ctx *cli.Context) error {
 	return errors.New("no rules set for autolooper, please set rules " +
 		"using the setrule command")
 }
+
+var getBudgetStatusCommand = cli.Command{
+	Name:  "budget",
+	Usage: "show the status of the autoloop fee budget",
+	Description: "Displays how much of the autoloop fee budget is spent " +
+		"or reserved for in flight swaps in the current budget " +
+		"period, and when the budget is refreshed.",
+	Action: getBudgetStatus,
+}
+
+func getBudgetStatus(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetBudgetStatus(
+		context.Background(), &looprpc.GetBudgetStatusRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/urfave/cli"
 )
 
@@ -21,6 +22,19 @@ var (
 		Usage: "the comma-separated list of short " +
 			"channel IDs of the channels to loop out",
 	}
+
+	outgoingPeersFlag = cli.StringFlag{
+		Name: "outgoing_peers",
+		Usage: "the comma-separated list of pubkeys of the peers " +
+			"whose channels may be used to loop out",
+	}
+
+	sweepFeeRateFlag = cli.Uint64Flag{
+		Name: "sweep_sat_per_vbyte",
+		Usage: "the fee rate in sat/vbyte to sweep the on-chain " +
+			"HTLC with instead of the fee rate estimated for " +
+			"the conf target",
+	}
 )
 var loopOutCommand = cli.Command{
 	Name:      "out",
@@ -32,8 +46,9 @@ var loopOutCommand = cli.Command{
 
 	The amount is to be specified in satoshis.
 
-	Optionally a BASE58/bech32 encoded bitcoin destination address may be
-	specified. If not specified, a new wallet address will be generated.`,
+	Optionally a BASE58/bech32 encoded bitcoin destination address or a
+	BIP21 URI may be specified. The amount of a URI must match the target
+	amount. If not specified, a new wallet address will be generated.`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "addr",
@@ -96,6 +111,8 @@ var loopOutCommand = cli.Command{
 		labelFlag,
 		verboseFlag,
 		channelFlag,
+		sweepFeeRateFlag,
+		outgoingPeersFlag,
 	},
 	Action: loopOut,
 }
@@ -136,6 +153,21 @@ func loopOut(ctx *cli.Context) error {
 		}
 	}
 
+	var outgoingPeers [][]byte
+	if ctx.IsSet(outgoingPeersFlag.Name) {
+		peerStrings := strings.Split(
+			ctx.String(outgoingPeersFlag.Name), ",",
+		)
+		for _, peerString := range peerStrings {
+			peer, err := route.NewVertexFromStr(peerString)
+			if err != nil {
+				return fmt.Errorf("error parsing peer pubkey "+
+					"\"%v\"", peerString)
+			}
+			outgoingPeers = append(outgoingPeers, peer[:])
+		}
+	}
+
 	// Validate our label early so that we can fail before getting a quote.
 	label := ctx.String(labelFlag.Name)
 	if err := labels.Validate(label); err != nil {
@@ -197,10 +229,13 @@ func loopOut(ctx *cli.Context) error {
 		return fmt.Errorf("at least 1 confirmation required for htlcs")
 	}
 
+	sweepSatPerVbyte := ctx.Uint64(sweepFeeRateFlag.Name)
+
 	quoteReq := &looprpc.QuoteRequest{
 		Amt:                     int64(amt),
 		ConfTarget:              sweepConfTarget,
 		SwapPublicationDeadline: uint64(swapDeadline.Unix()),
+		SweepSatPerVbyte:        sweepSatPerVbyte,
 	}
 	quote, err := client.LoopOutQuote(context.Background(), quoteReq)
 	if err != nil {
@@ -247,11 +282,13 @@ func loopOut(ctx *cli.Context) error {
 		MaxPrepayRoutingFee:     int64(limits.maxPrepayRoutingFee),
 		MaxSwapRoutingFee:       int64(limits.maxSwapRoutingFee),
 		OutgoingChanSet:         outgoingChanSet,
+		OutgoingPeers:           outgoingPeers,
 		SweepConfTarget:         sweepConfTarget,
 		HtlcConfirmations:       htlcConfs,
 		SwapPublicationDeadline: uint64(swapDeadline.Unix()),
 		Label:                   label,
 		Initiator:               defaultInitiator,
+		SweepSatPerVbyte:        sweepSatPerVbyte,
 	})
 	if err != nil {
 		return err
@@ -148,7 +148,9 @@ func main() {
 		listSwapsCommand, swapInfoCommand, getLiquidityParamsCommand,
 		setLiquidityRuleCommand, suggestSwapCommand, setParamsCommand,
 		getInfoCommand, abandonSwapCommand, reservationsCommands,
-		instantOutCommand, listInstantOutsCommand,
+		instantOutCommand, listInstantOutsCommand, exportCSVCommand,
+		bumpSweepFeeCommand, updateSwapCommand, getBudgetStatusCommand,
+		healthCommand, swapHistoryCommand, accountingCommand,
 	}
 
 	err := app.Run(os.Args)
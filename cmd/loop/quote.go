@@ -126,6 +126,7 @@ var quoteOutCommand = cli.Command{
 				"swap fee.",
 		},
 		verboseFlag,
+		sweepFeeRateFlag,
 	},
 	Action: quoteOut,
 }
@@ -159,6 +160,7 @@ func quoteOut(ctx *cli.Context) error {
 		Amt:                     int64(amt),
 		ConfTarget:              int32(ctx.Uint64("conf_target")),
 		SwapPublicationDeadline: uint64(swapDeadline.Unix()),
+		SweepSatPerVbyte:        ctx.Uint64(sweepFeeRateFlag.Name),
 	}
 	quoteResp, err := client.LoopOutQuote(ctxb, quoteReq)
 	if err != nil {
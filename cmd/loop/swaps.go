@@ -35,6 +35,24 @@ var listSwapsCommand = cli.Command{
 		labelFlag,
 		channelFlag,
 		lastHopFlag,
+		cli.Int64Flag{
+			Name: "initiated_after",
+			Usage: "only list swaps initiated at or after this " +
+				"unix time",
+		},
+		cli.Int64Flag{
+			Name: "initiated_before",
+			Usage: "only list swaps initiated before this unix " +
+				"time",
+		},
+		cli.Uint64Flag{
+			Name:  "index_offset",
+			Usage: "the number of matching swaps to skip",
+		},
+		cli.Uint64Flag{
+			Name:  "max_swaps",
+			Usage: "the maximum number of swaps to list",
+		},
 	},
 }
 
@@ -99,9 +117,14 @@ func listSwaps(ctx *cli.Context) error {
 		filter.Label = ctx.String(labelFlag.Name)
 	}
 
+	filter.InitiatedAfter = ctx.Int64("initiated_after")
+	filter.InitiatedBefore = ctx.Int64("initiated_before")
+
 	resp, err := client.ListSwaps(
 		context.Background(), &looprpc.ListSwapsRequest{
 			ListSwapFilter: filter,
+			IndexOffset:    ctx.Uint64("index_offset"),
+			MaxSwaps:       ctx.Uint64("max_swaps"),
 		},
 	)
 	if err != nil {
@@ -167,6 +190,46 @@ func swapInfo(ctx *cli.Context) error {
 	return nil
 }
 
+var swapHistoryCommand = cli.Command{
+	Name:      "swaphistory",
+	Usage:     "show the state transitions of a swap",
+	ArgsUsage: "id",
+	Description: "Shows every state that a swap went through, when it " +
+		"was reached and how long the swap stayed in it.",
+	Action: swapHistory,
+}
+
+func swapHistory(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "swaphistory")
+	}
+
+	id := ctx.Args().First()
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetSwapHistory(
+		context.Background(), &looprpc.SwapHistoryRequest{Id: idBytes},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var abandonSwapCommand = cli.Command{
 	Name:  "abandonswap",
 	Usage: "abandon a swap with a given swap hash",
@@ -175,7 +238,8 @@ var abandonSwapCommand = cli.Command{
 		"!!! This command might potentially lead to loss of funds if " +
 		"it is applied to swaps that are still waiting for pending " +
 		"user funds. Before executing this command make sure that " +
-		"no funds are locked by the swap.",
+		"no funds are locked by the swap. Loop outs can only be " +
+		"abandoned while they wait for the server's htlc.",
 	ArgsUsage: "ID",
 	Flags: []cli.Flag{
 		cli.BoolFlag{
@@ -236,3 +300,108 @@ func abandonSwap(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var bumpSweepFeeCommand = cli.Command{
+	Name:  "bumpsweepfee",
+	Usage: "raise the fee rate of the sweep of a pending loop out",
+	Description: "Raises the fee rate of the sweep of the loop out with " +
+		"the given swap hash and republishes it right away. Other " +
+		"sweeps in the same batch are bumped along. The fee rate " +
+		"must be higher than the current one and must not exceed " +
+		"the max sweep fee rate.",
+	ArgsUsage: "ID",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "sat_per_vbyte",
+			Usage: "the fee rate in sat/vbyte to sweep at",
+		},
+	},
+	Action: bumpSweepFee,
+}
+
+func bumpSweepFee(ctx *cli.Context) error {
+	if ctx.NArg() != 1 || !ctx.IsSet("sat_per_vbyte") {
+		return cli.ShowCommandHelp(ctx, "bumpsweepfee")
+	}
+
+	id := ctx.Args().First()
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.BumpSweepFee(
+		context.Background(), &looprpc.BumpSweepFeeRequest{
+			Id:          idBytes,
+			SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateSwapCommand = cli.Command{
+	Name:  "updateswap",
+	Usage: "change the sweep confirmation target of a pending loop out",
+	Description: "Changes the sweep confirmation target of the loop out " +
+		"with the given swap hash, and with it the fee rate of its " +
+		"sweep. The swap and its sweep pick up the new target on " +
+		"the next block.",
+	ArgsUsage: "ID",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks from the on-chain HTLC's " +
+				"confirmation height that it should be " +
+				"swept within",
+		},
+	},
+	Action: updateSwap,
+}
+
+func updateSwap(ctx *cli.Context) error {
+	if ctx.NArg() != 1 || !ctx.IsSet("conf_target") {
+		return cli.ShowCommandHelp(ctx, "updateswap")
+	}
+
+	id := ctx.Args().First()
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.UpdateSwap(
+		context.Background(), &looprpc.UpdateSwapRequest{
+			Id:              idBytes,
+			SweepConfTarget: int32(ctx.Uint64("conf_target")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -3,9 +3,11 @@ package loop
 import (
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"google.golang.org/grpc"
 )
 
@@ -18,4 +20,31 @@ type clientConfig struct {
 	LsatStore         lsat.Store
 	CreateExpiryTimer func(expiry time.Duration) <-chan time.Time
 	LoopOutMaxParts   uint32
+
+	// MaxOutstandingValue is the maximum total amount of all pending
+	// swaps. A zero value disables the limit.
+	MaxOutstandingValue btcutil.Amount
+
+	// StatusBufferSize is the number of status updates that are queued
+	// for a slow consumer. A zero value disables the buffer.
+	StatusBufferSize int
+
+	// ResumeRetries is the number of times that resuming swaps which
+	// failed to be resumed at startup is retried.
+	ResumeRetries int
+
+	// PreimageGenerator generates the preimages of new swaps. If it is
+	// nil, random preimages are used.
+	PreimageGenerator PreimageGenerator
+
+	// DestAddrType is the type of the wallet address that loop outs
+	// without a destination address sweep to.
+	DestAddrType walletrpc.AddressType
+
+	// PriceSource provides the bitcoin price that swap costs are
+	// annotated with. If it is nil, costs aren't annotated.
+	PriceSource PriceSource
+
+	// FiatCurrency is the currency that swap costs are annotated in.
+	FiatCurrency string
 }
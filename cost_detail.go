@@ -0,0 +1,216 @@
+package loop
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// CostComponent compares the realized amount of a single swap cost component
+// with the amount that was expected when the swap was initiated.
+type CostComponent struct {
+	// Expected is the quoted amount of this component. If the swap wasn't
+	// initiated with a quote, or the component isn't quoted, it is the
+	// limit.
+	Expected btcutil.Amount
+
+	// Limit is the maximum amount that the swap was allowed to spend on
+	// this component.
+	Limit btcutil.Amount
+
+	// Realized is the amount that was actually spent on this component so
+	// far.
+	Realized btcutil.Amount
+}
+
+// Variance returns the difference between the realized and the expected
+// amount. A negative variance means that the swap spent less than expected.
+func (c CostComponent) Variance() btcutil.Amount {
+	return c.Realized - c.Expected
+}
+
+// CostDetail breaks the costs of a swap down into its components.
+type CostDetail struct {
+	// SwapType is the type of the swap.
+	SwapType swap.Type
+
+	// State is the current state of the swap. The realized amounts are
+	// only final once the swap reached a final state.
+	State loopdb.SwapState
+
+	// Quoted is true if the swap was initiated with a quote, so that the
+	// expected amounts are the quoted ones.
+	Quoted bool
+
+	// SwapFee is the fee paid to the server. For a loop out, this
+	// includes the prepay amount, as the prepay counts towards the swap
+	// fee.
+	SwapFee CostComponent
+
+	// Prepay is the part of the swap fee of a loop out that the server
+	// bills ahead of the swap. Its limit is the amount of the prepay
+	// invoice, and it is realized once the server settled the prepay.
+	// Loop ins don't have a prepayment, so it is zero for them.
+	Prepay CostComponent
+
+	// RoutingFee is the off-chain routing fee of the swap. For a loop
+	// out, this covers both the swap and the prepay payment. Routing fees
+	// aren't quoted, so the expected amount is the limit. Loop ins don't
+	// pay routing fees, so their limit is zero.
+	RoutingFee CostComponent
+
+	// MinerFee is the on-chain fee of the swap. For a loop out, this is
+	// the sweep fee, for a loop in the htlc publication fee.
+	MinerFee CostComponent
+
+	// Fiat is the fiat value of the realized costs at the time of the
+	// swap's last update. It is only set if a price source is configured
+	// and the price could be obtained.
+	Fiat *FiatCost
+}
+
+// Final returns true if the swap reached a final state, so that the realized
+// amounts won't change anymore.
+func (c *CostDetail) Final() bool {
+	return c.State.Type() != loopdb.StateTypePending
+}
+
+// SwapCostDetail returns the cost breakdown of the swap with the given hash.
+// If a price source is configured, the costs are also annotated with their
+// fiat value.
+func (s *Client) SwapCostDetail(ctx context.Context,
+	hash lntypes.Hash) (*CostDetail, error) {
+
+	detail, lastUpdate, err := s.costDetail(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.PriceSource == nil || s.FiatCurrency == "" {
+		return detail, nil
+	}
+
+	// The costs are still useful without their fiat value, so a failing
+	// price source doesn't fail the request.
+	price, err := s.PriceSource.BTCPrice(ctx, s.FiatCurrency, lastUpdate)
+	if err != nil {
+		log.Warnf("Unable to get %v price for swap %v: %v",
+			s.FiatCurrency, hash, err)
+
+		return detail, nil
+	}
+
+	detail.Fiat = newFiatCost(detail, s.FiatCurrency, price, lastUpdate)
+
+	return detail, nil
+}
+
+// costDetail returns the cost breakdown of the swap with the given hash and
+// the time of the swap's last update.
+func (s *Client) costDetail(ctx context.Context, hash lntypes.Hash) (
+	*CostDetail, time.Time, error) {
+
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	for _, swp := range loopOutSwaps {
+		if swp.Hash != hash {
+			continue
+		}
+
+		detail := newCostDetail(
+			swap.TypeOut, swp.State(), &swp.Contract.SwapContract,
+			swp.Contract.MaxSwapRoutingFee+
+				swp.Contract.MaxPrepayRoutingFee,
+		)
+
+		lastUpdate := swp.LastUpdateTime()
+
+		if swp.Contract.PrepayInvoice == "" {
+			return detail, lastUpdate, nil
+		}
+
+		_, _, _, prepay, err := swap.DecodeInvoice(
+			s.lndServices.ChainParams, swp.Contract.PrepayInvoice,
+		)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("prepay invoice: %v",
+				err)
+		}
+
+		detail.Prepay = CostComponent{
+			Expected: prepay,
+			Limit:    prepay,
+		}
+		if detail.Quoted {
+			detail.Prepay.Expected = swp.Contract.Quote.PrepayAmount
+		}
+		if swp.Contract.PrepaySettled {
+			detail.Prepay.Realized = prepay
+		}
+
+		return detail, lastUpdate, nil
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	for _, swp := range loopInSwaps {
+		if swp.Hash != hash {
+			continue
+		}
+
+		detail := newCostDetail(
+			swap.TypeIn, swp.State(), &swp.Contract.SwapContract, 0,
+		)
+
+		return detail, swp.LastUpdateTime(), nil
+	}
+
+	return nil, time.Time{}, loopdb.ErrSwapNotFound
+}
+
+// newCostDetail compares the costs of the given swap state with the quote and
+// the limits of the swap's contract. Without a quote, the costs are compared
+// with the limits.
+func newCostDetail(swapType swap.Type, state loopdb.SwapStateData,
+	contract *loopdb.SwapContract,
+	maxRoutingFee btcutil.Amount) *CostDetail {
+
+	detail := &CostDetail{
+		SwapType: swapType,
+		State:    state.State,
+		SwapFee: CostComponent{
+			Expected: contract.MaxSwapFee,
+			Limit:    contract.MaxSwapFee,
+			Realized: state.Cost.Server,
+		},
+		RoutingFee: CostComponent{
+			Expected: maxRoutingFee,
+			Limit:    maxRoutingFee,
+			Realized: state.Cost.Offchain,
+		},
+		MinerFee: CostComponent{
+			Expected: contract.MaxMinerFee,
+			Limit:    contract.MaxMinerFee,
+			Realized: state.Cost.Onchain,
+		},
+	}
+
+	if contract.Quote != nil {
+		detail.Quoted = true
+		detail.SwapFee.Expected = contract.Quote.SwapFee
+		detail.MinerFee.Expected = contract.Quote.MinerFee
+	}
+
+	return detail
+}
@@ -0,0 +1,198 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapCostDetail tests that the realized costs of a swap are compared with
+// its quote, or the limits of its contract if it wasn't initiated with a
+// quote.
+func TestSwapCostDetail(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+	client := &Client{
+		clientConfig: clientConfig{
+			Store: store,
+		},
+		lndServices: &lnd.LndServices,
+	}
+
+	loopOutHash := lntypes.Hash{1}
+	prepayInvoice, err := getInvoice(loopOutHash, 300, prepayInvoiceDesc)
+	require.NoError(t, err)
+
+	store.LoopOutSwaps[loopOutHash] = &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			MaxSwapFee:  1000,
+			MaxMinerFee: 500,
+		},
+		PrepayInvoice:       prepayInvoice,
+		MaxSwapRoutingFee:   100,
+		MaxPrepayRoutingFee: 20,
+		PrepaySettled:       true,
+	}
+	store.LoopOutUpdates[loopOutHash] = []loopdb.SwapStateData{
+		{
+			State: loopdb.StateSuccess,
+			Cost: loopdb.SwapCost{
+				Server:   1000,
+				Onchain:  700,
+				Offchain: 80,
+			},
+		},
+	}
+
+	loopInHash := lntypes.Hash{2}
+	store.LoopInSwaps[loopInHash] = &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			MaxSwapFee:  2000,
+			MaxMinerFee: 300,
+		},
+	}
+	store.LoopInUpdates[loopInHash] = []loopdb.SwapStateData{}
+
+	ctx := context.Background()
+
+	detail, err := client.SwapCostDetail(ctx, loopOutHash)
+	require.NoError(t, err)
+	require.Equal(t, &CostDetail{
+		SwapType: swap.TypeOut,
+		State:    loopdb.StateSuccess,
+		SwapFee: CostComponent{
+			Expected: 1000,
+			Limit:    1000,
+			Realized: 1000,
+		},
+		Prepay: CostComponent{
+			Expected: 300,
+			Limit:    300,
+			Realized: 300,
+		},
+		RoutingFee: CostComponent{
+			Expected: 120,
+			Limit:    120,
+			Realized: 80,
+		},
+		MinerFee: CostComponent{
+			Expected: 500,
+			Limit:    500,
+			Realized: 700,
+		},
+	}, detail)
+	require.Equal(t, btcutil.Amount(200), detail.MinerFee.Variance())
+	require.Equal(t, btcutil.Amount(-40), detail.RoutingFee.Variance())
+	require.True(t, detail.Final())
+
+	detail, err = client.SwapCostDetail(ctx, loopInHash)
+	require.NoError(t, err)
+	require.Equal(t, &CostDetail{
+		SwapType: swap.TypeIn,
+		State:    loopdb.StateInitiated,
+		SwapFee: CostComponent{
+			Expected: 2000,
+			Limit:    2000,
+		},
+		MinerFee: CostComponent{
+			Expected: 300,
+			Limit:    300,
+		},
+	}, detail)
+	require.False(t, detail.Final())
+
+	// With a quote, the realized costs are compared with the quoted ones.
+	// A prepay that the server didn't settle isn't realized.
+	loopOut := store.LoopOutSwaps[loopOutHash]
+	loopOut.Quote = &loopdb.SwapQuote{
+		SwapFee:      900,
+		PrepayAmount: 250,
+		MinerFee:     400,
+	}
+	loopOut.PrepaySettled = false
+
+	detail, err = client.SwapCostDetail(ctx, loopOutHash)
+	require.NoError(t, err)
+	require.True(t, detail.Quoted)
+	require.Equal(t, CostComponent{
+		Expected: 900,
+		Limit:    1000,
+		Realized: 1000,
+	}, detail.SwapFee)
+	require.Equal(t, CostComponent{
+		Expected: 250,
+		Limit:    300,
+	}, detail.Prepay)
+	require.Equal(t, btcutil.Amount(300), detail.MinerFee.Variance())
+	require.Equal(t, btcutil.Amount(-40), detail.RoutingFee.Variance())
+
+	store.LoopInSwaps[loopInHash].Quote = &loopdb.SwapQuote{
+		SwapFee:  1500,
+		MinerFee: 200,
+	}
+
+	detail, err = client.SwapCostDetail(ctx, loopInHash)
+	require.NoError(t, err)
+	require.True(t, detail.Quoted)
+	require.Equal(t, btcutil.Amount(1500), detail.SwapFee.Expected)
+	require.Equal(t, btcutil.Amount(200), detail.MinerFee.Expected)
+	require.Equal(t, CostComponent{}, detail.Prepay)
+
+	_, err = client.SwapCostDetail(ctx, lntypes.Hash{3})
+	require.ErrorIs(t, err, loopdb.ErrSwapNotFound)
+
+	// With a price source, the costs are annotated with their fiat value.
+	prices := &mockPriceSource{price: 20_000}
+	client.PriceSource = prices
+	client.FiatCurrency = "usd"
+
+	detail, err = client.SwapCostDetail(ctx, loopOutHash)
+	require.NoError(t, err)
+	require.Equal(t, "usd", detail.Fiat.Currency)
+	require.InDelta(t, 0.2, detail.Fiat.SwapFee, 1e-9)
+	require.InDelta(t, 0.016, detail.Fiat.RoutingFee, 1e-9)
+	require.InDelta(t, 0.14, detail.Fiat.MinerFee, 1e-9)
+
+	// The price is requested for the time of the last update, which is
+	// the initiation time for swaps without updates.
+	initiationTime := time.Unix(1000, 0)
+	store.LoopInSwaps[loopInHash].InitiationTime = initiationTime
+
+	detail, err = client.SwapCostDetail(ctx, loopInHash)
+	require.NoError(t, err)
+	require.Equal(t, initiationTime, detail.Fiat.PriceTime)
+	require.Equal(t, initiationTime, prices.time)
+
+	// A failing price source leaves the costs without fiat value.
+	prices.err = errors.New("unavailable")
+	detail, err = client.SwapCostDetail(ctx, loopOutHash)
+	require.NoError(t, err)
+	require.Nil(t, detail.Fiat)
+}
+
+// mockPriceSource returns a fixed price and records the time of the last
+// request.
+type mockPriceSource struct {
+	price float64
+	err   error
+	time  time.Time
+}
+
+// BTCPrice returns the fixed price or error of the mock.
+func (m *mockPriceSource) BTCPrice(_ context.Context, _ string,
+	t time.Time) (float64, error) {
+
+	m.time = t
+
+	return m.price, m.err
+}
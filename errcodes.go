@@ -0,0 +1,264 @@
+package loop
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the error details that loopd attaches to its
+// rpc errors.
+const ErrorDomain = "loop"
+
+// categoryMetadataKey is the key of the error category in the metadata of the
+// error details.
+const categoryMetadataKey = "category"
+
+// ErrorCode is a stable identifier of a kind of error, which callers can
+// match on instead of parsing error messages.
+type ErrorCode string
+
+const (
+	// CodeUnknown is the code of errors that don't have a specific code.
+	CodeUnknown ErrorCode = "UNKNOWN"
+
+	// CodeSwapAmountTooLow is the code of ErrSwapAmountTooLow.
+	CodeSwapAmountTooLow ErrorCode = "SWAP_AMOUNT_TOO_LOW"
+
+	// CodeSwapAmountTooHigh is the code of ErrSwapAmountTooHigh.
+	CodeSwapAmountTooHigh ErrorCode = "SWAP_AMOUNT_TOO_HIGH"
+
+	// CodeSwapAmountUneconomical is the code of ErrSwapAmountUneconomical.
+	CodeSwapAmountUneconomical ErrorCode = "SWAP_AMOUNT_UNECONOMICAL"
+
+	// CodeSwapFeeTooHigh is the code of ErrSwapFeeTooHigh.
+	CodeSwapFeeTooHigh ErrorCode = "SWAP_FEE_TOO_HIGH"
+
+	// CodePrepayAmountTooHigh is the code of ErrPrepayAmountTooHigh.
+	CodePrepayAmountTooHigh ErrorCode = "PREPAY_AMOUNT_TOO_HIGH"
+
+	// CodeExpiryTooFar is the code of ErrExpiryTooFar.
+	CodeExpiryTooFar ErrorCode = "EXPIRY_TOO_FAR"
+
+	// CodeQuoteStale is the code of ErrQuoteStale.
+	CodeQuoteStale ErrorCode = "QUOTE_STALE"
+
+	// CodeDuplicateClientID is the code of ErrDuplicateClientID.
+	CodeDuplicateClientID ErrorCode = "DUPLICATE_CLIENT_ID"
+
+	// CodeDestAddrInvalid is the code of destination addresses that are
+	// for another network or of an unsupported type.
+	CodeDestAddrInvalid ErrorCode = "DEST_ADDR_INVALID"
+
+	// CodeDestAmountMismatch is the code of ErrDestAmountMismatch.
+	CodeDestAmountMismatch ErrorCode = "DEST_AMOUNT_MISMATCH"
+
+	// CodeLabelInvalid is the code of labels that are too long or use a
+	// reserved prefix.
+	CodeLabelInvalid ErrorCode = "LABEL_INVALID"
+
+	// CodeMetadataTooLarge is the code of loopdb.ErrMetadataTooLarge.
+	CodeMetadataTooLarge ErrorCode = "METADATA_TOO_LARGE"
+
+	// CodeInsufficientBalance is the code of ErrInsufficientBalance.
+	CodeInsufficientBalance ErrorCode = "INSUFFICIENT_BALANCE"
+
+	// CodeMaxOutstandingValueExceeded is the code of
+	// ErrMaxOutstandingValueExceeded.
+	CodeMaxOutstandingValueExceeded ErrorCode = "MAX_OUTSTANDING_VALUE_" +
+		"EXCEEDED"
+
+	// CodeRouteNotFound is the code of ErrNoRoute.
+	CodeRouteNotFound ErrorCode = "ROUTE_NOT_FOUND"
+
+	// CodeSwapNotFound is the code of loopdb.ErrSwapNotFound.
+	CodeSwapNotFound ErrorCode = "SWAP_NOT_FOUND"
+
+	// CodeNoLiquidityEffect is the code of ErrNoLiquidityEffect.
+	CodeNoLiquidityEffect ErrorCode = "NO_LIQUIDITY_EFFECT"
+
+	// CodeSwapNotCancelable is the code of ErrSwapNotCancelable.
+	CodeSwapNotCancelable ErrorCode = "SWAP_NOT_CANCELABLE"
+
+	// CodeSwapFinalized is the code of ErrSwapFinalized.
+	CodeSwapFinalized ErrorCode = "SWAP_FINALIZED"
+
+	// CodePaused is the code of ErrPaused.
+	CodePaused ErrorCode = "PAUSED"
+
+	// CodeClientRunning is the code of ErrClientRunning.
+	CodeClientRunning ErrorCode = "CLIENT_RUNNING"
+
+	// CodeServerUnavailable is the code of errors that occur because the
+	// swap server can't be reached.
+	CodeServerUnavailable ErrorCode = "SERVER_UNAVAILABLE"
+
+	// CodeTimeout is the code of requests that timed out.
+	CodeTimeout ErrorCode = "TIMEOUT"
+)
+
+// ErrorCategory groups error codes by what a caller can do about them.
+type ErrorCategory string
+
+const (
+	// CategoryUnknown is the category of errors without a specific code.
+	CategoryUnknown ErrorCategory = "UNKNOWN"
+
+	// CategoryInvalidRequest is the category of errors that are resolved
+	// by changing the request.
+	CategoryInvalidRequest ErrorCategory = "INVALID_REQUEST"
+
+	// CategoryLiquidity is the category of errors that depend on the
+	// node's balance or channels.
+	CategoryLiquidity ErrorCategory = "LIQUIDITY"
+
+	// CategoryNotFound is the category of errors about swaps or records
+	// that don't exist.
+	CategoryNotFound ErrorCategory = "NOT_FOUND"
+
+	// CategoryFailedPrecondition is the category of errors about
+	// operations that aren't possible in the current state.
+	CategoryFailedPrecondition ErrorCategory = "FAILED_PRECONDITION"
+
+	// CategoryUnavailable is the category of temporary errors, the
+	// request can be retried later.
+	CategoryUnavailable ErrorCategory = "UNAVAILABLE"
+)
+
+// codedError associates a sentinel error with its code and category.
+type codedError struct {
+	err      error
+	code     ErrorCode
+	category ErrorCategory
+}
+
+// codedErrors holds the codes of the sentinel errors that are returned to
+// callers.
+var codedErrors = []codedError{
+	{ErrSwapAmountTooLow, CodeSwapAmountTooLow, CategoryInvalidRequest},
+	{ErrSwapAmountTooHigh, CodeSwapAmountTooHigh, CategoryInvalidRequest},
+	{
+		ErrSwapAmountUneconomical, CodeSwapAmountUneconomical,
+		CategoryInvalidRequest,
+	},
+	{ErrSwapFeeTooHigh, CodeSwapFeeTooHigh, CategoryInvalidRequest},
+	{
+		ErrPrepayAmountTooHigh, CodePrepayAmountTooHigh,
+		CategoryInvalidRequest,
+	},
+	{ErrExpiryTooFar, CodeExpiryTooFar, CategoryInvalidRequest},
+	{ErrQuoteStale, CodeQuoteStale, CategoryInvalidRequest},
+	{ErrDuplicateClientID, CodeDuplicateClientID, CategoryInvalidRequest},
+	{ErrDestAddrWrongNetwork, CodeDestAddrInvalid, CategoryInvalidRequest},
+	{ErrDestAddrUnsupported, CodeDestAddrInvalid, CategoryInvalidRequest},
+	{ErrDestAmountMismatch, CodeDestAmountMismatch, CategoryInvalidRequest},
+	{labels.ErrLabelTooLong, CodeLabelInvalid, CategoryInvalidRequest},
+	{labels.ErrReservedPrefix, CodeLabelInvalid, CategoryInvalidRequest},
+	{
+		loopdb.ErrMetadataTooLarge, CodeMetadataTooLarge,
+		CategoryInvalidRequest,
+	},
+	{ErrInsufficientBalance, CodeInsufficientBalance, CategoryLiquidity},
+	{
+		ErrMaxOutstandingValueExceeded, CodeMaxOutstandingValueExceeded,
+		CategoryLiquidity,
+	},
+	{ErrNoRoute, CodeRouteNotFound, CategoryLiquidity},
+	{loopdb.ErrSwapNotFound, CodeSwapNotFound, CategoryNotFound},
+	{ErrNoLiquidityEffect, CodeNoLiquidityEffect, CategoryNotFound},
+	{
+		ErrSwapNotCancelable, CodeSwapNotCancelable,
+		CategoryFailedPrecondition,
+	},
+	{ErrSwapFinalized, CodeSwapFinalized, CategoryFailedPrecondition},
+	{ErrPaused, CodePaused, CategoryUnavailable},
+	{ErrClientRunning, CodeClientRunning, CategoryFailedPrecondition},
+	{context.DeadlineExceeded, CodeTimeout, CategoryUnavailable},
+}
+
+// ErrorCodeOf returns the code and category of an error. Errors that don't
+// have a specific code are reported as CodeUnknown.
+func ErrorCodeOf(err error) (ErrorCode, ErrorCategory) {
+	for _, coded := range codedErrors {
+		if errors.Is(err, coded.err) {
+			return coded.code, coded.category
+		}
+	}
+
+	// Errors of the swap server keep their grpc status if they are
+	// wrapped with %w.
+	if status.Code(err) == codes.Unavailable {
+		return CodeServerUnavailable, CategoryUnavailable
+	}
+
+	return CodeUnknown, CategoryUnknown
+}
+
+// grpcCodes maps the error categories to grpc status codes.
+var grpcCodes = map[ErrorCategory]codes.Code{
+	CategoryInvalidRequest:     codes.InvalidArgument,
+	CategoryLiquidity:          codes.FailedPrecondition,
+	CategoryNotFound:           codes.NotFound,
+	CategoryFailedPrecondition: codes.FailedPrecondition,
+	CategoryUnavailable:        codes.Unavailable,
+}
+
+// ToRPCError converts an error into a grpc status error with a matching
+// status code. The error code and category are attached as ErrorInfo
+// details, so that they survive the rpc boundary. Errors without a code and
+// errors that already are status errors are returned unchanged.
+func ToRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+
+	code, category := ErrorCodeOf(err)
+	if code == CodeUnknown {
+		return err
+	}
+
+	st := status.New(grpcCodes[category], err.Error())
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(code),
+		Domain: ErrorDomain,
+		Metadata: map[string]string{
+			categoryMetadataKey: string(category),
+		},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// RPCErrorCode returns the code and category that are attached to an error
+// returned by loopd. Errors without attached details are reported as
+// CodeUnknown.
+func RPCErrorCode(err error) (ErrorCode, ErrorCategory) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return CodeUnknown, CategoryUnknown
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != ErrorDomain {
+			continue
+		}
+
+		return ErrorCode(info.Reason),
+			ErrorCategory(info.Metadata[categoryMetadataKey])
+	}
+
+	return CodeUnknown, CategoryUnknown
+}
@@ -0,0 +1,70 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRPCErrorCodes tests that error codes survive the conversion into grpc
+// status errors.
+func TestRPCErrorCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		grpcCode codes.Code
+		code     ErrorCode
+		category ErrorCategory
+	}{
+		{
+			name:     "wrapped sentinel",
+			err:      fmt.Errorf("quote: %w", ErrSwapAmountTooLow),
+			grpcCode: codes.InvalidArgument,
+			code:     CodeSwapAmountTooLow,
+			category: CategoryInvalidRequest,
+		},
+		{
+			name:     "no route",
+			err:      ErrNoRoute,
+			grpcCode: codes.FailedPrecondition,
+			code:     CodeRouteNotFound,
+			category: CategoryLiquidity,
+		},
+		{
+			name: "server unavailable",
+			err: fmt.Errorf("terms: %w", status.Error(
+				codes.Unavailable, "connection refused",
+			)),
+			grpcCode: codes.Unavailable,
+			code:     CodeServerUnavailable,
+			category: CategoryUnavailable,
+		},
+		{
+			name:     "unknown error",
+			err:      errors.New("unknown"),
+			grpcCode: codes.Unknown,
+			code:     CodeUnknown,
+			category: CategoryUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			rpcErr := ToRPCError(test.err)
+			require.Equal(t, test.grpcCode, status.Code(rpcErr))
+			require.Contains(t, rpcErr.Error(), test.err.Error())
+
+			code, category := RPCErrorCode(rpcErr)
+			require.Equal(t, test.code, code)
+			require.Equal(t, test.category, category)
+		})
+	}
+
+	require.NoError(t, ToRPCError(nil))
+}
@@ -36,9 +36,15 @@ type executorConfig struct {
 
 	maxPaymentRetries int
 
+	repushDelay time.Duration
+
 	cancelSwap func(ctx context.Context, details *outCancelDetails) error
 
 	verifySchnorrSig func(pubKey *btcec.PublicKey, hash, sig []byte) error
+
+	// swapDone is an optional function that is called with the state of
+	// a swap once the execution of the swap returned.
+	swapDone func(ctx context.Context, info *SwapInfo)
 }
 
 // executor is responsible for executing swaps.
@@ -50,17 +56,56 @@ type executor struct {
 	currentHeight uint32
 	ready         chan struct{}
 
+	// lastErr is the last error that a swap execution failed with. It is
+	// guarded by the executor's mutex.
+	lastErr error
+
+	// queuedSwaps is the number of swaps that are waiting to be accepted
+	// by the event loop.
+	queuedSwaps int32 // To be used atomically.
+
+	// activeSwaps holds the swaps that are being executed by swap hash.
+	// It is guarded by the executor's mutex.
+	activeSwaps map[lntypes.Hash]*activeSwap
+
+	// statusUpdates receives the status updates of the swaps, which are
+	// forwarded to the status channel of the executor's run.
+	statusUpdates chan SwapInfo
+
+	// runCtx is the context of the executor's run. It is nil until the
+	// executor runs and is guarded by the executor's mutex.
+	runCtx context.Context
+
 	sync.Mutex
 
 	executorConfig
 }
 
+// activeSwap is the executor's record of a swap that it is executing. The
+// swap itself is owned by its goroutine while it executes, so its state is
+// only read from the record.
+type activeSwap struct {
+	// swap is the swap that is being executed.
+	swap genericSwap
+
+	// startOrder orders the swaps by the start of their execution.
+	startOrder int
+
+	// status is the last status update of the swap.
+	status SwapInfo
+
+	// debug is the debug state of the swap.
+	debug SwapDebugState
+}
+
 // newExecutor returns a new swap executor instance.
 func newExecutor(cfg *executorConfig) *executor {
 	return &executor{
 		executorConfig: *cfg,
 		newSwaps:       make(chan genericSwap),
 		ready:          make(chan struct{}),
+		activeSwaps:    make(map[lntypes.Hash]*activeSwap),
+		statusUpdates:  make(chan SwapInfo),
 	}
 }
 
@@ -140,6 +185,42 @@ func (s *executor) run(mainCtx context.Context,
 		}
 	}()
 
+	// Watch for channel closes, so that we can report the swaps that rely
+	// on the closed channels. The swaps don't depend on this, so we carry
+	// on without watching if the subscription fails.
+	chanEventChan, chanErrChan, err :=
+		s.lnd.Client.SubscribeChannelEvents(mainCtx)
+	if err != nil {
+		log.Warnf("Unable to subscribe to channel events: %v", err)
+	}
+
+	// Forward the status updates of the swaps, so that their last state
+	// can be recorded for debugging.
+	s.Lock()
+	s.runCtx = mainCtx
+	s.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		for {
+			select {
+			case info := <-s.statusUpdates:
+				s.swapUpdated(info)
+
+				select {
+				case statusChan <- info:
+				case <-mainCtx.Done():
+					return
+				}
+
+			case <-mainCtx.Done():
+				return
+			}
+		}
+	}()
+
 	// Start main event loop.
 	log.Infof("Starting event loop at height %v", height)
 
@@ -148,7 +229,7 @@ func (s *executor) run(mainCtx context.Context,
 
 	// Use a map to administer the individual notification queues for the
 	// swaps.
-	blockEpochQueues := make(map[int]*queue.ConcurrentQueue)
+	blockEpochQueues := make(map[lntypes.Hash]*queue.ConcurrentQueue)
 
 	// On exit, stop all queue goroutines.
 	defer func() {
@@ -157,23 +238,32 @@ func (s *executor) run(mainCtx context.Context,
 		}
 	}()
 
-	swapDoneChan := make(chan int)
-	nextSwapID := 0
+	swapDoneChan := make(chan lntypes.Hash)
+	nextStartOrder := 0
 
 	for {
 		select {
 		case newSwap := <-s.newSwaps:
+			// Read the initial status before the swap executes, as
+			// it is owned by the swap's goroutine afterwards.
+			info := newSwap.swapInfo()
+			hash := info.SwapHash
+
 			queue := queue.NewConcurrentQueue(10)
 			queue.Start()
-			swapID := nextSwapID
-			blockEpochQueues[swapID] = queue
+			blockEpochQueues[hash] = queue
+			s.swapStarted(newSwap, info, nextStartOrder, height)
+			nextStartOrder++
+
+			newSwap.swapLog().Infof("Executing %v swap at height "+
+				"%v", info.SwapType, height)
 
 			s.wg.Add(1)
 			go func() {
 				defer s.wg.Done()
 
 				err := newSwap.execute(mainCtx, &executeConfig{
-					statusChan:          statusChan,
+					statusChan:          s.statusUpdates,
 					sweeper:             s.sweeper,
 					batcher:             s.batcher,
 					blockEpochChan:      queue.ChanOut(),
@@ -181,6 +271,7 @@ func (s *executor) run(mainCtx context.Context,
 					loopOutMaxParts:     s.executorConfig.loopOutMaxParts,
 					totalPaymentTimeout: s.executorConfig.totalPaymentTimeout,
 					maxPaymentRetries:   s.executorConfig.maxPaymentRetries,
+					repushDelay:         s.executorConfig.repushDelay,
 					cancelSwap:          s.executorConfig.cancelSwap,
 					verifySchnorrSig:    s.executorConfig.verifySchnorrSig,
 				}, height)
@@ -188,35 +279,45 @@ func (s *executor) run(mainCtx context.Context,
 					err, context.Canceled,
 				) {
 
-					log.Errorf("Execute error: %v", err)
-				}
+					newSwap.swapLog().Errorf(
+						"Execute error: %v", err,
+					)
 
-				// If a loop-in ended we have to remove its
-				// abandon channel from our abandonChans map
-				// since the swap finalized.
-				if swap, ok := newSwap.(*loopInSwap); ok {
 					s.Lock()
-					delete(abandonChans, swap.hash)
+					s.lastErr = err
 					s.Unlock()
 				}
 
+				newSwap.swapLog().Infof("Swap execution ended")
+
+				if s.swapDone != nil {
+					s.swapDone(mainCtx, newSwap.swapInfo())
+				}
+
+				// If a swap ended we have to remove its
+				// abandon channel from our abandonChans map
+				// since the swap finalized.
+				s.Lock()
+				delete(abandonChans, hash)
+				s.Unlock()
+
 				select {
-				case swapDoneChan <- swapID:
+				case swapDoneChan <- hash:
 				case <-mainCtx.Done():
 				}
 			}()
 
-			nextSwapID++
-
-		case doneID := <-swapDoneChan:
-			queue, ok := blockEpochQueues[doneID]
+		case doneHash := <-swapDoneChan:
+			queue, ok := blockEpochQueues[doneHash]
 			if !ok {
 				return fmt.Errorf(
-					"swap id %v not found in queues",
-					doneID)
+					"swap %v not found in queues",
+					doneHash)
 			}
 			queue.Stop()
-			delete(blockEpochQueues, doneID)
+			delete(blockEpochQueues, doneHash)
+
+			s.swapFinished(doneHash)
 
 		case h := <-blockEpochChan:
 			setHeight(h)
@@ -228,6 +329,26 @@ func (s *executor) run(mainCtx context.Context,
 				}
 			}
 
+		case update := <-chanEventChan:
+			if update.UpdateType != lndclient.ClosedChannelUpdate ||
+				update.ClosedChannelInfo == nil {
+
+				continue
+			}
+
+			err := s.reportChannelClosed(
+				mainCtx, update.ClosedChannelInfo,
+			)
+			if err != nil {
+				return err
+			}
+
+		case err := <-chanErrChan:
+			log.Warnf("Channel event subscription failed, no longer "+
+				"watching for channel closes: %v", err)
+
+			chanEventChan, chanErrChan = nil, nil
+
 		case err := <-blockErrorChan:
 			return fmt.Errorf("block error: %v", err)
 
@@ -240,10 +361,100 @@ func (s *executor) run(mainCtx context.Context,
 	}
 }
 
+// swapStarted records the start of the execution of a swap with its initial
+// status. It must be called before the swap is executed.
+func (s *executor) swapStarted(swp genericSwap, info *SwapInfo,
+	startOrder int, height int32) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.activeSwaps[info.SwapHash] = &activeSwap{
+		swap:       swp,
+		startOrder: startOrder,
+		status:     *info,
+		debug: SwapDebugState{
+			SwapHash:    info.SwapHash,
+			SwapType:    info.SwapType,
+			State:       info.State,
+			StartHeight: height,
+			CltvExpiry:  info.CltvExpiry,
+			LastUpdate:  info.LastUpdate,
+		},
+	}
+}
+
+// swapUpdated records a status update of an active swap. Updates of swaps
+// that aren't active are ignored.
+func (s *executor) swapUpdated(info SwapInfo) {
+	s.Lock()
+	defer s.Unlock()
+
+	active, ok := s.activeSwaps[info.SwapHash]
+	if !ok {
+		return
+	}
+
+	// The closed channel is only reported on the update that reports the
+	// close.
+	info.ClosedChannel = nil
+	active.status = info
+
+	active.debug.State = info.State
+	active.debug.LastUpdate = info.LastUpdate
+}
+
+// swapFinished removes a swap whose execution returned.
+func (s *executor) swapFinished(hash lntypes.Hash) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.activeSwaps, hash)
+}
+
+// reportChannelClosed sends a status update for all active swaps that rely on
+// the closed channel, because their off-chain payments may no longer be
+// routable. The update repeats the swap's last status with the closed channel
+// set.
+func (s *executor) reportChannelClosed(ctx context.Context,
+	channel *lndclient.ClosedChannel) error {
+
+	var affected []SwapInfo
+
+	s.Lock()
+	for _, active := range s.activeSwaps {
+		if !active.swap.affectedByClose(channel) {
+			continue
+		}
+
+		active.swap.swapLog().Warnf("Channel %v with peer %v was "+
+			"closed (%v, closing tx %v), the swap payment may no "+
+			"longer be routable", channel.ChannelID,
+			channel.PubKeyBytes, channel.CloseType,
+			channel.ClosingTxHash)
+
+		info := active.status
+		info.ClosedChannel = channel
+		affected = append(affected, info)
+	}
+	s.Unlock()
+
+	for _, info := range affected {
+		if err := s.sendStatus(ctx, info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // initiateSwap delivers a new swap to the executor main loop.
 func (s *executor) initiateSwap(ctx context.Context,
 	swap genericSwap) {
 
+	atomic.AddInt32(&s.queuedSwaps, 1)
+	defer atomic.AddInt32(&s.queuedSwaps, -1)
+
 	select {
 	case s.newSwaps <- swap:
 	case <-ctx.Done():
@@ -251,11 +462,70 @@ func (s *executor) initiateSwap(ctx context.Context,
 	}
 }
 
+// sendStatus delivers a status update that doesn't originate from an
+// executing swap, like the swaps do. The update is dropped if the executor
+// isn't running.
+func (s *executor) sendStatus(ctx context.Context, info SwapInfo) error {
+	s.Lock()
+	runCtx := s.runCtx
+	s.Unlock()
+
+	if runCtx == nil {
+		return nil
+	}
+
+	select {
+	case s.statusUpdates <- info:
+		return nil
+
+	case <-runCtx.Done():
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // height returns the current height known to the swap server.
 func (s *executor) height() int32 {
 	return int32(atomic.LoadUint32(&s.currentHeight))
 }
 
+// activeSwapCount returns the number of swaps that are being executed.
+func (s *executor) activeSwapCount() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return len(s.activeSwaps)
+}
+
+// lastError returns the last error that a swap execution failed with.
+func (s *executor) lastError() error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.lastErr
+}
+
+// liveness returns an error if the executor isn't running its event loop.
+func (s *executor) liveness() error {
+	s.Lock()
+	runCtx := s.runCtx
+	s.Unlock()
+
+	select {
+	case <-s.ready:
+	default:
+		return errors.New("executor is waiting for the first block")
+	}
+
+	if runCtx == nil || runCtx.Err() != nil {
+		return errors.New("executor is not running")
+	}
+
+	return nil
+}
+
 // waitFinished waits for all swap goroutines to finish.
 func (s *executor) waitFinished() {
 	s.wg.Wait()
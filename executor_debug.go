@@ -0,0 +1,92 @@
+package loop
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// SwapDebugState is the executor's view of a swap that it is executing.
+type SwapDebugState struct {
+	// SwapHash is the hash of the swap.
+	SwapHash lntypes.Hash
+
+	// SwapType is the type of the swap.
+	SwapType swap.Type
+
+	// State is the state of the swap according to its last status update.
+	State loopdb.SwapState
+
+	// StartHeight is the block height at which the executor started
+	// executing the swap.
+	StartHeight int32
+
+	// CltvExpiry is the expiry height of the swap's htlc. The deadlines
+	// that the swap waits for are derived from it.
+	CltvExpiry int32
+
+	// LastUpdate is the time of the swap's last status update.
+	LastUpdate time.Time
+}
+
+// ExecutorDebugState is a snapshot of the internal state of the swap
+// executor.
+type ExecutorDebugState struct {
+	// Height is the last block height that the executor processed.
+	Height int32
+
+	// Ready indicates whether the executor received its first block and
+	// started executing swaps.
+	Ready bool
+
+	// ActiveSwaps are the swaps that are being executed, in the order in
+	// which their execution started.
+	ActiveSwaps []SwapDebugState
+
+	// QueuedSwaps is the number of swaps that are waiting to be accepted
+	// by the executor.
+	QueuedSwaps int
+
+	// LastError is the last error that a swap execution failed with.
+	LastError error
+}
+
+// debugState returns a snapshot of the executor's internal state. It is safe
+// to call concurrently with the executor's event loop.
+func (s *executor) debugState() *ExecutorDebugState {
+	debugState := &ExecutorDebugState{
+		Height:      s.height(),
+		QueuedSwaps: int(atomic.LoadInt32(&s.queuedSwaps)),
+	}
+
+	select {
+	case <-s.ready:
+		debugState.Ready = true
+	default:
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	debugState.LastError = s.lastErr
+
+	activeSwaps := make([]*activeSwap, 0, len(s.activeSwaps))
+	for _, active := range s.activeSwaps {
+		activeSwaps = append(activeSwaps, active)
+	}
+	sort.Slice(activeSwaps, func(i, j int) bool {
+		return activeSwaps[i].startOrder < activeSwaps[j].startOrder
+	})
+
+	for _, active := range activeSwaps {
+		debugState.ActiveSwaps = append(
+			debugState.ActiveSwaps, active.debug,
+		)
+	}
+
+	return debugState
+}
@@ -0,0 +1,78 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExecutorDebugState tests that the debug state of the executor reflects
+// the start, status updates and completion of swaps.
+func TestExecutorDebugState(t *testing.T) {
+	executor := newExecutor(&executorConfig{})
+
+	newSwap := func(hash lntypes.Hash) *loopOutSwap {
+		return &loopOutSwap{
+			swapKit: swapKit{
+				hash:     hash,
+				swapType: swap.TypeOut,
+				state:    loopdb.StateInitiated,
+				contract: &loopdb.SwapContract{
+					CltvExpiry: 200,
+				},
+			},
+		}
+	}
+
+	firstHash := lntypes.Hash{1}
+	secondHash := lntypes.Hash{2}
+
+	start := func(hash lntypes.Hash, startOrder int) {
+		swp := newSwap(hash)
+		executor.swapStarted(swp, swp.swapInfo(), startOrder, 100)
+	}
+	start(secondHash, 1)
+	start(firstHash, 0)
+	require.Equal(t, 2, executor.activeSwapCount())
+
+	executor.swapUpdated(SwapInfo{
+		SwapHash: secondHash,
+		SwapStateData: loopdb.SwapStateData{
+			State: loopdb.StatePreimageRevealed,
+		},
+	})
+
+	// Updates of swaps that aren't active are ignored.
+	executor.swapUpdated(SwapInfo{
+		SwapHash: lntypes.Hash{3},
+	})
+
+	debugState := executor.debugState()
+	require.False(t, debugState.Ready)
+	require.Equal(t, []SwapDebugState{
+		{
+			SwapHash:    firstHash,
+			SwapType:    swap.TypeOut,
+			State:       loopdb.StateInitiated,
+			StartHeight: 100,
+			CltvExpiry:  200,
+		},
+		{
+			SwapHash:    secondHash,
+			SwapType:    swap.TypeOut,
+			State:       loopdb.StatePreimageRevealed,
+			StartHeight: 100,
+			CltvExpiry:  200,
+		},
+	}, debugState.ActiveSwaps)
+
+	executor.swapFinished(firstHash)
+	require.Equal(t, 1, executor.activeSwapCount())
+
+	debugState = executor.debugState()
+	require.Len(t, debugState.ActiveSwaps, 1)
+	require.Equal(t, secondHash, debugState.ActiveSwaps[0].SwapHash)
+}
@@ -0,0 +1,77 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReportChannelClosed tests that a channel close is reported in a status
+// update of the swaps that rely on the closed channel, without changing their
+// last recorded status.
+func TestReportChannelClosed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	executor := newExecutor(&executorConfig{})
+	executor.runCtx = ctx
+
+	newSwap := func(hash lntypes.Hash, chanID uint64) *loopOutSwap {
+		swp := &loopOutSwap{
+			swapKit: swapKit{
+				hash:     hash,
+				swapType: swap.TypeOut,
+				state:    loopdb.StateInitiated,
+				contract: &loopdb.SwapContract{},
+				log:      newSwapLogger(hash),
+			},
+		}
+		swp.OutgoingChanSet = loopdb.ChannelSet{chanID}
+
+		return swp
+	}
+
+	affectedHash := lntypes.Hash{1}
+	otherHash := lntypes.Hash{2}
+	for i, swp := range []*loopOutSwap{
+		newSwap(affectedHash, 123), newSwap(otherHash, 124),
+	} {
+		executor.swapStarted(swp, swp.swapInfo(), i, 100)
+	}
+
+	// The last status of the affected swap is repeated in the update.
+	executor.swapUpdated(SwapInfo{
+		SwapHash: affectedHash,
+		SwapStateData: loopdb.SwapStateData{
+			State: loopdb.StatePreimageRevealed,
+		},
+	})
+
+	closed := &lndclient.ClosedChannel{
+		ChannelID: 123,
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- executor.reportChannelClosed(ctx, closed)
+	}()
+
+	info := <-executor.statusUpdates
+	require.NoError(t, <-errChan)
+
+	require.Equal(t, affectedHash, info.SwapHash)
+	require.Equal(t, loopdb.StatePreimageRevealed, info.State)
+	require.Equal(t, closed, info.ClosedChannel)
+
+	// Recording the update doesn't carry the closed channel over to later
+	// updates.
+	executor.swapUpdated(info)
+	require.Nil(
+		t, executor.activeSwaps[affectedHash].status.ClosedChannel,
+	)
+}
@@ -0,0 +1,70 @@
+package loop
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxHealthHeightLag is the number of blocks that the executor may be behind
+// lnd before it is reported as unhealthy.
+const maxHealthHeightLag = 3
+
+// HealthStatus reports the health of each subsystem that swaps depend on. A
+// nil error means that the subsystem is healthy.
+type HealthStatus struct {
+	// Lnd is the error of querying lnd.
+	Lnd error
+
+	// Server is the error of querying the swap server.
+	Server error
+
+	// Database is the error of a test write to the swap store.
+	Database error
+
+	// Executor is set if the swap executor isn't running or doesn't keep
+	// up with lnd's block height.
+	Executor error
+}
+
+// Healthy returns true if all subsystems are healthy.
+func (h *HealthStatus) Healthy() bool {
+	return h.Lnd == nil && h.Server == nil && h.Database == nil &&
+		h.Executor == nil
+}
+
+// Health checks that lnd and the swap server are reachable, that the swap
+// store accepts writes and that the swap executor is alive. All subsystems
+// are checked, even if one of them is unhealthy.
+func (s *Client) Health(ctx context.Context) *HealthStatus {
+	status := &HealthStatus{}
+
+	info, err := s.lndServices.Client.GetInfo(ctx)
+	if err != nil {
+		status.Lnd = fmt.Errorf("unable to query lnd: %v", err)
+	}
+
+	_, err = s.Server.GetLoopOutTerms(ctx, "")
+	if err != nil {
+		status.Server = fmt.Errorf("unable to query swap server: %v",
+			err)
+	}
+
+	err = s.Store.CheckWritable(ctx)
+	if err != nil {
+		status.Database = fmt.Errorf("swap store not writable: %v", err)
+	}
+
+	status.Executor = s.executor.liveness()
+
+	// The executor relies on lnd's block notifications, so it should
+	// know about lnd's latest block.
+	if status.Executor == nil && info != nil {
+		lag := int64(info.BlockHeight) - int64(s.executor.height())
+		if lag > maxHealthHeightLag {
+			status.Executor = fmt.Errorf("executor is %v blocks "+
+				"behind lnd", lag)
+		}
+	}
+
+	return status
+}
@@ -0,0 +1,56 @@
+package loop
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHealth tests that the health of the swap executor is reported
+// separately from the other subsystems.
+func TestHealth(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	executor := newExecutor(&executorConfig{})
+	client := &Client{
+		clientConfig: clientConfig{
+			LndServices: &lnd.LndServices,
+			Server:      newServerMock(lnd),
+			Store:       loopdb.NewStoreMock(t),
+		},
+		lndServices: &lnd.LndServices,
+		executor:    executor,
+	}
+
+	ctx := context.Background()
+
+	// The executor didn't receive a block yet.
+	status := client.Health(ctx)
+	require.False(t, status.Healthy())
+	require.NoError(t, status.Lnd)
+	require.NoError(t, status.Server)
+	require.NoError(t, status.Database)
+	require.Error(t, status.Executor)
+
+	// Once the executor runs, it is healthy as long as it keeps up with
+	// lnd's block height of 600.
+	runCtx, cancel := context.WithCancel(ctx)
+	executor.runCtx = runCtx
+	close(executor.ready)
+
+	atomic.StoreUint32(&executor.currentHeight, 599)
+	require.True(t, client.Health(ctx).Healthy())
+
+	atomic.StoreUint32(&executor.currentHeight, 590)
+	require.Error(t, client.Health(ctx).Executor)
+
+	// A stopped executor is unhealthy.
+	atomic.StoreUint32(&executor.currentHeight, 600)
+	cancel()
+	require.Error(t, client.Health(ctx).Executor)
+}
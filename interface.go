@@ -4,9 +4,12 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
@@ -17,7 +20,8 @@ type OutRequest struct {
 	// include the swap and miner fee.
 	Amount btcutil.Amount
 
-	// Destination address for the swap.
+	// Destination address for the swap. If nil, a new address of lnd's
+	// wallet is used.
 	DestAddr btcutil.Address
 
 	// IsExternalAddr indicates whether the provided destination address
@@ -50,8 +54,9 @@ type OutRequest struct {
 
 	// MaxMinerFee is the maximum in on-chain fees that we are willing to
 	// spent. If we want to sweep the on-chain htlc and the fee estimate
-	// turns out higher than this value, we cancel the swap. If the fee
-	// estimate is lower, we publish the sweep tx.
+	// turns out higher than this value, we hold the sweep until the
+	// estimate comes down or the htlc gets close to its timeout. If the
+	// fee estimate is lower, we publish the sweep tx.
 	//
 	// If the sweep tx isn't confirmed, we are forced to ratchet up fees
 	// until it is swept. Possibly even exceeding MaxMinerFee if we get
@@ -70,14 +75,31 @@ type OutRequest struct {
 	// client sweep tx.
 	SweepConfTarget int32
 
+	// SwapExpiryDelta is an optional preferred number of blocks until the
+	// on-chain htlc expires. It is raised to at least the sweep
+	// confirmation target and clamped to the server's cltv delta limits.
+	// If zero, the expiry is derived from the sweep confirmation target.
+	SwapExpiryDelta int32
+
 	// HtlcConfirmations specifies the number of confirmations we require
-	// for on chain loop out htlcs.
+	// for on chain loop out htlcs. The preimage is only revealed to the
+	// server once the htlc has this many confirmations, so it controls
+	// the reveal timing of the swap. More confirmations protect against
+	// the htlc being reorged out after the preimage was revealed, which
+	// would allow the server to settle the swap payment without us being
+	// able to sweep. Fewer confirmations complete the swap faster. If
+	// zero, the default number of confirmations is used.
 	HtlcConfirmations int32
 
 	// OutgoingChanSet optionally specifies the short channel ids of the
 	// channels that may be used to loop out.
 	OutgoingChanSet loopdb.ChannelSet
 
+	// OutgoingPeers optionally specifies peers whose channels may be used
+	// to loop out. The client adds the open channels with these peers to
+	// the outgoing channel set.
+	OutgoingPeers []route.Vertex
+
 	// SwapPublicationDeadline can be set by the client to allow the server
 	// delaying publication of the swap HTLC to save on chain fees.
 	SwapPublicationDeadline time.Time
@@ -88,10 +110,66 @@ type OutRequest struct {
 	// Label contains an optional label for the swap.
 	Label string
 
+	// Metadata is an optional opaque blob of at most
+	// loopdb.MaxMetadataSize bytes that is stored with the swap.
+	Metadata []byte
+
 	// Initiator is an optional string that identifies what software
 	// initiated the swap (loop CLI, autolooper, LiT UI and so on) and is
 	// appended to the user agent string.
 	Initiator string
+
+	// RoundAmount rounds the swap amount down to a multiple of the amount
+	// increment of the loop out terms. The adjusted amount is reported
+	// back in the swap info. As long as the server doesn't announce an
+	// increment, the amount is only checked against the server minimum.
+	RoundAmount bool
+
+	// Quote is an optional quote that the swap is based on. If set, the
+	// swap is rejected if the server asks for a higher swap fee or prepay
+	// amount than quoted, if the sweep fee estimated at initiation exceeds
+	// the quoted miner fee by more than 10%, or if the quote is older than
+	// MaxQuoteAge. The quote is stored with the swap, so that its costs can
+	// be compared with the quoted ones.
+	Quote *LoopOutQuote
+
+	// MaxQuoteAge is the maximum age of the quote at the time the swap is
+	// initiated. A zero value doesn't limit the age of the quote.
+	MaxQuoteAge time.Duration
+
+	// ClientID is an optional identifier that the caller assigns to the
+	// swap. It must be unique among all loop out swaps and can be used to
+	// look up the swap with GetSwapByClientID.
+	ClientID string
+
+	// ProbeBeforePay makes the client query lnd for routes to the server
+	// that pay the swap and prepay invoices within the routing fee limits
+	// before the swap is registered with the server. If an outgoing
+	// channel set is given, the routes must start with one of its
+	// channels. If the payments may be split into more than one part,
+	// only the balance of the outgoing channels is checked, because lnd
+	// only queries single path routes. If the swap can't be paid, it
+	// fails with ErrNoRoute instead of failing on a slow payment attempt
+	// later on.
+	ProbeBeforePay bool
+
+	// SweepWhenFeeBelow holds the sweep of the htlc back until the
+	// estimated fee rate for the sweep conf target drops below this
+	// value. If the fee rate stays higher, the htlc is swept regardless
+	// once the swap comes close to its expiry. Zero sweeps right away.
+	SweepWhenFeeBelow chainfee.SatPerKWeight
+
+	// HtlcConfTimeout is the number of blocks after the swap is initiated
+	// within which the server's htlc must confirm. If it doesn't, the swap
+	// is failed before the preimage is revealed, instead of waiting until
+	// close to the swap's expiry. Zero disables the timeout.
+	HtlcConfTimeout int32
+
+	// SweepFeeRate is the fee rate that the htlc is swept with instead of
+	// the fee rate estimated for the sweep conf target. If the sweep
+	// doesn't confirm, its fee rate is still bumped from there. Zero
+	// estimates the fee rate.
+	SweepFeeRate chainfee.SatPerKWeight
 }
 
 // Out contains the full details of a loop out request. This includes things
@@ -121,6 +199,22 @@ type LoopOutQuoteRequest struct {
 	// client sweep tx.
 	SweepConfTarget int32
 
+	// SweepFeeRate is the fee rate that the miner fee is quoted with
+	// instead of the fee rate estimated for the sweep conf target. Zero
+	// estimates the fee rate.
+	SweepFeeRate chainfee.SatPerKWeight
+
+	// DestAddrType is the type of the sweep destination address that the
+	// miner fee is quoted for. If unknown, the miner fee is quoted for a
+	// p2wsh output, which is the heaviest output type.
+	DestAddrType walletrpc.AddressType
+
+	// SwapExpiryDelta is an optional preferred number of blocks until the
+	// on-chain htlc expires. It is raised to at least the sweep
+	// confirmation target and clamped to the server's cltv delta limits.
+	// If zero, the expiry is derived from the sweep confirmation target.
+	SwapExpiryDelta int32
+
 	// SwapPublicationDeadline can be set by the client to allow the server
 	// delaying publication of the swap HTLC to save on chain fees.
 	SwapPublicationDeadline time.Time
@@ -139,6 +233,12 @@ type LoopOutQuoteRequest struct {
 	// initiated the swap (loop CLI, autolooper, LiT UI and so on) and is
 	// appended to the user agent string.
 	Initiator string
+
+	// RoundAmount rounds the swap amount down to a multiple of the amount
+	// increment of the loop out terms. The adjusted amount is reported
+	// back in the quote. As long as the server doesn't announce an
+	// increment, the amount is only checked against the server minimum.
+	RoundAmount bool
 }
 
 // LoopOutTerms are the server terms on which it executes swaps.
@@ -156,11 +256,21 @@ type LoopOutTerms struct {
 
 	// MaxCltvDelta is the maximum expiry delta for loop out swaps.
 	MaxCltvDelta int32
+
+	// AmountIncrement is the increment in which the server accepts swap
+	// amounts. A zero value means that any amount between the minimum and
+	// maximum is accepted, and that amounts aren't rounded. Servers don't
+	// announce an increment yet, so it is zero until they do.
+	AmountIncrement btcutil.Amount
 }
 
 // LoopOutQuote contains estimates for the fees making up the total swap cost
 // for the client.
 type LoopOutQuote struct {
+	// Amount is the swap amount that the quote was made for. It differs
+	// from the requested amount if the amount was rounded.
+	Amount btcutil.Amount
+
 	// SwapFee is the fee that the swap server is charging for the swap.
 	SwapFee btcutil.Amount
 
@@ -175,6 +285,9 @@ type LoopOutQuote struct {
 	// SwapPaymentDest is the node pubkey where to swap payment needs to be
 	// sent to.
 	SwapPaymentDest [33]byte
+
+	// Time is the time at which the quote was obtained.
+	Time time.Time
 }
 
 // LoopInRequest contains the required parameters for the swap.
@@ -213,6 +326,18 @@ type LoopInRequest struct {
 	// Label contains an optional label for the swap.
 	Label string
 
+	// Metadata is an optional opaque blob of at most
+	// loopdb.MaxMetadataSize bytes that is stored with the swap.
+	Metadata []byte
+
+	// InvoiceMemo is an optional description for the swap invoice that is
+	// created in lnd, so that the swap payment can be identified in lnd's
+	// invoice records. It is at most MaxInvoiceMemoSize bytes long. The
+	// invoice is sent to the server, so the server sees the memo. If
+	// empty, a generic memo is used. Loop out swaps have no invoice of
+	// their own, their Label can be used to identify them instead.
+	InvoiceMemo string
+
 	// Initiator is an optional string that identifies what software
 	// initiated the swap (loop CLI, autolooper, LiT UI and so on) and is
 	// appended to the user agent string.
@@ -226,6 +351,11 @@ type LoopInRequest struct {
 	// RouteHints are optional route hints to reach the destination through
 	// private channels.
 	RouteHints [][]zpay32.HopHint
+
+	// Quote is an optional quote that the swap is based on. It is stored
+	// with the swap, so that its costs can be compared with the quoted
+	// ones.
+	Quote *LoopInQuote
 }
 
 // LoopInTerms are the server terms on which it executes loop in swaps.
@@ -323,6 +453,10 @@ type LoopOutSwapInfo struct { // nolint:revive
 	// SwapHash contains the sha256 hash of the swap preimage.
 	SwapHash lntypes.Hash
 
+	// Amount is the amount of the swap. It differs from the requested
+	// amount if the amount was rounded.
+	Amount btcutil.Amount
+
 	// HtlcAddress contains the swap htlc address that the server will
 	// publish to.
 	HtlcAddress btcutil.Address
@@ -376,6 +510,58 @@ type SwapInfo struct {
 	// channels that may be used to loop out. On a loop in this field
 	// is nil.
 	OutgoingChanSet loopdb.ChannelSet
+
+	// PrepaySettled is true once the server has settled the prepayment of
+	// a loop out. On a loop in this field is false.
+	PrepaySettled bool
+
+	// SwapPaymentSettled is true once the server has settled the swap
+	// payment of a loop out. On a loop in this field is false.
+	SwapPaymentSettled bool
+
+	// PrepayForfeited is the prepayment that was lost because a loop out
+	// failed after the server settled the prepayment but not the swap
+	// payment. The server doesn't refund the prepayment. It is only set
+	// in updates of failed loop outs.
+	PrepayForfeited btcutil.Amount
+
+	// SweepVerified is true once the confirmed sweep of a loop out has been
+	// checked to pay the expected amount to the destination address, or to
+	// the wallet for sweeps to a wallet address.
+	SweepVerified bool
+
+	// SweepDiscrepancy describes why the confirmed sweep of a loop out
+	// didn't match the expected destination and amount. It is empty if no
+	// discrepancy was found.
+	SweepDiscrepancy string
+
+	// SweepPublishError is the last error that kept the sweep of a loop
+	// out from being published or its fee rate from being bumped, such as
+	// repeated rejections of the sweep from the mempool or the sweep fee
+	// limits being reached. The sweep keeps being retried. It is cleared
+	// once the htlc is spent.
+	SweepPublishError string
+
+	// ClosedChannel is a channel that the off-chain payment of the swap
+	// relies on and that was closed while the swap was pending. The
+	// payment may no longer be routable, so the swap may need to be
+	// abandoned. It is only set on the status update that reports the
+	// close, which doesn't change the state of the swap.
+	ClosedChannel *lndclient.ClosedChannel
+}
+
+// SwapTransition is a single state transition in the history of a swap.
+type SwapTransition struct {
+	// SwapStateData holds the state that the swap transitioned to, the
+	// costs accrued so far and the htlc tx hash if known at that point.
+	loopdb.SwapStateData
+
+	// Time is the time of the transition.
+	Time time.Time
+
+	// Duration is the time that the swap spent in this state until the
+	// next transition. It is zero for the last transition of the history.
+	Duration time.Duration
 }
 
 // LastUpdate returns the last update time of the swap.
@@ -405,3 +591,13 @@ type ProbeRequest struct {
 type AbandonSwapRequest struct {
 	SwapHash lntypes.Hash
 }
+
+// UpdateSwapRequest specifies the changes to a pending loop out swap. It is
+// identified by its swap hash.
+type UpdateSwapRequest struct {
+	SwapHash lntypes.Hash
+
+	// SweepConfTarget is the new confirmation target for the sweep of the
+	// swap's htlc. It determines the fee rate of the sweep.
+	SweepConfTarget int32
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/funding"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -237,6 +238,11 @@ type Config struct {
 	// NOTE: the params are decoded using `proto.Unmarshal` over a
 	// serialized RPC request.
 	FetchLiquidityParams func(ctx context.Context) ([]byte, error)
+
+	// DestAddrType is the type of the wallet address that autoloop sweeps
+	// to when neither a destination address nor an account is set. If
+	// unknown, p2wkh addresses are used.
+	DestAddrType walletrpc.AddressType
 }
 
 // Manager contains a set of desired liquidity rules for our channel
@@ -352,6 +358,23 @@ func (m *Manager) SetParameters(ctx context.Context,
 	return m.saveParams(ctx, req)
 }
 
+// UpdateParameters sets and persists the parameters of the manager if they are
+// valid.
+func (m *Manager) UpdateParameters(ctx context.Context,
+	params Parameters) error {
+
+	req, err := ParametersToRpc(params)
+	if err != nil {
+		return err
+	}
+
+	if err := m.setParameters(ctx, params); err != nil {
+		return err
+	}
+
+	return m.saveParams(ctx, req)
+}
+
 // SetParameters updates our current set of parameters if the new parameters
 // provided are valid.
 func (m *Manager) setParameters(ctx context.Context,
@@ -784,6 +807,12 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 	for _, channel := range channels {
 		channelPeers[channel.ChannelID] = channel.PubKeyBytes
 
+		// Excluded channels don't count towards their peer's balance,
+		// so that peer-level swaps don't use them.
+		if m.params.isExcluded(channel) {
+			continue
+		}
+
 		bal, ok := peerChannels[channel.PubKeyBytes]
 		if !ok {
 			bal = &balances{}
@@ -814,6 +843,11 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 			continue
 		}
 
+		if _, excluded := m.params.ExcludedPeers[peer]; excluded {
+			resp.DisqualifiedPeers[peer] = ReasonExcluded
+			continue
+		}
+
 		suggestion, err := m.suggestSwap(
 			ctx, traffic, balances, rule, outRestrictions,
 			inRestrictions,
@@ -840,6 +874,11 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 			continue
 		}
 
+		if m.params.isExcluded(channel) {
+			resp.DisqualifiedChans[channelID] = ReasonExcluded
+			continue
+		}
+
 		suggestion, err := m.suggestSwap(
 			ctx, traffic, balance, rule, outRestrictions,
 			inRestrictions,
@@ -983,7 +1022,9 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 
 	// Next, get the amount that we need to swap for this entity, skipping
 	// over it if no change in liquidity is required.
-	amount := rule.swapAmount(balance, restrictions, rule.Type)
+	amount := rule.swapAmount(
+		balance, rule.restrict(restrictions), rule.Type,
+	)
 	if amount == 0 {
 		return nil, newReasonError(ReasonLiquidityOk)
 	}
@@ -1065,6 +1106,64 @@ func (e *existingAutoLoopSummary) totalFees() btcutil.Amount {
 	return e.spentFees + e.pendingFees
 }
 
+// BudgetStatus describes how much of the autoloop fee budget is used in the
+// current budget period.
+type BudgetStatus struct {
+	// Budget is the total amount that automated swaps may spend on fees
+	// per budget period.
+	Budget btcutil.Amount
+
+	// Spent is the amount that completed automated swaps spent on fees in
+	// the current budget period.
+	Spent btcutil.Amount
+
+	// Pending is the worst-case amount that in flight automated swaps may
+	// still spend on fees.
+	Pending btcutil.Amount
+
+	// Remaining is the amount that new automated swaps may spend on fees.
+	// Autoloop doesn't dispatch swaps once it is zero.
+	Remaining btcutil.Amount
+
+	// NextRefresh is the time at which the budget period ends and the full
+	// budget is available again.
+	NextRefresh time.Time
+}
+
+// BudgetStatus returns how much of the autoloop fee budget is spent or
+// reserved for in flight swaps in the current budget period.
+func (m *Manager) BudgetStatus(ctx context.Context) (*BudgetStatus, error) {
+	loopOuts, err := m.cfg.ListLoopOut(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loopIns, err := m.cfg.ListLoopIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	summary := m.checkExistingAutoLoops(ctx, loopOuts, loopIns)
+
+	remaining := m.params.AutoFeeBudget - summary.totalFees()
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return &BudgetStatus{
+		Budget:    m.params.AutoFeeBudget,
+		Spent:     summary.spentFees,
+		Pending:   summary.pendingFees,
+		Remaining: remaining,
+		NextRefresh: m.params.AutoloopBudgetLastRefresh.Add(
+			m.params.AutoFeeRefreshPeriod,
+		),
+	}, nil
+}
+
 // checkExistingAutoLoops calculates the total amount that has been spent by
 // automatically dispatched swaps that have completed, and the worst-case fee
 // total for our set of ongoing, automatically dispatched swaps as well as a
@@ -1161,7 +1260,9 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 		// not want to repeatedly try to route through bad channels
 		// which remain unbalanced because they cannot route a swap, so
 		// we backoff.
-		if state == loopdb.StateFailOffchainPayments {
+		if state == loopdb.StateFailOffchainPayments ||
+			state == loopdb.StateFailInvoiceExpired {
+
 			failedAt := out.LastUpdate().Time
 
 			if failedAt.After(failureCutoff) {
@@ -1313,7 +1414,9 @@ func (m *Manager) dispatchStickyLoopOut(ctx context.Context,
 				return
 			}
 
-			if *update == loopdb.StateFailOffchainPayments {
+			if *update == loopdb.StateFailOffchainPayments ||
+				*update == loopdb.StateFailInvoiceExpired {
+
 				// Save the old amount so we can log it.
 				oldAmt := out.Amount
 
@@ -1394,6 +1497,8 @@ func (m *Manager) waitForSwapPayment(ctx context.Context, swapHash lntypes.Hash,
 		case loopdb.StatePreimageRevealed:
 			fallthrough
 		case loopdb.StateFailOffchainPayments:
+			fallthrough
+		case loopdb.StateFailInvoiceExpired:
 			updateChan <- &update.State
 			return
 		}
@@ -1433,6 +1538,12 @@ func (m *Manager) pickEasyAutoloopChannel(channels []lndclient.ChannelInfo,
 			continue
 		}
 
+		if m.params.isExcluded(channel) {
+			log.Debugf("Channel %v cannot be used for easy "+
+				"autoloop: excluded", channel.ChannelID)
+			continue
+		}
+
 		lastFail, recentFail := traffic.failedLoopOut[shortChanID]
 		if recentFail {
 			log.Debugf("Channel %v cannot be used for easy "+
@@ -2036,3 +2036,183 @@ func TestCurrentTraffic(t *testing.T) {
 		require.Equal(t, testCase.expected, actual)
 	}
 }
+
+// TestBudgetStatus tests that the budget status accounts for the fees of
+// completed and in flight automated swaps.
+func TestBudgetStatus(t *testing.T) {
+	cfg, _ := newTestConfig()
+
+	completed := &loopdb.LoopOut{
+		Loop: loopdb.Loop{
+			Events: []*loopdb.LoopEvent{
+				{
+					SwapStateData: loopdb.SwapStateData{
+						Cost: loopdb.SwapCost{
+							Server: 100,
+						},
+						State: loopdb.StateSuccess,
+					},
+					Time: testTime,
+				},
+			},
+		},
+		Contract: autoOutContract,
+	}
+
+	pendingContract := *autoOutContract
+	pendingContract.MaxSwapFee = 200
+	pending := &loopdb.LoopOut{
+		Contract: &pendingContract,
+	}
+
+	cfg.ListLoopOut = func(context.Context) ([]*loopdb.LoopOut, error) {
+		return []*loopdb.LoopOut{completed, pending}, nil
+	}
+
+	manager := NewManager(cfg)
+	manager.params.AutoFeeBudget = 250
+	manager.params.AutoloopBudgetLastRefresh = testBudgetStart
+
+	status, err := manager.BudgetStatus(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &BudgetStatus{
+		Budget:    250,
+		Spent:     100,
+		Pending:   200,
+		Remaining: 0,
+		NextRefresh: testBudgetStart.Add(
+			defaultParameters.AutoFeeRefreshPeriod,
+		),
+	}, status)
+}
+
+// TestSuggestSwapsExclusions tests that excluded channels and peers are
+// disqualified from automated swaps.
+func TestSuggestSwapsExclusions(t *testing.T) {
+	tests := []struct {
+		name             string
+		excludedChannels map[lnwire.ShortChannelID]struct{}
+		excludedPeers    map[route.Vertex]struct{}
+		expected         *Suggestions
+	}{
+		{
+			name: "channel excluded",
+			excludedChannels: map[lnwire.ShortChannelID]struct{}{
+				chanID2: {},
+			},
+			expected: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonExcluded,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "peer excluded",
+			excludedPeers: map[route.Vertex]struct{}{
+				channel2.PubKeyBytes: {},
+			},
+			expected: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonExcluded,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				chanID2: chanRule,
+			}
+			params.ExcludedChannels = testCase.excludedChannels
+			params.ExcludedPeers = testCase.excludedPeers
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.expected, nil,
+			)
+		})
+	}
+}
+
+// TestParametersRpcExclusionsAndLimits tests that the exclusions and the swap
+// amount limits of the rules are persisted and can be set over RPC.
+func TestParametersRpcExclusionsAndLimits(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{channel1}
+
+	var persisted []byte
+	cfg.PutLiquidityParams = func(_ context.Context, data []byte) error {
+		persisted = data
+		return nil
+	}
+	cfg.FetchLiquidityParams = func(context.Context) ([]byte, error) {
+		return persisted, nil
+	}
+
+	manager := NewManager(cfg)
+
+	limits := &Restrictions{
+		Minimum: 1000,
+		Maximum: 5000,
+	}
+
+	params := defaultParameters
+	params.PeerRules = map[route.Vertex]*SwapRule{
+		peer1: {
+			ThresholdRule: NewThresholdRule(50, 0),
+			Type:          swap.TypeOut,
+			Limits:        limits,
+		},
+	}
+	params.ExcludedChannels = map[lnwire.ShortChannelID]struct{}{
+		chanID2: {},
+	}
+	params.ExcludedPeers = map[route.Vertex]struct{}{
+		peer2: {},
+	}
+	require.NoError(t, manager.UpdateParameters(ctx, params))
+	require.NotNil(t, persisted)
+
+	// The persisted parameters include the exclusions and the limits.
+	req, err := manager.loadParams(ctx)
+	require.NoError(t, err)
+
+	loaded, err := RpcToParameters(req)
+	require.NoError(t, err)
+	require.Equal(t, params.ExcludedChannels, loaded.ExcludedChannels)
+	require.Equal(t, params.ExcludedPeers, loaded.ExcludedPeers)
+	require.Equal(t, limits, loaded.PeerRules[peer1].Limits)
+
+	// Clear the exclusions and the limits over RPC.
+	req.ExcludedChannels = nil
+	req.ExcludedPeers = nil
+	req.Rules[0].MinSwapAmount = 0
+	req.Rules[0].MaxSwapAmount = 0
+	require.NoError(t, manager.SetParameters(ctx, req))
+
+	current := manager.GetParameters()
+	require.Nil(t, current.ExcludedChannels)
+	require.Nil(t, current.ExcludedPeers)
+	require.Nil(t, current.PeerRules[peer1].Limits)
+}
@@ -100,6 +100,7 @@ func (b *loopOutBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 		ctx, &loop.LoopOutQuoteRequest{
 			Amount:                  amount,
 			SweepConfTarget:         params.SweepConfTarget,
+			DestAddrType:            b.destAddrType(params),
 			SwapPublicationDeadline: b.cfg.Clock.Now(),
 			Initiator:               getInitiator(params),
 		},
@@ -157,10 +158,8 @@ func (b *loopOutBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 		}
 
 		account := ""
-		addrType := walletrpc.AddressType_WITNESS_PUBKEY_HASH
 		if len(params.Account) > 0 {
 			account = params.Account
-			addrType = params.AccountAddrType
 			request.IsExternalAddr = true
 		}
 		if params.DestAddr != nil {
@@ -168,7 +167,7 @@ func (b *loopOutBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 			request.IsExternalAddr = true
 		} else {
 			addr, err := b.cfg.Lnd.WalletKit.NextAddr(
-				ctx, account, addrType, false,
+				ctx, account, b.destAddrType(params), false,
 			)
 			if err != nil {
 				return nil, err
@@ -181,3 +180,24 @@ func (b *loopOutBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 		OutRequest: request,
 	}, nil
 }
+
+// destAddrType returns the type of the wallet address that a swap sweeps to.
+// If the parameters set a destination address, its type isn't known up front
+// and unknown is returned.
+func (b *loopOutBuilder) destAddrType(
+	params Parameters) walletrpc.AddressType {
+
+	switch {
+	case params.DestAddr != nil:
+		return walletrpc.AddressType_UNKNOWN
+
+	case len(params.Account) > 0:
+		return params.AccountAddrType
+
+	case b.cfg.DestAddrType != walletrpc.AddressType_UNKNOWN:
+		return b.cfg.DestAddrType
+
+	default:
+		return walletrpc.AddressType_WITNESS_PUBKEY_HASH
+	}
+}
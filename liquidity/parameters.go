@@ -104,6 +104,13 @@ type Parameters struct {
 	// and channel rules map to avoid ambiguity.
 	PeerRules map[route.Vertex]*SwapRule
 
+	// ExcludedChannels are channels that automated swaps never use, even
+	// if their peer has a rule.
+	ExcludedChannels map[lnwire.ShortChannelID]struct{}
+
+	// ExcludedPeers are peers whose channels automated swaps never use.
+	ExcludedPeers map[route.Vertex]struct{}
+
 	// CustomPaymentCheckInterval is an optional custom interval to use when
 	// checking an autoloop loop out payments' payment status.
 	CustomPaymentCheckInterval time.Duration
@@ -143,6 +150,19 @@ func (p Parameters) String() string {
 		p.ClientRestrictions.Minimum, p.ClientRestrictions.Maximum)
 }
 
+// isExcluded returns whether a channel is excluded from automated swaps,
+// either by itself or through its peer.
+func (p Parameters) isExcluded(channel lndclient.ChannelInfo) bool {
+	shortID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+	if _, ok := p.ExcludedChannels[shortID]; ok {
+		return true
+	}
+
+	_, ok := p.ExcludedPeers[channel.PubKeyBytes]
+
+	return ok
+}
+
 // haveRules returns a boolean indicating whether we have any rules configured.
 func (p Parameters) haveRules() bool {
 	if len(p.ChannelRules) != 0 {
@@ -205,6 +225,11 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 			return fmt.Errorf("channel: %v has invalid rule: %v",
 				channel.ToUint64(), err)
 		}
+
+		if err := rule.validateLimits(); err != nil {
+			return fmt.Errorf("channel: %v has invalid rule: %v",
+				channel.ToUint64(), err)
+		}
 	}
 
 	for peer, rule := range p.PeerRules {
@@ -212,6 +237,11 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 			return fmt.Errorf("peer: %v has invalid rule: %v",
 				peer, err)
 		}
+
+		if err := rule.validateLimits(); err != nil {
+			return fmt.Errorf("peer: %v has invalid rule: %v",
+				peer, err)
+		}
 	}
 
 	// Check that our confirmation target is above our required minimum.
@@ -306,8 +336,7 @@ func cloneParameters(params Parameters) Parameters {
 	)
 
 	for channel, rule := range params.ChannelRules {
-		ruleCopy := *rule
-		paramCopy.ChannelRules[channel] = &ruleCopy
+		paramCopy.ChannelRules[channel] = cloneRule(rule)
 	}
 
 	paramCopy.PeerRules = make(
@@ -316,13 +345,45 @@ func cloneParameters(params Parameters) Parameters {
 	)
 
 	for peer, rule := range params.PeerRules {
-		ruleCopy := *rule
-		paramCopy.PeerRules[peer] = &ruleCopy
+		paramCopy.PeerRules[peer] = cloneRule(rule)
+	}
+
+	// The exclusions are optional, so they are only copied if set.
+	if params.ExcludedChannels != nil {
+		paramCopy.ExcludedChannels = make(
+			map[lnwire.ShortChannelID]struct{},
+			len(params.ExcludedChannels),
+		)
+
+		for channel := range params.ExcludedChannels {
+			paramCopy.ExcludedChannels[channel] = struct{}{}
+		}
+	}
+
+	if params.ExcludedPeers != nil {
+		paramCopy.ExcludedPeers = make(
+			map[route.Vertex]struct{}, len(params.ExcludedPeers),
+		)
+
+		for peer := range params.ExcludedPeers {
+			paramCopy.ExcludedPeers[peer] = struct{}{}
+		}
 	}
 
 	return paramCopy
 }
 
+// cloneRule copies a rule, including its swap amount limits.
+func cloneRule(rule *SwapRule) *SwapRule {
+	ruleCopy := *rule
+	if rule.Limits != nil {
+		limits := *rule.Limits
+		ruleCopy.Limits = &limits
+	}
+
+	return &ruleCopy
+}
+
 // rpcToFee converts the values provided over rpc to a fee limit interface,
 // failing if an inconsistent set of fields are set.
 func rpcToFee(req *clientrpc.LiquidityParameters) (FeeLimit, error) {
@@ -372,13 +433,22 @@ func rpcToRule(rule *clientrpc.LiquidityRule) (*SwapRule, error) {
 		return nil, fmt.Errorf("rule type field must be set")
 
 	case clientrpc.LiquidityRuleType_THRESHOLD:
-		return &SwapRule{
+		swapRule := &SwapRule{
 			ThresholdRule: NewThresholdRule(
 				int(rule.IncomingThreshold),
 				int(rule.OutgoingThreshold),
 			),
 			Type: swapType,
-		}, nil
+		}
+
+		if rule.MinSwapAmount != 0 || rule.MaxSwapAmount != 0 {
+			swapRule.Limits = &Restrictions{
+				Minimum: btcutil.Amount(rule.MinSwapAmount),
+				Maximum: btcutil.Amount(rule.MaxSwapAmount),
+			}
+		}
+
+		return swapRule, nil
 
 	default:
 		return nil, fmt.Errorf("unknown rule: %T", rule)
@@ -505,6 +575,34 @@ func RpcToParameters(req *clientrpc.LiquidityParameters) (*Parameters,
 		}
 	}
 
+	// The exclusions are optional, so they are only set if provided.
+	if len(req.ExcludedChannels) > 0 {
+		params.ExcludedChannels = make(
+			map[lnwire.ShortChannelID]struct{},
+			len(req.ExcludedChannels),
+		)
+
+		for _, channel := range req.ExcludedChannels {
+			shortID := lnwire.NewShortChanIDFromInt(channel)
+			params.ExcludedChannels[shortID] = struct{}{}
+		}
+	}
+
+	if len(req.ExcludedPeers) > 0 {
+		params.ExcludedPeers = make(
+			map[route.Vertex]struct{}, len(req.ExcludedPeers),
+		)
+
+		for _, peer := range req.ExcludedPeers {
+			pubkey, err := route.NewVertexFromBytes(peer)
+			if err != nil {
+				return nil, err
+			}
+
+			params.ExcludedPeers[pubkey] = struct{}{}
+		}
+	}
+
 	return params, nil
 }
 
@@ -588,6 +686,17 @@ func ParametersToRpc(cfg Parameters) (*clientrpc.LiquidityParameters,
 		rpcCfg.Rules = append(rpcCfg.Rules, rpcRule)
 	}
 
+	for channel := range cfg.ExcludedChannels {
+		rpcCfg.ExcludedChannels = append(
+			rpcCfg.ExcludedChannels, channel.ToUint64(),
+		)
+	}
+
+	for peer := range cfg.ExcludedPeers {
+		peer := peer
+		rpcCfg.ExcludedPeers = append(rpcCfg.ExcludedPeers, peer[:])
+	}
+
 	return rpcCfg, nil
 }
 
@@ -609,5 +718,10 @@ func newRPCRule(channelID uint64, peer []byte,
 		rpcRule.SwapType = clientrpc.SwapType_LOOP_IN
 	}
 
+	if rule.Limits != nil {
+		rpcRule.MinSwapAmount = uint64(rule.Limits.Minimum)
+		rpcRule.MaxSwapAmount = uint64(rule.Limits.Maximum)
+	}
+
 	return rpcRule
 }
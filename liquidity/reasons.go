@@ -69,6 +69,10 @@ const (
 	// ReasonLoopInUnreachable indicates that the server does not have a
 	// path to the client, so cannot perform a loop in swap at this time.
 	ReasonLoopInUnreachable
+
+	// ReasonExcluded indicates that the channel or peer is excluded from
+	// automated swaps.
+	ReasonExcluded
 )
 
 // String returns a string representation of a reason.
@@ -119,6 +123,9 @@ func (r Reason) String() string {
 	case ReasonLoopInUnreachable:
 		return "loop in unreachable"
 
+	case ReasonExcluded:
+		return "excluded"
+
 	default:
 		return "unknown"
 	}
@@ -24,6 +24,43 @@ var (
 type SwapRule struct {
 	*ThresholdRule
 	swap.Type
+
+	// Limits optionally narrows the swap amounts of this rule within the
+	// server's and the client's restrictions. A zero maximum doesn't
+	// limit the amount. If nil, only the general restrictions apply.
+	Limits *Restrictions
+}
+
+// restrict narrows the restrictions provided to the rule's swap amount
+// limits.
+func (r *SwapRule) restrict(restrictions *Restrictions) *Restrictions {
+	if r.Limits == nil {
+		return restrictions
+	}
+
+	restricted := *restrictions
+	if r.Limits.Minimum > restricted.Minimum {
+		restricted.Minimum = r.Limits.Minimum
+	}
+
+	if r.Limits.Maximum != 0 && r.Limits.Maximum < restricted.Maximum {
+		restricted.Maximum = r.Limits.Maximum
+	}
+
+	return &restricted
+}
+
+// validateLimits checks that the rule's swap amount limits are consistent.
+func (r *SwapRule) validateLimits() error {
+	if r.Limits == nil || r.Limits.Maximum == 0 {
+		return nil
+	}
+
+	if r.Limits.Minimum > r.Limits.Maximum {
+		return ErrMinimumExceedsMaximumAmt
+	}
+
+	return nil
 }
 
 // ThresholdRule is a liquidity rule that implements minimum incoming and
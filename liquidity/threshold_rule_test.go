@@ -256,3 +256,27 @@ func TestSuggestSwap(t *testing.T) {
 		})
 	}
 }
+
+// TestSwapRuleLimits tests that a rule's swap amount limits narrow the general
+// restrictions.
+func TestSwapRuleLimits(t *testing.T) {
+	restrictions := NewRestrictions(10, 100)
+
+	rule := &SwapRule{
+		ThresholdRule: NewThresholdRule(10, 10),
+		Type:          swap.TypeOut,
+	}
+	require.Equal(t, restrictions, rule.restrict(restrictions))
+
+	// Limits within the restrictions narrow them.
+	rule.Limits = NewRestrictions(20, 50)
+	require.Equal(t, NewRestrictions(20, 50), rule.restrict(restrictions))
+
+	// Limits beyond the restrictions, or a zero maximum, don't widen them.
+	rule.Limits = NewRestrictions(5, 0)
+	require.Equal(t, restrictions, rule.restrict(restrictions))
+
+	// A minimum above the maximum is invalid.
+	rule.Limits = NewRestrictions(60, 50)
+	require.ErrorIs(t, rule.validateLimits(), ErrMinimumExceedsMaximumAmt)
+}
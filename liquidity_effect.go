@@ -0,0 +1,163 @@
+package loop
+
+import (
+	"context"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// LiquidityEffect describes how a swap changed the local balance of the
+// channels that it targeted. For a loop out, these are the channels of the
+// outgoing channel set, for a loop in the channels with the last hop.
+type LiquidityEffect struct {
+	// Channels are the short channel ids of the targeted channels at the
+	// time the swap was initiated.
+	Channels []uint64
+
+	// BalanceBefore is the local balance of the targeted channels when
+	// the swap was initiated.
+	BalanceBefore btcutil.Amount
+
+	// BalanceAfter is the local balance of the targeted channels when the
+	// swap completed. It is only set if Completed is true.
+	BalanceAfter btcutil.Amount
+
+	// Completed indicates whether the swap reached a final state and the
+	// balance after the swap was recorded.
+	Completed bool
+}
+
+// liquidityTracker records the local balance of the channels that swaps
+// target, at initiation and at completion of the swaps. The records are kept
+// in memory, so they are only available for swaps that were initiated since
+// the client was started.
+type liquidityTracker struct {
+	lnd *lndclient.LndServices
+
+	effects map[lntypes.Hash]*LiquidityEffect
+
+	mu sync.Mutex
+}
+
+// newLiquidityTracker returns a new liquidity tracker.
+func newLiquidityTracker(lnd *lndclient.LndServices) *liquidityTracker {
+	return &liquidityTracker{
+		lnd:     lnd,
+		effects: make(map[lntypes.Hash]*LiquidityEffect),
+	}
+}
+
+// targetedChannels returns the short channel ids of the open channels that
+// are either part of the channel set or are with the peer, along with their
+// total local balance.
+func (l *liquidityTracker) targetedChannels(ctx context.Context,
+	chanSet []uint64, peer *route.Vertex) ([]uint64, btcutil.Amount,
+	error) {
+
+	targeted := make(map[uint64]struct{}, len(chanSet))
+	for _, id := range chanSet {
+		targeted[id] = struct{}{}
+	}
+
+	channels, err := l.lnd.Client.ListChannels(ctx, false, false)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		ids     []uint64
+		balance btcutil.Amount
+	)
+	for _, channel := range channels {
+		_, inSet := targeted[channel.ChannelID]
+		withPeer := peer != nil && channel.PubKeyBytes == *peer
+		if !inSet && !withPeer {
+			continue
+		}
+
+		ids = append(ids, channel.ChannelID)
+		balance += channel.LocalBalance
+	}
+
+	return ids, balance, nil
+}
+
+// swapInitiated records the local balance of the channels that the swap
+// targets. Swaps that don't target any channels are not tracked. Failing to
+// query the balance doesn't fail the swap, so errors are only logged.
+func (l *liquidityTracker) swapInitiated(ctx context.Context,
+	hash lntypes.Hash, chanSet []uint64, peer *route.Vertex) {
+
+	if len(chanSet) == 0 && peer == nil {
+		return
+	}
+
+	ids, balance, err := l.targetedChannels(ctx, chanSet, peer)
+	if err != nil {
+		log.Warnf("Unable to record liquidity of swap %v: %v", hash,
+			err)
+
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.effects[hash] = &LiquidityEffect{
+		Channels:      ids,
+		BalanceBefore: balance,
+	}
+}
+
+// swapDone records the local balance of the channels that a tracked swap
+// targeted once the swap has reached a final state.
+func (l *liquidityTracker) swapDone(ctx context.Context, info *SwapInfo) {
+	if info.State.IsPending() {
+		return
+	}
+
+	l.mu.Lock()
+	effect, ok := l.effects[info.SwapHash]
+	l.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	_, balance, err := l.targetedChannels(ctx, effect.Channels, nil)
+	if err != nil {
+		log.Warnf("Unable to record liquidity of swap %v: %v",
+			info.SwapHash, err)
+
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	effect.BalanceAfter = balance
+	effect.Completed = true
+}
+
+// effect returns a copy of the liquidity effect of the swap, if it is
+// tracked.
+func (l *liquidityTracker) effect(hash lntypes.Hash) (*LiquidityEffect,
+	bool) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	effect, ok := l.effects[hash]
+	if !ok {
+		return nil, false
+	}
+
+	effectCopy := *effect
+	effectCopy.Channels = append([]uint64(nil), effect.Channels...)
+
+	return &effectCopy, true
+}
@@ -0,0 +1,91 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLiquidityTracker tests that the balance of the channels that swaps
+// target is recorded at initiation and completion of the swaps.
+func TestLiquidityTracker(t *testing.T) {
+	lnd := test.NewMockLnd()
+	peer := route.Vertex{1}
+
+	lnd.Channels = []lndclient.ChannelInfo{
+		{
+			ChannelID:    1,
+			PubKeyBytes:  peer,
+			LocalBalance: 1000,
+		},
+		{
+			ChannelID:    2,
+			PubKeyBytes:  peer,
+			LocalBalance: 2000,
+		},
+		{
+			ChannelID:    3,
+			PubKeyBytes:  route.Vertex{2},
+			LocalBalance: 4000,
+		},
+	}
+
+	var (
+		ctx         = context.Background()
+		tracker     = newLiquidityTracker(&lnd.LndServices)
+		loopOutHash = lntypes.Hash{1}
+		loopInHash  = lntypes.Hash{2}
+		untargeted  = lntypes.Hash{3}
+	)
+
+	tracker.swapInitiated(ctx, loopOutHash, []uint64{1, 3}, nil)
+	tracker.swapInitiated(ctx, loopInHash, nil, &peer)
+	tracker.swapInitiated(ctx, untargeted, nil, nil)
+
+	_, ok := tracker.effect(untargeted)
+	require.False(t, ok)
+
+	effect, ok := tracker.effect(loopInHash)
+	require.True(t, ok)
+	require.Equal(t, &LiquidityEffect{
+		Channels:      []uint64{1, 2},
+		BalanceBefore: 3000,
+	}, effect)
+
+	// Update the balances and let the loop out complete, while the loop
+	// in is still pending.
+	lnd.Channels[0].LocalBalance = 500
+	lnd.Channels[2].LocalBalance = 3000
+
+	tracker.swapDone(ctx, &SwapInfo{
+		SwapHash: loopOutHash,
+		SwapStateData: loopdb.SwapStateData{
+			State: loopdb.StateSuccess,
+		},
+	})
+	tracker.swapDone(ctx, &SwapInfo{
+		SwapHash: loopInHash,
+		SwapStateData: loopdb.SwapStateData{
+			State: loopdb.StateInitiated,
+		},
+	})
+
+	effect, ok = tracker.effect(loopOutHash)
+	require.True(t, ok)
+	require.Equal(t, &LiquidityEffect{
+		Channels:      []uint64{1, 3},
+		BalanceBefore: 5000,
+		BalanceAfter:  3500,
+		Completed:     true,
+	}, effect)
+
+	effect, ok = tracker.effect(loopInHash)
+	require.True(t, ok)
+	require.False(t, effect.Completed)
+}
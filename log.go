@@ -2,7 +2,9 @@ package loop
 
 import (
 	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/lntypes"
 )
 
 // log is a logger that is initialized with no output filters. This
@@ -21,3 +23,13 @@ func init() {
 func UseLogger(logger btclog.Logger) {
 	log = logger
 }
+
+// newSwapLogger returns a logger for the swap with the given hash. All lines
+// that it logs are prefixed with the short swap hash, so that the output of
+// concurrent swaps can be told apart.
+func newSwapLogger(hash lntypes.Hash) *swap.PrefixLog {
+	return &swap.PrefixLog{
+		Hash:   hash,
+		Logger: log,
+	}
+}
@@ -0,0 +1,125 @@
+package loopd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// exportSwaps writes all swaps in the database to the configured export file.
+func exportSwaps(config *Config, lisCfg *ListenerCfg) error {
+	return withSwapStore(config, lisCfg, func(ctx context.Context,
+		store loopdb.SwapStore, keys *storeKeys) error {
+
+		output := config.Export.Output
+		err := loopdb.ExportSwaps(
+			ctx, store, output, keys.chainParams, keys.secrets,
+			keys.params,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to export swaps: %v", err)
+		}
+
+		fmt.Printf("Swaps exported to %v\n", output)
+
+		return nil
+	})
+}
+
+// importSwaps restores the swaps of the configured export file into the
+// database.
+func importSwaps(config *Config, lisCfg *ListenerCfg) error {
+	return withSwapStore(config, lisCfg, func(ctx context.Context,
+		store loopdb.SwapStore, keys *storeKeys) error {
+
+		input := config.Import.Input
+		err := loopdb.ImportSwaps(
+			ctx, store, input, keys.chainParams,
+			backupCipher(ctx, config, keys),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to import swaps: %v", err)
+		}
+
+		fmt.Printf("Swaps imported from %v\n", input)
+
+		return nil
+	})
+}
+
+// storeKeys holds the network of the swap database and the keys that its
+// swap secrets are encrypted with.
+type storeKeys struct {
+	// chainParams are the parameters of the network of the database.
+	chainParams *chaincfg.Params
+
+	// lnd is used to derive lnd keys.
+	lnd *lndclient.LndServices
+
+	// secrets is the cipher of the swap secrets, nil if database
+	// encryption is disabled.
+	secrets *loopdb.SecretCipher
+
+	// params are the parameters that the key of secrets was derived with.
+	params *loopdb.KeyParams
+}
+
+// backupCipher returns the function that derives the cipher of a backup from
+// the key parameters that the backup carries. The key of a password encrypted
+// backup is derived from the configured password and the salt of the backup,
+// so that the backup can be imported into another data directory. Backups
+// without key parameters are decrypted with the key of the database.
+func backupCipher(ctx context.Context, config *Config,
+	keys *storeKeys) loopdb.BackupCipher {
+
+	return func(params *loopdb.KeyParams) (*loopdb.SecretCipher, error) {
+		if params == nil {
+			return keys.secrets, nil
+		}
+
+		return keyCipher(ctx, config, keys.lnd, params)
+	}
+}
+
+// withSwapStore opens the swap database of the daemon and passes it to the
+// given function, together with the keys of its swap secrets.
+func withSwapStore(config *Config, lisCfg *ListenerCfg,
+	f func(context.Context, loopdb.SwapStore, *storeKeys) error) error {
+
+	network := lndclient.Network(config.Network)
+
+	lnd, err := lisCfg.getLnd(network, config.Lnd)
+	if err != nil {
+		return err
+	}
+	defer lnd.Close()
+
+	chainParams, err := network.ChainParams()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	swapDb, baseDb, err := openDatabase(
+		ctx, config, chainParams, &lnd.LndServices,
+	)
+	if err != nil {
+		return err
+	}
+	defer swapDb.Close()
+
+	params, err := baseDb.FetchSecretKeyParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	return f(ctx, swapDb, &storeKeys{
+		chainParams: chainParams,
+		lnd:         &lnd.LndServices,
+		secrets:     baseDb.SecretCipher(),
+		params:      params,
+	})
+}
@@ -0,0 +1,110 @@
+package loopd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestImportSwapsOtherDataDir tests that a password encrypted backup is
+// imported into another database, whose salt differs from the one that the
+// backup was encrypted with.
+func TestImportSwapsOtherDataDir(t *testing.T) {
+	ctx := context.Background()
+	network := &chaincfg.TestNet3Params
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	err := os.WriteFile(passwordFile, []byte("password\n"), 0600)
+	require.NoError(t, err)
+
+	newConfig := func() *Config {
+		return &Config{
+			DataDir:        t.TempDir(),
+			DBEncryption:   dbEncryptionPassword,
+			DBPasswordFile: passwordFile,
+		}
+	}
+
+	// Create a swap in the database of the first data directory and
+	// export it.
+	source := loopdb.NewTestDB(t)
+	err = setupSecretEncryption(ctx, newConfig(), nil, source.BaseDB)
+	require.NoError(t, err)
+
+	sourceSecrets := source.SecretCipher()
+	sourceParams, err := source.FetchSecretKeyParams(ctx)
+	require.NoError(t, err)
+
+	_, senderPubKey := test.CreateKey(1)
+	var senderKey [33]byte
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+
+	_, receiverPubKey := test.CreateKey(2)
+	var receiverKey [33]byte
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	preimage := lntypes.Preimage{1}
+	hash := preimage.Hash()
+	contract := &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			Preimage:        preimage,
+			AmountRequested: 50000,
+			CltvExpiry:      744,
+			HtlcKeys: loopdb.HtlcKeys{
+				SenderScriptKey:        senderKey,
+				SenderInternalPubKey:   senderKey,
+				ReceiverScriptKey:      receiverKey,
+				ReceiverInternalPubKey: receiverKey,
+			},
+			InitiationTime:  time.Unix(1000, 0),
+			ProtocolVersion: loopdb.ProtocolVersionMuSig2,
+		},
+		DestAddr:    test.GetDestAddr(t, 0),
+		SwapInvoice: "swapinvoice",
+	}
+	require.NoError(t, source.CreateLoopOut(ctx, hash, contract))
+
+	path := filepath.Join(t.TempDir(), "backup.db")
+	require.NoError(t, loopdb.ExportSwaps(
+		ctx, source, path, network, sourceSecrets, sourceParams,
+	))
+
+	// The second database is keyed with a salt of its own.
+	targetCfg := newConfig()
+	target := loopdb.NewTestDB(t)
+	err = setupSecretEncryption(ctx, targetCfg, nil, target.BaseDB)
+	require.NoError(t, err)
+
+	targetSecrets := target.SecretCipher()
+	targetParams, err := target.FetchSecretKeyParams(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, sourceParams.Salt, targetParams.Salt)
+
+	keys := &storeKeys{
+		chainParams: network,
+		secrets:     targetSecrets,
+		params:      targetParams,
+	}
+	require.NoError(t, loopdb.ImportSwaps(
+		ctx, target, path, network, backupCipher(ctx, targetCfg, keys),
+	))
+
+	swaps, err := target.FetchLoopOutSwaps(ctx)
+	require.NoError(t, err)
+	require.Len(t, swaps, 1)
+	require.Equal(t, preimage, swaps[0].Contract.Preimage)
+
+	// The imported preimage is encrypted with the key of the second
+	// database.
+	target.EnableSecretEncryption(sourceSecrets)
+	_, err = target.FetchLoopOutSwaps(ctx)
+	require.ErrorIs(t, err, loopdb.ErrWrongSecretKey)
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -47,6 +48,7 @@ var (
 	defaultLoopOutMaxParts     = uint32(5)
 	defaultTotalPaymentTimeout = time.Minute * 60
 	defaultMaxPaymentRetries   = 3
+	defaultResumeRetries       = 3
 
 	// DefaultTLSCertFilename is the default file name for the autogenerated
 	// TLS certificate.
@@ -121,15 +123,27 @@ type lndConfig struct {
 }
 
 type loopServerConfig struct {
-	Host  string `long:"host" description:"Loop server address host:port"`
-	Proxy string `long:"proxy" description:"The host:port of a SOCKS proxy through which all connections to the loop server will be established over"`
+	Host string `long:"host" description:"Loop server address host:port"`
+
+	FallbackHosts []string `long:"fallbackhost" description:"Additional host:port address of the same loop server that is used if the server can't be reached at the host address. Can be specified multiple times, the addresses are tried in order. All addresses must front the same server state, as swaps aren't bound to the address that accepted them."`
+	Proxy         string   `long:"proxy" description:"The host:port of a SOCKS proxy through which all connections to the loop server will be established over"`
 
 	NoTLS   bool   `long:"notls" description:"Disable tls for communication to the loop server [testing only]"`
 	TLSPath string `long:"tlspath" description:"Path to loop server tls certificate [testing only]"`
+
+	TLSFingerprint string `long:"tlsfingerprint" description:"The hex encoded sha256 fingerprint of the loop server's tls certificate. If set, the server certificate is only accepted if it matches this fingerprint."`
 }
 
 type viewParameters struct{}
 
+type exportParameters struct {
+	Output string `long:"output" description:"The file to export the swaps to. The file must not exist yet." required:"true"`
+}
+
+type importParameters struct {
+	Input string `long:"input" description:"The file to import the swaps from, as written by exportswaps." required:"true"`
+}
+
 type Config struct {
 	ShowVersion bool   `long:"version" description:"Display version information and exit"`
 	Network     string `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"mainnet" choice:"simnet"`
@@ -137,6 +151,14 @@ type Config struct {
 	RESTListen  string `long:"restlisten" description:"Address to listen on for REST clients"`
 	CORSOrigin  string `long:"corsorigin" description:"The value to send in the Access-Control-Allow-Origin header. Header will be omitted if empty."`
 
+	MetricsListen string `long:"metricslisten" description:"Address to serve prometheus metrics on at /metrics. Metrics are disabled if empty."`
+
+	Webhooks      []string `long:"webhook" description:"URL of an HTTP endpoint that swap state changes are posted to as JSON. Can be specified multiple times."`
+	WebhookSecret string   `long:"webhooksecret" description:"The secret that the webhook payloads are signed with. The hex encoded HMAC-SHA256 signature of the payload is sent in the X-Loop-Signature header. Required if webhooks are set."`
+
+	FiatCurrency string `long:"fiatcurrency" description:"The fiat currency, for example usd, that swap costs are annotated in for accounting. The bitcoin price at the time of a swap's last update is queried from the price source. Annotations are disabled if empty."`
+	FiatPriceURL string `long:"fiatpriceurl" description:"The base URL of a CoinGecko compatible API that bitcoin prices are queried from. If empty, the public CoinGecko API is used."`
+
 	LoopDir    string `long:"loopdir" description:"The directory for all of loop's data. If set, this option overwrites --datadir, --logdir, --tlscertpath, --tlskeypath and --macaroonpath."`
 	ConfigFile string `long:"configfile" description:"Path to configuration file."`
 	DataDir    string `long:"datadir" description:"Directory for loopdb."`
@@ -145,15 +167,18 @@ type Config struct {
 	Sqlite          *loopdb.SqliteConfig   `group:"sqlite" namespace:"sqlite"`
 	Postgres        *loopdb.PostgresConfig `group:"postgres" namespace:"postgres"`
 
+	DBEncryption   string `long:"dbencryption" description:"Encrypt the swap secrets in the database. 'lnd' derives the key from lnd's wallet, 'password' from the password in dbpasswordfile. Secrets that were stored before encryption was enabled are encrypted on startup. Encryption is disabled if empty." choice:"lnd" choice:"password"`
+	DBPasswordFile string `long:"dbpasswordfile" description:"Path to a file that holds the password that the swap secrets are encrypted with. Required if dbencryption is set to password."`
+
 	TLSCertPath        string        `long:"tlscertpath" description:"Path to write the TLS certificate for loop's RPC and REST services."`
 	TLSKeyPath         string        `long:"tlskeypath" description:"Path to write the TLS private key for loop's RPC and REST services."`
 	TLSExtraIPs        []string      `long:"tlsextraip" description:"Adds an extra IP to the generated certificate."`
 	TLSExtraDomains    []string      `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate."`
 	TLSAutoRefresh     bool          `long:"tlsautorefresh" description:"Re-generate TLS certificate and key if the IPs or domains are changed."`
 	TLSDisableAutofill bool          `long:"tlsdisableautofill" description:"Do not include the interface IPs or the system hostname in TLS certificate, use first --tlsextradomain as Common Name instead, if set."`
-	TLSValidity        time.Duration `long:"tlsvalidity" description:"Loop's TLS certificate validity period in days. Defaults to 8760h (1 year)"`
+	TLSValidity        time.Duration `long:"tlsvalidity" description:"Loop's TLS certificate validity period in days. Defaults to 8760h (1 year). The certificate is re-generated on startup once less than a tenth of the period is left."`
 
-	MacaroonPath string `long:"macaroonpath" description:"Path to write the macaroon for loop's RPC and REST services if it doesn't exist."`
+	MacaroonPath string `long:"macaroonpath" description:"Path to write the macaroon for loop's RPC and REST services if it doesn't exist. The readonly.macaroon and swap.macaroon with restricted permissions are written to the same directory."`
 
 	LogDir         string `long:"logdir" description:"Directory to log output."`
 	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)."`
@@ -168,6 +193,30 @@ type Config struct {
 	TotalPaymentTimeout time.Duration `long:"totalpaymenttimeout" description:"The timeout to use for off-chain payments."`
 	MaxPaymentRetries   int           `long:"maxpaymentretries" description:"The maximum number of times an off-chain payment may be retried."`
 
+	StatusBufferSize int `long:"statusbuffersize" description:"The number of swap status updates that are queued if a client reads them too slowly. While queued, intermediate updates of a swap are replaced by newer ones. Set to 0 to disable."`
+
+	RepushDelay time.Duration `long:"repushdelay" description:"The delay after a new block before a pending loop out re-checks whether its htlc can be swept and re-pushes its preimage. Increase to reduce chain backend queries. Set to 0 to use the default."`
+
+	ResumeRetries int `long:"resumeretries" description:"The number of times that resuming pending swaps which failed to be resumed at startup is retried, with an increasing delay. Set to 0 to disable."`
+
+	TermsHistorySize int `long:"termshistorysize" description:"The number of changes of the server's loop out terms that are kept in the database for auditing. Set to 0 to use the default."`
+
+	MaxOutstandingValue uint64 `long:"maxoutstandingvalue" description:"The maximum total amount in satoshis that may be committed to pending swaps. New swaps that would exceed this value are rejected. Set to 0 to disable."`
+
+	MaxSweepFeeRate uint64 `long:"maxsweepfeerate" description:"The highest fee rate in sat/kw that sweep transactions are published with. Once reached, the fee rate is no longer bumped. Set to 0 to disable."`
+
+	MaxSweepFeeBumps int `long:"maxsweepfeebumps" description:"The number of times that the fee rate of a sweep transaction is bumped when it doesn't confirm. Afterwards, the sweep is republished with its last fee rate. Set to 0 to disable."`
+
+	SweepBatchWindow time.Duration `long:"sweepbatchwindow" description:"The delay after a new block before a sweep transaction is published, during which the sweeps of swaps that become ready around the same block are batched into a single transaction. Set to 0 to use the default."`
+
+	SweepFeeFunction string `long:"sweepfeefunction" description:"How the fee rate of a sweep transaction is raised when it doesn't confirm. 'step' bumps it by a fixed amount per block, 'linear' and 'exponential' raise it towards maxsweepfeerate, which is reached when the earliest htlc of the sweep expires." choice:"step" choice:"linear" choice:"exponential"`
+
+	DestAddrType string `long:"destaddrtype" description:"The type of the address that lnd's wallet generates for loop out sweeps when no destination address or account is given." choice:"p2wkh" choice:"np2wkh" choice:"p2tr"`
+
+	SweepStaticFeeRate uint64 `long:"sweepstaticfeerate" description:"A fixed fee rate in sat/kw to use for sweep fee estimates instead of lnd's fee estimator. Only allowed on regtest and simnet, intended for integration tests. Set to 0 to disable."`
+
+	MaxChainSubscriptions int `long:"maxchainsubscriptions" description:"The maximum number of confirmation and spend subscriptions that are open with lnd at the same time. Swaps that watch for the same transaction share a subscription. Once the limit is reached, new swaps are refused, while swaps that already run keep their subscriptions. Sweeps are not limited. Set to 0 to disable."`
+
 	EnableExperimental bool `long:"experimental" description:"Enable experimental features: reservations"`
 
 	Lnd *lndConfig `group:"lnd" namespace:"lnd"`
@@ -175,6 +224,10 @@ type Config struct {
 	Server *loopServerConfig `group:"server" namespace:"server"`
 
 	View viewParameters `command:"view" alias:"v" description:"View all swaps in the database. This command can only be executed when loopd is not running."`
+
+	Export exportParameters `command:"exportswaps" description:"Export all swaps in the database, including pending ones, to a file. This command can only be executed when loopd is not running."`
+
+	Import importParameters `command:"importswaps" description:"Import the swaps of an export into an empty database. Pending swaps are resumed on the next start of loopd, which requires the lnd node that they were initiated with. This command can only be executed when loopd is not running."`
 }
 
 const (
@@ -211,6 +264,7 @@ func DefaultConfig() Config {
 		LoopOutMaxParts:     defaultLoopOutMaxParts,
 		TotalPaymentTimeout: defaultTotalPaymentTimeout,
 		MaxPaymentRetries:   defaultMaxPaymentRetries,
+		ResumeRetries:       defaultResumeRetries,
 		EnableExperimental:  false,
 		Lnd: &lndConfig{
 			Host:         "localhost:10009",
@@ -227,7 +281,10 @@ func Validate(cfg *Config) error {
 	cfg.LogDir = lncfg.CleanAndExpandPath(cfg.LogDir)
 	cfg.TLSCertPath = lncfg.CleanAndExpandPath(cfg.TLSCertPath)
 	cfg.TLSKeyPath = lncfg.CleanAndExpandPath(cfg.TLSKeyPath)
+	cfg.Export.Output = lncfg.CleanAndExpandPath(cfg.Export.Output)
+	cfg.Import.Input = lncfg.CleanAndExpandPath(cfg.Import.Input)
 	cfg.MacaroonPath = lncfg.CleanAndExpandPath(cfg.MacaroonPath)
+	cfg.DBPasswordFile = lncfg.CleanAndExpandPath(cfg.DBPasswordFile)
 
 	// Since our loop directory overrides our log/data dir values, make sure
 	// that they are not set when loop dir is set. We hard here rather than
@@ -352,6 +409,50 @@ func Validate(cfg *Config) error {
 		return fmt.Errorf("max payment retries must be at least 1")
 	}
 
+	// The deadline-aware fee functions escalate towards the max sweep fee
+	// rate.
+	if cfg.SweepFeeFunction != "" && cfg.SweepFeeFunction != "step" &&
+		cfg.MaxSweepFeeRate == 0 {
+
+		return fmt.Errorf("sweepfeefunction %v requires maxsweepfeerate",
+			cfg.SweepFeeFunction)
+	}
+
+	// A static sweep fee rate must never be used on a real network.
+	if cfg.SweepStaticFeeRate != 0 && cfg.Network != "regtest" &&
+		cfg.Network != "simnet" {
+
+		return fmt.Errorf("sweepstaticfeerate is only allowed on " +
+			"regtest and simnet")
+	}
+
+	// Webhook payloads are always signed, so that endpoints can tell them
+	// apart from forged ones.
+	if len(cfg.Webhooks) > 0 && cfg.WebhookSecret == "" {
+		return fmt.Errorf("webhooksecret must be set to use webhooks")
+	}
+
+	for _, webhook := range cfg.Webhooks {
+		webhookURL, err := url.Parse(webhook)
+		if err != nil {
+			return fmt.Errorf("invalid webhook %v: %v", webhook, err)
+		}
+
+		if webhookURL.Scheme != "http" && webhookURL.Scheme != "https" {
+			return fmt.Errorf("webhook %v must be an http or https "+
+				"url", webhook)
+		}
+	}
+
+	if cfg.FiatPriceURL != "" && cfg.FiatCurrency == "" {
+		return fmt.Errorf("fiatcurrency must be set to use fiatpriceurl")
+	}
+
+	if cfg.DBEncryption == dbEncryptionPassword && cfg.DBPasswordFile == "" {
+		return fmt.Errorf("dbpasswordfile must be set to encrypt the " +
+			"database with a password")
+	}
+
 	// TLS Validity period to be at least 24 hours
 	if cfg.TLSValidity < time.Hour*24 {
 		return fmt.Errorf("TLS certificate minimum validity period is 24h")
@@ -362,49 +463,96 @@ func Validate(cfg *Config) error {
 
 // getTLSConfig generates a new self signed certificate or refreshes an existing
 // one if necessary, then returns the full TLS configuration for initializing
-// a secure server interface.
+// a secure server interface. The returned renewer serves the certificate and
+// must be run to renew it while the daemon is running.
 func getTLSConfig(cfg *Config) (*tls.Config, *credentials.TransportCredentials,
-	error) {
+	*tlsCertRenewer, error) {
 
 	// Let's load our certificate first or create then load if it doesn't
 	// yet exist.
 	certData, parsedCert, err := loadCertWithCreate(cfg)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	// If the certificate expired or it was outdated, delete it and the TLS
-	// key and generate a new pair.
-	if time.Now().After(parsedCert.NotAfter) {
-		log.Info("TLS certificate is expired or outdated, " +
-			"removing old file then generating a new one")
+	// If the certificate expires soon or it was outdated, delete it and
+	// the TLS key and generate a new pair.
+	refresh, err := tlsNeedsRefresh(cfg, parsedCert, time.Now())
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
-		err := os.Remove(cfg.TLSCertPath)
-		if err != nil {
-			return nil, nil, err
-		}
+	if refresh {
+		log.Info("TLS certificate is expiring or outdated, " +
+			"removing old file then generating a new one")
 
-		err = os.Remove(cfg.TLSKeyPath)
+		certData, parsedCert, err = regenerateCert(cfg)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
+	}
 
-		certData, _, err = loadCertWithCreate(cfg)
-		if err != nil {
-			return nil, nil, err
-		}
+	renewer, err := newTLSCertRenewer(cfg, parsedCert)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
+	// The certificate is served by the renewer, so that a renewed
+	// certificate is picked up without a restart.
 	tlsCfg := cert.TLSConfFromCert(certData)
+	tlsCfg.Certificates = nil
+	tlsCfg.GetCertificate = renewer.reloader.GetCertificateFunc()
 	tlsCfg.NextProtos = []string{"h2"}
-	restCreds, err := credentials.NewClientTLSFromFile(
-		cfg.TLSCertPath, "",
-	)
+
+	// The REST proxy pins the certificate that the daemon currently
+	// serves instead of the one that was on disk at startup. The regular
+	// verification is replaced by this check.
+	restCreds := credentials.NewTLS(&tls.Config{
+		MinVersion:            tls.VersionTLS12,
+		InsecureSkipVerify:    true, // nolint:gosec
+		VerifyPeerCertificate: renewer.verifyPeerCert,
+	})
+
+	return tlsCfg, &restCreds, renewer, nil
+}
+
+// regenerateCert deletes the TLS certificate and key and generates a new pair.
+func regenerateCert(cfg *Config) (tls.Certificate, *x509.Certificate,
+	error) {
+
+	err := os.Remove(cfg.TLSCertPath)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	err = os.Remove(cfg.TLSKeyPath)
 	if err != nil {
-		return nil, nil, err
+		return tls.Certificate{}, nil, err
 	}
 
-	return tlsCfg, &restCreds, nil
+	return loadCertWithCreate(cfg)
+}
+
+// tlsNeedsRefresh returns whether the TLS certificate has to be regenerated.
+// This is the case once less than a tenth of the configured validity period is
+// left, so that the certificate is renewed before it expires. If auto refresh
+// is enabled, it is also regenerated if its IPs or domains are outdated.
+func tlsNeedsRefresh(cfg *Config, parsedCert *x509.Certificate,
+	now time.Time) (bool, error) {
+
+	renewalTime := parsedCert.NotAfter.Add(-cfg.TLSValidity / 10)
+	if !now.Before(renewalTime) {
+		return true, nil
+	}
+
+	if !cfg.TLSAutoRefresh {
+		return false, nil
+	}
+
+	return cert.IsOutdated(
+		parsedCert, cfg.TLSExtraIPs, cfg.TLSExtraDomains,
+		cfg.TLSDisableAutofill,
+	)
 }
 
 // loadCertWithCreate tries to load the TLS certificate from disk. If the
@@ -0,0 +1,36 @@
+package loopd
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestTLSNeedsRefresh tests that the TLS certificate is renewed before it
+// expires.
+func TestTLSNeedsRefresh(t *testing.T) {
+	cfg := &Config{
+		TLSValidity: 100 * 24 * time.Hour,
+	}
+
+	now := time.Now()
+	newCert := func(validFor time.Duration) *x509.Certificate {
+		return &x509.Certificate{
+			NotAfter: now.Add(validFor),
+		}
+	}
+
+	refresh, err := tlsNeedsRefresh(cfg, newCert(50*24*time.Hour), now)
+	require.NoError(t, err)
+	require.False(t, refresh)
+
+	refresh, err = tlsNeedsRefresh(cfg, newCert(5*24*time.Hour), now)
+	require.NoError(t, err)
+	require.True(t, refresh)
+
+	refresh, err = tlsNeedsRefresh(cfg, newCert(-time.Hour), now)
+	require.NoError(t, err)
+	require.True(t, refresh)
+}
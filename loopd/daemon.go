@@ -28,6 +28,7 @@ import (
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -94,6 +95,8 @@ type Daemon struct {
 	restListener  net.Listener
 	restCtxCancel func()
 
+	metricsServer *http.Server
+
 	macaroonService *lndclient.MacaroonService
 }
 
@@ -135,6 +138,14 @@ func (d *Daemon) Start() error {
 		return err
 	}
 
+	err = checkLndPermissions(
+		context.Background(), d.lnd.Client, d.cfg.Lnd.MacaroonPath,
+	)
+	if err != nil {
+		d.lnd.Close()
+		return err
+	}
+
 	// With lnd connected, initialize everything else, such as the swap
 	// server client, the swap client RPC server instance and our main swap
 	// and error handlers. If this fails, then nothing has been started yet
@@ -230,8 +241,12 @@ func (d *Daemon) startWebServers() error {
 		return fmt.Errorf("error with macaroon interceptor: %v", err)
 	}
 	d.grpcServer = grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor),
-		grpc.StreamInterceptor(streamInterceptor),
+		grpc.ChainUnaryInterceptor(
+			unaryInterceptor, errorUnaryInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			streamInterceptor, errorStreamInterceptor,
+		),
 	)
 	loop_looprpc.RegisterSwapClientServer(d.grpcServer, d)
 
@@ -240,7 +255,7 @@ func (d *Daemon) startWebServers() error {
 
 	// Next, start the gRPC server listening for HTTP/2 connections.
 	log.Infof("Starting gRPC listener")
-	serverTLSCfg, restClientCreds, err := getTLSConfig(d.cfg)
+	serverTLSCfg, restClientCreds, tlsRenewer, err := getTLSConfig(d.cfg)
 	if err != nil {
 		return fmt.Errorf("could not create gRPC server options: %v",
 			err)
@@ -255,19 +270,29 @@ func (d *Daemon) startWebServers() error {
 	// true, which instructs it to use the same field names as specified in
 	// the proto file and not switch to camel case. What we also want is
 	// that the marshaler prints all values, even if they are falsey.
-	customMarshalerOption := proxy.WithMarshalerOption(
-		proxy.MIMEWildcard, &proxy.JSONPb{
-			MarshalOptions: protojson.MarshalOptions{
-				UseProtoNames:   true,
-				EmitUnpopulated: true,
-			},
+	restMarshaler := &proxy.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
 		},
+	}
+	customMarshalerOption := proxy.WithMarshalerOption(
+		proxy.MIMEWildcard, restMarshaler,
 	)
 
 	// We'll also create and start an accompanying proxy to serve clients
 	// through REST.
 	ctx, cancel := context.WithCancel(context.Background())
 	d.restCtxCancel = cancel
+
+	// Renew the TLS certificate while we are running. The renewal stops
+	// with the REST proxy, which is stopped on every shutdown.
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		tlsRenewer.run(ctx)
+	}()
 	mux := proxy.NewServeMux(customMarshalerOption)
 	var restHandler http.Handler = mux
 	if d.cfg.CORSOrigin != "" {
@@ -300,6 +325,15 @@ func (d *Daemon) startWebServers() error {
 		return err
 	}
 
+	// Swap updates are streamed to web clients as server-sent events,
+	// which the generated proxy doesn't offer.
+	err = registerSwapEvents(
+		ctx, mux, restProxyDest, proxyOpts, restMarshaler,
+	)
+	if err != nil {
+		return err
+	}
+
 	d.restListener, err = d.listenerCfg.restListener(serverTLSCfg)
 	if err != nil {
 		return fmt.Errorf("REST proxy unable to listen on %s: %v",
@@ -391,7 +425,7 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 	if needSqlMigration(d.cfg) {
 		log.Infof("Boltdb found, running migration")
 
-		err := migrateBoltdb(d.mainCtx, d.cfg)
+		err := migrateBoltdb(d.mainCtx, d.cfg, &d.lnd.LndServices)
 		if err != nil {
 			return fmt.Errorf("unable to migrate boltdb: %v", err)
 		}
@@ -406,7 +440,9 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		return err
 	}
 
-	swapDb, baseDb, err := openDatabase(d.cfg, chainParams)
+	swapDb, baseDb, err := openDatabase(
+		d.mainCtx, d.cfg, chainParams, &d.lnd.LndServices,
+	)
 	if err != nil {
 		return err
 	}
@@ -487,6 +523,16 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 			clientCleanup()
 			return err
 		}
+
+		if err = d.bakeScopedMacaroons(); err != nil {
+			if err := d.macaroonService.Stop(); err != nil {
+				log.Errorf("Error shutting down macaroon "+
+					"service: %v", err)
+			}
+			cleanupMacaroonStore()
+			clientCleanup()
+			return err
+		}
 	}
 
 	var (
@@ -535,7 +581,7 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		config:             d.cfg,
 		network:            lndclient.Network(d.cfg.Network),
 		impl:               swapClient,
-		liquidityMgr:       getLiquidityManager(swapClient),
+		liquidityMgr:       getLiquidityManager(d.cfg, swapClient),
 		lnd:                &d.lnd.LndServices,
 		swaps:              make(map[lntypes.Hash]loop.SwapInfo),
 		subscribers:        make(map[int]chan<- interface{}),
@@ -570,6 +616,16 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		d.swaps[s.SwapHash] = *s
 	}
 
+	if d.cfg.MetricsListen != "" {
+		d.metrics = newSwapMetrics(swapsList)
+	}
+
+	if len(d.cfg.Webhooks) > 0 {
+		d.webhooks = newWebhookNotifier(
+			d.cfg.Webhooks, d.cfg.WebhookSecret, log,
+		)
+	}
+
 	// Start the swap client itself.
 	d.wg.Add(1)
 	go func() {
@@ -596,6 +652,24 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		d.processStatusUpdates(d.mainCtx)
 	}()
 
+	if d.webhooks != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Infof("Starting webhook notifications")
+			d.webhooks.run(d.mainCtx)
+			log.Infof("Webhook notifications stopped")
+		}()
+	}
+
+	if d.metrics != nil {
+		err := d.startMetricsServer()
+		if err != nil {
+			return err
+		}
+	}
+
 	d.wg.Add(1)
 	go func() {
 		defer d.wg.Done()
@@ -742,6 +816,12 @@ func (d *Daemon) stop() {
 	if d.restCtxCancel != nil {
 		d.restCtxCancel()
 	}
+	if d.metricsServer != nil {
+		err := d.metricsServer.Close()
+		if err != nil {
+			log.Errorf("Error stopping metrics server: %v", err)
+		}
+	}
 
 	if d.macaroonService != nil {
 		err := d.macaroonService.Stop()
@@ -762,6 +842,46 @@ func (d *Daemon) stop() {
 	d.wg.Wait()
 }
 
+// startMetricsServer starts serving the prometheus metrics on the configured
+// metrics address and keeps the block height lag up to date.
+func (d *Daemon) startMetricsServer() error {
+	listener, err := net.Listen("tcp", d.cfg.MetricsListen)
+	if err != nil {
+		return fmt.Errorf("metrics server unable to listen on %s: %v",
+			d.cfg.MetricsListen, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(
+		d.metrics.registry, promhttp.HandlerOpts{},
+	))
+
+	d.metricsServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		log.Infof("Metrics server listening on %s", listener.Addr())
+		err := d.metricsServer.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			d.internalErrChan <- err
+		}
+	}()
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		d.trackHeightLag(d.mainCtx)
+	}()
+
+	return nil
+}
+
 // allowCORS wraps the given http.Handler with a function that adds the
 // Access-Control-Allow-Origin header to the response.
 func allowCORS(handler http.Handler, origin string) http.Handler {
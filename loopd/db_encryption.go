@@ -0,0 +1,177 @@
+package loopd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+const (
+	// dbEncryptionLnd derives the database key from lnd's wallet.
+	dbEncryptionLnd = "lnd"
+
+	// dbEncryptionPassword derives the database key from a password.
+	dbEncryptionPassword = "password"
+
+	// dbEncryptionMessage is the message that lnd signs to derive the
+	// database key.
+	dbEncryptionMessage = "loop database encryption"
+)
+
+// setupSecretEncryption enables the encryption of the swap secrets of the
+// database. The key is derived with the key parameters that are stored in the
+// database, so that the same key is derived on every start. On the first
+// start with encryption enabled, new parameters are derived from the
+// configuration and stored with a key check. The key is checked before it is
+// enabled, so loopd refuses to start with a wrong key before any secret is
+// written with it.
+func setupSecretEncryption(ctx context.Context, cfg *Config,
+	lnd *lndclient.LndServices, db *loopdb.BaseDB) error {
+
+	params, err := db.FetchSecretKeyParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case cfg.DBEncryption == "" && params == nil:
+		return nil
+
+	case cfg.DBEncryption == "":
+		return fmt.Errorf("swap secrets are encrypted with a %v key, "+
+			"dbencryption=%v required", params.Scheme,
+			params.Scheme)
+
+	case params != nil && string(params.Scheme) != cfg.DBEncryption:
+		return fmt.Errorf("swap secrets are encrypted with a %v key, "+
+			"but dbencryption is %v", params.Scheme,
+			cfg.DBEncryption)
+	}
+
+	newParams := params == nil
+	if newParams {
+		params, err = newKeyParams(cfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	secrets, err := keyCipher(ctx, cfg, lnd, params)
+	if err != nil {
+		return err
+	}
+
+	err = db.CheckSecretKey(ctx, secrets)
+	if err != nil {
+		return fmt.Errorf("database key doesn't match the key of the "+
+			"stored swap secrets: %w", err)
+	}
+
+	db.EnableSecretEncryption(secrets)
+
+	if newParams {
+		return db.PutSecretKeyParams(ctx, params)
+	}
+
+	return nil
+}
+
+// newKeyParams returns the parameters of a new key of the configured scheme.
+func newKeyParams(cfg *Config) (*loopdb.KeyParams, error) {
+	switch cfg.DBEncryption {
+	case dbEncryptionLnd:
+		return &loopdb.KeyParams{
+			Scheme: loopdb.KeySchemeLnd,
+		}, nil
+
+	case dbEncryptionPassword:
+		salt, err := loopdb.NewPasswordSalt()
+		if err != nil {
+			return nil, err
+		}
+
+		return loopdb.NewPasswordKeyParams(salt), nil
+
+	default:
+		return nil, fmt.Errorf("unknown database encryption: %v",
+			cfg.DBEncryption)
+	}
+}
+
+// keyCipher derives the key with the given parameters and returns the cipher
+// of that key. A password key is derived from the password in the configured
+// password file, so that a backup can be decrypted on another machine with
+// the parameters that it carries.
+func keyCipher(ctx context.Context, cfg *Config, lnd *lndclient.LndServices,
+	params *loopdb.KeyParams) (*loopdb.SecretCipher, error) {
+
+	var (
+		key [loopdb.SecretKeySize]byte
+		err error
+	)
+	switch params.Scheme {
+	case loopdb.KeySchemeLnd:
+		key, err = lndKey(ctx, lnd)
+
+	case loopdb.KeySchemePassword:
+		key, err = passwordKey(cfg, params)
+
+	default:
+		return nil, fmt.Errorf("unknown key scheme: %v", params.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return loopdb.NewSecretCipher(key)
+}
+
+// lndKey derives the database key from lnd's wallet. The key is the hash of
+// lnd's signature of a fixed message with a key of a dedicated key family.
+// That key is used for nothing else, so neither it nor its public key ever
+// leave lnd, and the signature can't be recreated without the wallet. lnd's
+// ECDSA signatures are deterministic (RFC 6979), so the same database key is
+// derived on every start.
+func lndKey(ctx context.Context,
+	lnd *lndclient.LndServices) ([loopdb.SecretKeySize]byte, error) {
+
+	sig, err := lnd.Signer.SignMessage(
+		ctx, []byte(dbEncryptionMessage), keychain.KeyLocator{
+			Family: keychain.KeyFamily(swap.DBEncryptionKeyFamily),
+			Index:  0,
+		},
+	)
+	if err != nil {
+		return [loopdb.SecretKeySize]byte{}, fmt.Errorf("unable to "+
+			"derive database key: %v", err)
+	}
+
+	return sha256.Sum256(sig), nil
+}
+
+// passwordKey derives the database key from the password in the configured
+// password file with the given parameters.
+func passwordKey(cfg *Config, params *loopdb.KeyParams) (
+	[loopdb.SecretKeySize]byte, error) {
+
+	if cfg.DBPasswordFile == "" {
+		return [loopdb.SecretKeySize]byte{}, errors.New("database " +
+			"password file required")
+	}
+
+	password, err := os.ReadFile(cfg.DBPasswordFile)
+	if err != nil {
+		return [loopdb.SecretKeySize]byte{}, fmt.Errorf("unable to "+
+			"read database password: %v", err)
+	}
+
+	return loopdb.PasswordKey(bytes.TrimSpace(password), params)
+}
@@ -0,0 +1,71 @@
+package loopd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSetupSecretEncryption tests that the key parameters are stored on the
+// first start with encryption enabled, that later starts derive the key from
+// the stored parameters, and that a start with another key is refused.
+func TestSetupSecretEncryption(t *testing.T) {
+	ctx := context.Background()
+
+	writePassword := func(password string) string {
+		path := filepath.Join(t.TempDir(), "password")
+		err := os.WriteFile(path, []byte(password), 0600)
+		require.NoError(t, err)
+
+		return path
+	}
+	passwordFile := writePassword("password")
+
+	newConfig := func(passwordFile string) *Config {
+		return &Config{
+			DBEncryption:   dbEncryptionPassword,
+			DBPasswordFile: passwordFile,
+		}
+	}
+
+	db := loopdb.NewTestDB(t)
+
+	// The first start stores the parameters of a new key.
+	cfg := newConfig(passwordFile)
+	err := setupSecretEncryption(ctx, cfg, nil, db.BaseDB)
+	require.NoError(t, err)
+	require.NotNil(t, db.SecretCipher())
+
+	params, err := db.FetchSecretKeyParams(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, params)
+	require.Equal(t, loopdb.KeySchemePassword, params.Scheme)
+
+	// A later start derives the same key from the stored parameters.
+	cfg = newConfig(passwordFile)
+	err = setupSecretEncryption(ctx, cfg, nil, db.BaseDB)
+	require.NoError(t, err)
+
+	// A start with another password is refused, and the stored parameters
+	// are left untouched.
+	otherPassword := newConfig(writePassword("other password"))
+	err = setupSecretEncryption(ctx, otherPassword, nil, db.BaseDB)
+	require.ErrorIs(t, err, loopdb.ErrWrongSecretKey)
+
+	stored, err := db.FetchSecretKeyParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, stored)
+
+	// A start with another scheme, or without encryption, is refused.
+	lndConfig := newConfig("")
+	lndConfig.DBEncryption = dbEncryptionLnd
+	err = setupSecretEncryption(ctx, lndConfig, nil, db.BaseDB)
+	require.ErrorContains(t, err, "dbencryption is lnd")
+
+	err = setupSecretEncryption(ctx, &Config{}, nil, db.BaseDB)
+	require.ErrorContains(t, err, "dbencryption=password required")
+}
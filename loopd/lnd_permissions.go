@@ -0,0 +1,79 @@
+package loopd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopd/perms"
+)
+
+// checkLndPermissions makes sure that the lnd macaroon at the given path
+// grants all permissions that loopd needs, so that a custom macaroon that is
+// missing permissions is reported at startup rather than on the first call
+// that needs them. The check relies on lnd's CheckMacaroonPermissions call. If
+// that call isn't available, for example because the macaroon doesn't grant
+// access to it, the check is skipped.
+func checkLndPermissions(ctx context.Context, lnd lndclient.LightningClient,
+	macaroonPath string) error {
+
+	if macaroonPath == "" {
+		return nil
+	}
+
+	macaroon, err := os.ReadFile(macaroonPath)
+	if err != nil {
+		return fmt.Errorf("unable to read lnd macaroon: %v", err)
+	}
+
+	required := make(
+		[]lndclient.MacaroonPermission, len(perms.RequiredLndPermissions),
+	)
+	for i, op := range perms.RequiredLndPermissions {
+		required[i] = lndclient.MacaroonPermission{
+			Entity: op.Entity,
+			Action: op.Action,
+		}
+	}
+
+	valid, err := lnd.CheckMacaroonPermissions(ctx, macaroon, required, "")
+	if err != nil {
+		log.Warnf("Unable to check lnd macaroon permissions, skipping "+
+			"check: %v", err)
+
+		return nil
+	}
+
+	if valid {
+		return nil
+	}
+
+	// Check the permissions one by one to tell which ones are missing.
+	var missing []string
+	for _, permission := range required {
+		permission := permission
+
+		valid, err := lnd.CheckMacaroonPermissions(
+			ctx, macaroon, []lndclient.MacaroonPermission{
+				permission,
+			}, "",
+		)
+		if err != nil {
+			return fmt.Errorf("unable to check lnd macaroon "+
+				"permission %v: %v", permission.String(), err)
+		}
+
+		if !valid {
+			missing = append(missing, permission.String())
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("lnd macaroon %v is missing permissions: %v",
+		macaroonPath, strings.Join(missing, ", "))
+}
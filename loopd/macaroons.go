@@ -1,9 +1,30 @@
 package loopd
 
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/lightninglabs/loop/loopd/perms"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
 const (
 	// loopMacaroonLocation is the value we use for the loopd macaroons'
 	// "Location" field when baking them.
 	loopMacaroonLocation = "loop"
+
+	// readOnlyMacaroonFilename is the file name of the macaroon that can
+	// only monitor swaps.
+	readOnlyMacaroonFilename = "readonly.macaroon"
+
+	// swapMacaroonFilename is the file name of the macaroon that can
+	// monitor and execute swaps, but not change the autoloop
+	// configuration.
+	swapMacaroonFilename = "swap.macaroon"
 )
 
 var (
@@ -18,4 +39,107 @@ var (
 	// create/unlock calls in the RPC. Using a password should be optional
 	// though.
 	macDbDefaultPw = []byte("")
+
+	// swapExecutionOps are the write permissions that the swap macaroon
+	// grants in addition to all read permissions.
+	swapExecutionOps = []bakery.Op{{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "in",
+	}, {
+		Entity: "loop",
+		Action: "out",
+	}}
 )
+
+// readOnlyOp returns whether the readonly macaroon grants the op.
+func readOnlyOp(op bakery.Op) bool {
+	return op.Action == "read"
+}
+
+// swapOp returns whether the swap macaroon grants the op.
+func swapOp(op bakery.Op) bool {
+	if readOnlyOp(op) {
+		return true
+	}
+
+	for _, swapExecutionOp := range swapExecutionOps {
+		if op == swapExecutionOp {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scopedPermissions returns the deduplicated ops of all RPC permissions that
+// are granted by the given scope, sorted by entity and action.
+func scopedPermissions(granted func(bakery.Op) bool) []bakery.Op {
+	unique := make(map[bakery.Op]struct{})
+	for _, ops := range perms.RequiredPermissions {
+		for _, op := range ops {
+			if granted(op) {
+				unique[op] = struct{}{}
+			}
+		}
+	}
+
+	scoped := make([]bakery.Op, 0, len(unique))
+	for op := range unique {
+		scoped = append(scoped, op)
+	}
+
+	sort.Slice(scoped, func(i, j int) bool {
+		if scoped[i].Entity != scoped[j].Entity {
+			return scoped[i].Entity < scoped[j].Entity
+		}
+
+		return scoped[i].Action < scoped[j].Action
+	})
+
+	return scoped
+}
+
+// bakeScopedMacaroons writes the readonly and swap macaroons next to the loop
+// macaroon, which grants all permissions. They are baked on every start, so
+// that they always match the current permissions. Previously baked copies
+// stay valid as they share the default root key.
+func (d *Daemon) bakeScopedMacaroons() error {
+	scopes := []struct {
+		filename string
+		granted  func(bakery.Op) bool
+	}{
+		{readOnlyMacaroonFilename, readOnlyOp},
+		{swapMacaroonFilename, swapOp},
+	}
+
+	idCtx := macaroons.ContextWithRootKeyID(
+		context.Background(), macaroons.DefaultRootKeyID,
+	)
+	macDir := filepath.Dir(d.cfg.MacaroonPath)
+
+	for _, scope := range scopes {
+		mac, err := d.macaroonService.Oven.NewMacaroon(
+			idCtx, bakery.LatestVersion, nil,
+			scopedPermissions(scope.granted)...,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to bake %v: %v",
+				scope.filename, err)
+		}
+
+		macBytes, err := mac.M().MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(macDir, scope.filename)
+		if err := os.WriteFile(path, macBytes, 0644); err != nil {
+			return fmt.Errorf("unable to write %v: %v", path, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop/loopd/perms"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// allowed returns whether the scoped ops grant all ops of the RPC.
+func allowed(t *testing.T, scoped []bakery.Op, rpc string) bool {
+	t.Helper()
+
+	required, ok := perms.RequiredPermissions[rpc]
+	require.True(t, ok, rpc)
+
+	for _, op := range required {
+		if !containsOp(scoped, op) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsOp returns whether the op is in the list.
+func containsOp(ops []bakery.Op, op bakery.Op) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TestScopedPermissions tests which RPCs the scoped macaroons grant access
+// to.
+func TestScopedPermissions(t *testing.T) {
+	readOnly := scopedPermissions(readOnlyOp)
+	swap := scopedPermissions(swapOp)
+
+	require.True(t, allowed(t, readOnly, "/looprpc.SwapClient/ListSwaps"))
+	require.True(t, allowed(t, readOnly, "/looprpc.SwapClient/Monitor"))
+	require.False(t, allowed(t, readOnly, "/looprpc.SwapClient/LoopOut"))
+	require.False(t, allowed(t, readOnly, "/looprpc.SwapClient/LoopIn"))
+
+	require.True(t, allowed(t, swap, "/looprpc.SwapClient/Monitor"))
+	require.True(t, allowed(t, swap, "/looprpc.SwapClient/LoopOut"))
+	require.True(t, allowed(t, swap, "/looprpc.SwapClient/LoopIn"))
+	require.False(t, allowed(
+		t, swap, "/looprpc.SwapClient/SetLiquidityParams",
+	))
+
+	// The scoped ops are deduplicated.
+	for i := range swap {
+		require.False(t, containsOp(swap[i+1:], swap[i]))
+	}
+}
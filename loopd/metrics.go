@@ -0,0 +1,185 @@
+package loopd
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// metricsNamespace is the namespace of all metrics that loopd exports.
+	metricsNamespace = "loop"
+
+	// heightLagInterval is the interval in which the block height lag
+	// between lnd and the swap client is refreshed.
+	heightLagInterval = time.Minute
+)
+
+// swapMetrics holds the prometheus metrics of the swaps that loopd executes.
+type swapMetrics struct {
+	registry *prometheus.Registry
+
+	started   *prometheus.CounterVec
+	succeeded *prometheus.CounterVec
+	failed    *prometheus.CounterVec
+	swept     *prometheus.CounterVec
+	fees      *prometheus.CounterVec
+	pending   *prometheus.GaugeVec
+	heightLag prometheus.Gauge
+
+	// states holds the last known state of every swap, so that we only
+	// count state transitions once.
+	states map[lntypes.Hash]loopdb.SwapState
+
+	mu sync.Mutex
+}
+
+// newSwapMetrics creates the swap metrics and registers them with a new
+// registry. The swaps that already exist are only counted as pending, they
+// don't count as started or completed again after a restart.
+func newSwapMetrics(swaps []*loop.SwapInfo) *swapMetrics {
+	typeLabel := []string{"type"}
+
+	m := &swapMetrics{
+		registry: prometheus.NewRegistry(),
+		started: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "swaps_started_total",
+			Help:      "Number of swaps that were started.",
+		}, typeLabel),
+		succeeded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "swaps_succeeded_total",
+			Help:      "Number of swaps that succeeded.",
+		}, typeLabel),
+		failed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "swaps_failed_total",
+			Help:      "Number of swaps that failed.",
+		}, typeLabel),
+		swept: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "swapped_sats_total",
+			Help:      "Amount in satoshis of the swaps that succeeded.",
+		}, typeLabel),
+		fees: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "swap_fees_sats_total",
+			Help: "Fees in satoshis paid for completed swaps, by " +
+				"kind of fee.",
+		}, []string{"type", "kind"}),
+		pending: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "swaps_pending",
+			Help:      "Number of swaps that are pending.",
+		}, typeLabel),
+		heightLag: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "block_height_lag",
+			Help: "Number of blocks that the swap client is " +
+				"behind lnd.",
+		}),
+		states: make(map[lntypes.Hash]loopdb.SwapState),
+	}
+
+	m.registry.MustRegister(
+		m.started, m.succeeded, m.failed, m.swept, m.fees, m.pending,
+		m.heightLag,
+	)
+
+	for _, swp := range swaps {
+		m.states[swp.SwapHash] = swp.State
+		if swp.State.IsPending() {
+			swapType := strings.ToLower(swp.SwapType.String())
+			m.pending.WithLabelValues(swapType).Inc()
+		}
+	}
+
+	return m
+}
+
+// update records a swap status update.
+func (m *swapMetrics) update(swp loop.SwapInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	swapType := strings.ToLower(swp.SwapType.String())
+
+	prev, known := m.states[swp.SwapHash]
+	m.states[swp.SwapHash] = swp.State
+
+	if !known {
+		m.started.WithLabelValues(swapType).Inc()
+
+		if swp.State.IsPending() {
+			m.pending.WithLabelValues(swapType).Inc()
+		}
+	}
+
+	// We're only interested in the transition into a final state, which
+	// happens exactly once per swap.
+	if swp.State.IsPending() || (known && prev.IsFinal()) {
+		return
+	}
+
+	if known {
+		m.pending.WithLabelValues(swapType).Dec()
+	}
+
+	if swp.State == loopdb.StateSuccess {
+		m.succeeded.WithLabelValues(swapType).Inc()
+		addSats(m.swept.WithLabelValues(swapType), swp.AmountRequested)
+	} else {
+		m.failed.WithLabelValues(swapType).Inc()
+	}
+
+	addSats(m.fees.WithLabelValues(swapType, "server"), swp.Cost.Server)
+	addSats(m.fees.WithLabelValues(swapType, "onchain"), swp.Cost.Onchain)
+	addSats(
+		m.fees.WithLabelValues(swapType, "offchain"), swp.Cost.Offchain,
+	)
+}
+
+// addSats adds an amount to a counter. Counters can't decrease, so negative
+// amounts, such as a server fee that turned into a rebate, are skipped.
+func addSats(counter prometheus.Counter, amt btcutil.Amount) {
+	if amt > 0 {
+		counter.Add(float64(amt))
+	}
+}
+
+// setHeightLag sets the number of blocks that the swap client is behind lnd.
+func (m *swapMetrics) setHeightLag(lndHeight uint32, clientHeight int32) {
+	m.heightLag.Set(float64(int64(lndHeight) - int64(clientHeight)))
+}
+
+// trackHeightLag periodically refreshes the block height lag between lnd and
+// the swap client until the context is canceled.
+func (d *Daemon) trackHeightLag(ctx context.Context) {
+	ticker := time.NewTicker(heightLagInterval)
+	defer ticker.Stop()
+
+	for {
+		info, err := d.lnd.Client.GetInfo(ctx)
+		if err != nil {
+			log.Warnf("Unable to get lnd info for metrics: %v", err)
+		} else {
+			d.metrics.setHeightLag(
+				info.BlockHeight, d.impl.Status().Height,
+			)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapMetrics tests that swap status updates are counted once per state
+// transition and that swaps which existed on startup aren't counted as
+// started again.
+func TestSwapMetrics(t *testing.T) {
+	swapInfo := func(hash byte, state loopdb.SwapState) loop.SwapInfo {
+		info := loop.SwapInfo{
+			SwapHash: lntypes.Hash{hash},
+			SwapType: swap.TypeOut,
+		}
+		info.State = state
+		info.AmountRequested = 100_000
+		info.Cost = loopdb.SwapCost{
+			Server:   100,
+			Onchain:  200,
+			Offchain: -10,
+		}
+
+		return info
+	}
+
+	get := testutil.ToFloat64
+
+	// Start with one swap that is still pending and one that completed
+	// before the restart.
+	resumed := swapInfo(1, loopdb.StateHtlcPublished)
+	completed := swapInfo(2, loopdb.StateSuccess)
+	m := newSwapMetrics([]*loop.SwapInfo{&resumed, &completed})
+
+	require.Equal(t, 1.0, get(m.pending.WithLabelValues("out")))
+	require.Equal(t, 0.0, get(m.started.WithLabelValues("out")))
+
+	// A new swap is started and updated twice while pending.
+	m.update(swapInfo(3, loopdb.StateInitiated))
+	m.update(swapInfo(3, loopdb.StateHtlcPublished))
+	require.Equal(t, 1.0, get(m.started.WithLabelValues("out")))
+	require.Equal(t, 2.0, get(m.pending.WithLabelValues("out")))
+
+	// The resumed swap succeeds and the new one fails. Repeated final
+	// updates are ignored.
+	m.update(swapInfo(1, loopdb.StateSuccess))
+	m.update(swapInfo(1, loopdb.StateSuccess))
+	m.update(swapInfo(3, loopdb.StateFailTimeout))
+
+	require.Equal(t, 0.0, get(m.pending.WithLabelValues("out")))
+	require.Equal(t, 1.0, get(m.succeeded.WithLabelValues("out")))
+	require.Equal(t, 1.0, get(m.failed.WithLabelValues("out")))
+	require.Equal(t, 100_000.0, get(m.swept.WithLabelValues("out")))
+	require.Equal(t, 200.0, get(m.fees.WithLabelValues("out", "server")))
+	require.Equal(t, 400.0, get(m.fees.WithLabelValues("out", "onchain")))
+	require.Equal(t, 0.0, get(m.fees.WithLabelValues("out", "offchain")))
+
+	m.setHeightLag(105, 100)
+	require.Equal(t, 5.0, get(m.heightLag))
+}
@@ -11,7 +11,9 @@ import (
 )
 
 // migrateBoltdb migrates the boltdb to sqlite.
-func migrateBoltdb(ctx context.Context, cfg *Config) error {
+func migrateBoltdb(ctx context.Context, cfg *Config,
+	lnd *lndclient.LndServices) error {
+
 	// First get the chain params.
 	chainParams, err := lndclient.Network(cfg.Network).ChainParams()
 	if err != nil {
@@ -25,7 +27,7 @@ func migrateBoltdb(ctx context.Context, cfg *Config) error {
 	}
 	defer boltdb.Close()
 
-	swapDb, _, err := openDatabase(cfg, chainParams)
+	swapDb, _, err := openDatabase(ctx, cfg, chainParams, lnd)
 	if err != nil {
 		return err
 	}
@@ -31,6 +31,14 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "swap",
 		Action: "read",
 	}},
+	"/looprpc.SwapClient/GetSwapHistory": {{
+		Entity: "swap",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/GetAccountingReport": {{
+		Entity: "swap",
+		Action: "read",
+	}},
 	"/looprpc.SwapClient/AbandonSwap": {{
 		Entity: "swap",
 		Action: "execute",
@@ -41,6 +49,20 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "loop",
 		Action: "out",
 	}},
+	"/looprpc.SwapClient/BumpSweepFee": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "out",
+	}},
+	"/looprpc.SwapClient/UpdateSwap": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "out",
+	}},
 	"/looprpc.SwapClient/LoopOutTerms": {{
 		Entity: "terms",
 		Action: "read",
@@ -77,10 +99,18 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "suggestions",
 		Action: "read",
 	}},
+	"/looprpc.SwapClient/GetBudgetStatus": {{
+		Entity: "suggestions",
+		Action: "read",
+	}},
 	"/looprpc.SwapClient/GetInfo": {{
 		Entity: "suggestions",
 		Action: "read",
 	}},
+	"/looprpc.SwapClient/Health": {{
+		Entity: "swap",
+		Action: "read",
+	}},
 	"/looprpc.SwapClient/GetLiquidityParams": {{
 		Entity: "suggestions",
 		Action: "read",
@@ -113,3 +143,42 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Action: "read",
 	}},
 }
+
+// RequiredLndPermissions is the list of lnd macaroon permissions that loopd
+// needs for the lnd RPC methods it calls. A custom lnd macaroon must grant all
+// of them.
+var RequiredLndPermissions = []bakery.Op{
+	// GetInfo, GetChanInfo and GetVersion.
+	{Entity: "info", Action: "read"},
+
+	// ListChannels, ClosedChannels, ListPayments, TrackPayment and the
+	// channel event subscription.
+	{Entity: "offchain", Action: "read"},
+
+	// Paying the swap invoices.
+	{Entity: "offchain", Action: "write"},
+
+	// The chain notifier, ListTransactions, ListSweeps and fee estimates.
+	{Entity: "onchain", Action: "read"},
+
+	// Publishing htlc and sweep transactions and funding psbts.
+	{Entity: "onchain", Action: "write"},
+
+	// Deriving the swap keys.
+	{Entity: "address", Action: "read"},
+
+	// Creating sweep destination addresses.
+	{Entity: "address", Action: "write"},
+
+	// Looking up and subscribing to the swap invoices.
+	{Entity: "invoices", Action: "read"},
+
+	// Creating, settling and canceling the swap invoices.
+	{Entity: "invoices", Action: "write"},
+
+	// Verifying signatures.
+	{Entity: "signer", Action: "read"},
+
+	// Signing htlc spends and creating MuSig2 signatures.
+	{Entity: "signer", Action: "generate"},
+}
@@ -0,0 +1,125 @@
+package loopd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightninglabs/loop/looprpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// swapEventsPath is the REST path that streams swap updates as
+	// server-sent events.
+	swapEventsPath = "/v1/loop/swaps/events"
+
+	// macaroonHeader is the header in which REST clients pass their
+	// macaroon. The REST proxy forwards it to the gRPC server as the
+	// macaroon metadata field.
+	macaroonHeader = "Grpc-Metadata-Macaroon"
+)
+
+// registerSwapEvents adds the swap event stream to the REST proxy's mux. The
+// events are read from the Monitor RPC of the gRPC server at the given
+// endpoint, so the stream requires the same permissions as Monitor. The
+// connection to the endpoint is closed when the context is canceled.
+func registerSwapEvents(ctx context.Context, mux *proxy.ServeMux,
+	endpoint string, opts []grpc.DialOption,
+	marshaler proxy.Marshaler) error {
+
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			log.Errorf("Failed to close swap events connection: %v",
+				err)
+		}
+	}()
+
+	return mux.HandlePath(
+		http.MethodGet, swapEventsPath, swapEventsHandler(
+			looprpc.NewSwapClientClient(conn), marshaler,
+		),
+	)
+}
+
+// swapEventsHandler returns a handler that sends each swap update of the
+// Monitor RPC as a server-sent event. As the RPC only fails once the first
+// update is received, errors are sent as events of type error rather than as
+// an HTTP status.
+func swapEventsHandler(client looprpc.SwapClientClient,
+	marshaler proxy.Marshaler) proxy.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request,
+		_ map[string]string) {
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(
+				w, "streaming not supported",
+				http.StatusInternalServerError,
+			)
+
+			return
+		}
+
+		ctx := r.Context()
+		if mac := r.Header.Get(macaroonHeader); mac != "" {
+			ctx = metadata.AppendToOutgoingContext(
+				ctx, "macaroon", mac,
+			)
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		stream, err := client.Monitor(ctx, &looprpc.MonitorRequest{})
+		if err != nil {
+			writeErrorEvent(w, flusher, err)
+			return
+		}
+
+		for {
+			swp, err := stream.Recv()
+			if err != nil {
+				// The stream ends when the client goes away,
+				// there is no one left to notify then.
+				if ctx.Err() == nil {
+					writeErrorEvent(w, flusher, err)
+				}
+
+				return
+			}
+
+			data, err := marshaler.Marshal(swp)
+			if err != nil {
+				writeErrorEvent(w, flusher, err)
+				return
+			}
+
+			_, err = fmt.Fprintf(w, "event: swap\ndata: %s\n\n", data)
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// writeErrorEvent sends the error's message as an event of type error.
+func writeErrorEvent(w http.ResponseWriter, flusher http.Flusher, err error) {
+	_, _ = fmt.Fprintf(
+		w, "event: error\ndata: %s\n\n", status.Convert(err).Message(),
+	)
+	flusher.Flush()
+}
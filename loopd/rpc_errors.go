@@ -0,0 +1,27 @@
+package loopd
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop"
+	"google.golang.org/grpc"
+)
+
+// errorUnaryInterceptor converts the errors of unary calls into status errors
+// that carry the error code of the loop error taxonomy.
+func errorUnaryInterceptor(ctx context.Context, req interface{},
+	_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{},
+	error) {
+
+	resp, err := handler(ctx, req)
+
+	return resp, loop.ToRPCError(err)
+}
+
+// errorStreamInterceptor converts the errors of streaming calls into status
+// errors that carry the error code of the loop error taxonomy.
+func errorStreamInterceptor(srv interface{}, ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	return loop.ToRPCError(handler(srv, ss))
+}
@@ -247,6 +247,14 @@ func Run(rpcCfg RPCConfig) error {
 		return view(&config, lisCfg)
 	}
 
+	if parser.Active.Name == "exportswaps" {
+		return exportSwaps(&config, lisCfg)
+	}
+
+	if parser.Active.Name == "importswaps" {
+		return importSwaps(&config, lisCfg)
+	}
+
 	return fmt.Errorf("unimplemented command %v", parser.Active.Name)
 }
 
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	looprpc "github.com/lightninglabs/loop/swapserverrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -83,6 +85,8 @@ type swapClientServer struct {
 	lnd                *lndclient.LndServices
 	reservationManager *reservation.Manager
 	instantOutManager  *instantout.Manager
+	metrics            *swapMetrics
+	webhooks           *webhookNotifier
 	swaps              map[lntypes.Hash]loop.SwapInfo
 	subscribers        map[int]chan<- interface{}
 	statusChan         chan loop.SwapInfo
@@ -112,13 +116,23 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 
 	case in.Dest != "":
 		// Decode the client provided destination address for the loop
-		// out sweep.
-		sweepAddr, err = btcutil.DecodeAddress(
+		// out sweep. It may be given as a BIP21 URI, whose amount is
+		// used as the swap amount.
+		var uriAmt btcutil.Amount
+		sweepAddr, uriAmt, err = loop.ParseDestination(
 			in.Dest, s.lnd.ChainParams,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("decode address: %v", err)
+			return nil, err
+		}
+
+		amt, err := loop.DestinationAmount(
+			btcutil.Amount(in.Amt), uriAmt,
+		)
+		if err != nil {
+			return nil, err
 		}
+		in.Amt = int64(amt)
 
 		isExternalAddr = true
 
@@ -152,7 +166,7 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		// Generate sweep address if none specified.
 		sweepAddr, err = s.lnd.WalletKit.NextAddr(
 			context.Background(), "",
-			walletrpc.AddressType_WITNESS_PUBKEY_HASH, false,
+			destAddrType(s.config.DestAddrType), false,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("NextAddr error: %v", err)
@@ -184,6 +198,9 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		SwapPublicationDeadline: publicationDeadline,
 		Label:                   in.Label,
 		Initiator:               in.Initiator,
+		SweepFeeRate: chainfee.SatPerKVByte(
+			in.SweepSatPerVbyte * 1000,
+		).FeePerKWeight(),
 	}
 
 	switch {
@@ -198,6 +215,11 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		req.OutgoingChanSet = in.OutgoingChanSet
 	}
 
+	req.OutgoingPeers, err = parseOutgoingPeers(in.OutgoingPeers)
+	if err != nil {
+		return nil, err
+	}
+
 	info, err := s.impl.LoopOut(ctx, req)
 	if err != nil {
 		log.Errorf("LoopOut: %v", err)
@@ -253,8 +275,10 @@ func toWalletAddrType(addrType clientrpc.AddressType) (walletrpc.AddressType,
 	}
 }
 
-func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
-	*clientrpc.SwapStatus, error) {
+// marshallSwapState converts a swap state into its rpc state and failure
+// reason.
+func marshallSwapState(swapState loopdb.SwapState) (clientrpc.SwapState,
+	clientrpc.FailureReason, error) {
 
 	var (
 		state         clientrpc.SwapState
@@ -266,7 +290,7 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 	// previous versions where we squashed all failure reasons to a single
 	// failure state, we set a failure reason for all our different failure
 	// states, and set our failed state for all of them.
-	switch loopSwap.State {
+	switch swapState {
 	case loopdb.StateInitiated:
 		state = clientrpc.SwapState_INITIATED
 
@@ -309,8 +333,13 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 	case loopdb.StateFailIncorrectHtlcAmtSwept:
 		failureReason = clientrpc.FailureReason_FAILURE_REASON_INCORRECT_HTLC_AMT_SWEPT
 
+	// An expired invoice couldn't be paid off-chain, so we report it as an
+	// off-chain failure.
+	case loopdb.StateFailInvoiceExpired:
+		failureReason = clientrpc.FailureReason_FAILURE_REASON_OFFCHAIN
+
 	default:
-		return nil, fmt.Errorf("unknown swap state: %v", loopSwap.State)
+		return 0, 0, fmt.Errorf("unknown swap state: %v", swapState)
 	}
 
 	// If we have a failure reason, we have a failure state, so should use
@@ -319,6 +348,17 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 		state = clientrpc.SwapState_FAILED
 	}
 
+	return state, failureReason, nil
+}
+
+func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
+	*clientrpc.SwapStatus, error) {
+
+	state, failureReason, err := marshallSwapState(loopSwap.State)
+	if err != nil {
+		return nil, err
+	}
+
 	var swapType clientrpc.SwapType
 	var (
 		htlcAddress      string
@@ -361,6 +401,11 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 		return nil, errors.New("unknown swap type")
 	}
 
+	var htlcTxid string
+	if loopSwap.HtlcTxHash != nil {
+		htlcTxid = loopSwap.HtlcTxHash.String()
+	}
+
 	return &clientrpc.SwapStatus{
 		Amt:              int64(loopSwap.AmountRequested),
 		Id:               loopSwap.SwapHash.String(),
@@ -379,9 +424,39 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 		Label:            loopSwap.Label,
 		LastHop:          lastHop,
 		OutgoingChanSet:  outGoingChanSet,
+		HtlcTxid:         htlcTxid,
 	}, nil
 }
 
+// marshallSwapWithSweep marshalls the swap like marshallSwap and adds the
+// txid of the confirmed sweep of a successful loop out, which is looked up in
+// the database.
+func (s *swapClientServer) marshallSwapWithSweep(ctx context.Context,
+	loopSwap *loop.SwapInfo) (*clientrpc.SwapStatus, error) {
+
+	rpcSwap, err := s.marshallSwap(loopSwap)
+	if err != nil {
+		return nil, err
+	}
+
+	if loopSwap.SwapType != swap.TypeOut ||
+		loopSwap.State != loopdb.StateSuccess {
+
+		return rpcSwap, nil
+	}
+
+	sweepTxid, err := s.impl.SweepTxid(ctx, loopSwap.SwapHash)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sweep txid: %v", err)
+	}
+
+	if sweepTxid != nil {
+		rpcSwap.SweepTxid = sweepTxid.String()
+	}
+
+	return rpcSwap, nil
+}
+
 // Monitor will return a stream of swap updates for currently active swaps.
 func (s *swapClientServer) Monitor(in *clientrpc.MonitorRequest,
 	server clientrpc.SwapClient_MonitorServer) error {
@@ -484,9 +559,17 @@ func (s *swapClientServer) Monitor(in *clientrpc.MonitorRequest,
 
 // ListSwaps returns a list of all currently known swaps and their current
 // status.
-func (s *swapClientServer) ListSwaps(_ context.Context,
+func (s *swapClientServer) ListSwaps(ctx context.Context,
 	req *clientrpc.ListSwapsRequest) (*clientrpc.ListSwapsResponse, error) {
 
+	filter := req.ListSwapFilter
+	if req.IndexOffset != 0 || req.MaxSwaps != 0 ||
+		(filter != nil && (filter.InitiatedAfter != 0 ||
+			filter.InitiatedBefore != 0)) {
+
+		return s.listStoredSwaps(ctx, req)
+	}
+
 	var (
 		rpcSwaps = []*clientrpc.SwapStatus{}
 		idx      = 0
@@ -507,7 +590,7 @@ func (s *swapClientServer) ListSwaps(_ context.Context,
 			continue
 		}
 
-		rpcSwap, err := s.marshallSwap(&swp)
+		rpcSwap, err := s.marshallSwapWithSweep(ctx, &swp)
 		if err != nil {
 			return nil, err
 		}
@@ -517,6 +600,77 @@ func (s *swapClientServer) ListSwaps(_ context.Context,
 	return &clientrpc.ListSwapsResponse{Swaps: rpcSwaps}, nil
 }
 
+// listStoredSwaps returns a page of the swaps in the database that match the
+// request. The filter and the paging are applied by the database, which can't
+// filter on the outgoing channel set, the label or the last hop.
+func (s *swapClientServer) listStoredSwaps(ctx context.Context,
+	req *clientrpc.ListSwapsRequest) (*clientrpc.ListSwapsResponse, error) {
+
+	if req.IndexOffset > math.MaxInt32 || req.MaxSwaps > math.MaxInt32 {
+		return nil, errors.New("index offset and max swaps must fit " +
+			"into 32 bits")
+	}
+
+	filter := &loop.ListSwapsFilter{
+		Offset: int(req.IndexOffset),
+		Limit:  int(req.MaxSwaps),
+	}
+
+	if rpcFilter := req.ListSwapFilter; rpcFilter != nil {
+		if len(rpcFilter.OutgoingChanSet) != 0 ||
+			len(rpcFilter.LoopInLastHop) != 0 ||
+			rpcFilter.Label != "" {
+
+			return nil, errors.New("outgoing channel set, label " +
+				"and last hop filters can't be combined with " +
+				"paging or a time range")
+		}
+
+		switch rpcFilter.SwapType {
+		case clientrpc.ListSwapsFilter_LOOP_OUT:
+			swapType := swap.TypeOut
+			filter.SwapType = &swapType
+
+		case clientrpc.ListSwapsFilter_LOOP_IN:
+			swapType := swap.TypeIn
+			filter.SwapType = &swapType
+		}
+
+		if rpcFilter.PendingOnly {
+			pending := loopdb.StateTypePending
+			filter.StateType = &pending
+		}
+
+		if rpcFilter.InitiatedAfter != 0 {
+			filter.InitiatedAfter = time.Unix(
+				rpcFilter.InitiatedAfter, 0,
+			)
+		}
+
+		if rpcFilter.InitiatedBefore != 0 {
+			filter.InitiatedBefore = time.Unix(
+				rpcFilter.InitiatedBefore, 0,
+			)
+		}
+	}
+
+	swaps, err := s.impl.ListSwaps(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error listing swaps: %v", err)
+	}
+
+	rpcSwaps := make([]*clientrpc.SwapStatus, 0, len(swaps))
+	for _, swapInfo := range swaps {
+		rpcSwap, err := s.marshallSwapWithSweep(ctx, swapInfo)
+		if err != nil {
+			return nil, err
+		}
+		rpcSwaps = append(rpcSwaps, rpcSwap)
+	}
+
+	return &clientrpc.ListSwapsResponse{Swaps: rpcSwaps}, nil
+}
+
 // filterSwap filters the given swap based on the provided filter.
 func filterSwap(swapInfo *loop.SwapInfo, filter *clientrpc.ListSwapsFilter) bool {
 	if filter == nil {
@@ -589,7 +743,7 @@ func filterSwap(swapInfo *loop.SwapInfo, filter *clientrpc.ListSwapsFilter) bool
 }
 
 // SwapInfo returns all known details about a single swap.
-func (s *swapClientServer) SwapInfo(_ context.Context,
+func (s *swapClientServer) SwapInfo(ctx context.Context,
 	req *clientrpc.SwapInfoRequest) (*clientrpc.SwapStatus, error) {
 
 	swapHash, err := lntypes.MakeHash(req.Id)
@@ -599,11 +753,115 @@ func (s *swapClientServer) SwapInfo(_ context.Context,
 
 	// Just return the server's in-memory cache here too as we also want to
 	// return temporary failures to the client.
+	s.swapsLock.Lock()
 	swp, ok := s.swaps[swapHash]
+	s.swapsLock.Unlock()
 	if !ok {
 		return nil, fmt.Errorf("swap with hash %s not found", req.Id)
 	}
-	return s.marshallSwap(&swp)
+	return s.marshallSwapWithSweep(ctx, &swp)
+}
+
+// GetSwapHistory returns the state transitions of a single swap and the time
+// that the swap spent in each state.
+func (s *swapClientServer) GetSwapHistory(ctx context.Context,
+	req *clientrpc.SwapHistoryRequest) (*clientrpc.SwapHistoryResponse,
+	error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+	}
+
+	history, err := s.impl.GetSwapHistory(ctx, swapHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting swap history: %v", err)
+	}
+
+	transitions := make([]*clientrpc.SwapTransition, 0, len(history))
+	for _, transition := range history {
+		state, failureReason, err := marshallSwapState(
+			transition.State,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var htlcTxid string
+		if transition.HtlcTxHash != nil {
+			htlcTxid = transition.HtlcTxHash.String()
+		}
+
+		transitions = append(transitions, &clientrpc.SwapTransition{
+			State:         state,
+			FailureReason: failureReason,
+			TimeNs:        transition.Time.UnixNano(),
+			DurationNs:    int64(transition.Duration),
+			CostServer:    int64(transition.Cost.Server),
+			CostOnchain:   int64(transition.Cost.Onchain),
+			CostOffchain:  int64(transition.Cost.Offchain),
+			HtlcTxid:      htlcTxid,
+		})
+	}
+
+	return &clientrpc.SwapHistoryResponse{
+		Transitions: transitions,
+	}, nil
+}
+
+// GetAccountingReport aggregates the volume and the fees of the completed
+// swaps by the requested period.
+func (s *swapClientServer) GetAccountingReport(ctx context.Context,
+	req *clientrpc.AccountingReportRequest) (
+	*clientrpc.AccountingReportResponse, error) {
+
+	var period loop.ReportPeriod
+	switch req.Period {
+	case clientrpc.ReportPeriod_REPORT_PERIOD_DAY:
+		period = loop.ReportPeriodDay
+
+	case clientrpc.ReportPeriod_REPORT_PERIOD_WEEK:
+		period = loop.ReportPeriodWeek
+
+	case clientrpc.ReportPeriod_REPORT_PERIOD_MONTH:
+		period = loop.ReportPeriodMonth
+
+	default:
+		return nil, fmt.Errorf("unknown report period: %v", req.Period)
+	}
+
+	var start, end time.Time
+	if req.StartTime != 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime != 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+
+	report, err := s.impl.AccountingReport(ctx, period, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("error creating accounting report: %v",
+			err)
+	}
+
+	periods := make([]*clientrpc.AccountingPeriod, 0, len(report))
+	for _, aggregate := range report {
+		periods = append(periods, &clientrpc.AccountingPeriod{
+			StartTime:        aggregate.Start.Unix(),
+			SuccessCount:     uint32(aggregate.SuccessCount),
+			FailureCount:     uint32(aggregate.FailureCount),
+			LoopOutVolumeSat: int64(aggregate.LoopOutVolume),
+			LoopInVolumeSat:  int64(aggregate.LoopInVolume),
+			SwapFeesSat:      int64(aggregate.SwapFees),
+			MinerFeesSat:     int64(aggregate.MinerFees),
+			RoutingFeesSat:   int64(aggregate.RoutingFees),
+			TotalFeesSat:     int64(aggregate.TotalFees()),
+		})
+	}
+
+	return &clientrpc.AccountingReportResponse{
+		Periods: periods,
+	}, nil
 }
 
 // AbandonSwap requests the server to abandon a swap with the given hash.
@@ -628,11 +886,6 @@ func (s *swapClientServer) AbandonSwap(ctx context.Context,
 		return nil, fmt.Errorf("swap with hash %s not found", req.Id)
 	}
 
-	if swap.SwapType.IsOut() {
-		return nil, fmt.Errorf("abandoning loop out swaps is not " +
-			"supported yet")
-	}
-
 	// If the swap is in a final state, we cannot abandon it.
 	if swap.State.IsFinal() {
 		return nil, fmt.Errorf("cannot abandon swap in final state, "+
@@ -649,6 +902,51 @@ func (s *swapClientServer) AbandonSwap(ctx context.Context,
 	return &clientrpc.AbandonSwapResponse{}, nil
 }
 
+// BumpSweepFee raises the fee rate of the pending sweep of the loop out with
+// the given hash and republishes it.
+func (s *swapClientServer) BumpSweepFee(ctx context.Context,
+	req *clientrpc.BumpSweepFeeRequest) (*clientrpc.BumpSweepFeeResponse,
+	error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+	}
+
+	if req.SatPerVbyte == 0 {
+		return nil, fmt.Errorf("sat_per_vbyte must be set")
+	}
+
+	err = s.impl.BumpSweepFee(ctx, swapHash, req.SatPerVbyte)
+	if err != nil {
+		return nil, fmt.Errorf("error bumping sweep fee: %v", err)
+	}
+
+	return &clientrpc.BumpSweepFeeResponse{}, nil
+}
+
+// UpdateSwap changes the sweep confirmation target of the pending loop out
+// with the given hash.
+func (s *swapClientServer) UpdateSwap(ctx context.Context,
+	req *clientrpc.UpdateSwapRequest) (*clientrpc.UpdateSwapResponse,
+	error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+	}
+
+	err = s.impl.UpdateSwap(ctx, &loop.UpdateSwapRequest{
+		SwapHash:        swapHash,
+		SweepConfTarget: req.SweepConfTarget,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error updating swap: %v", err)
+	}
+
+	return &clientrpc.UpdateSwapResponse{}, nil
+}
+
 // LoopOutTerms returns the terms that the server enforces for loop out swaps.
 func (s *swapClientServer) LoopOutTerms(ctx context.Context,
 	_ *clientrpc.TermsRequest) (*clientrpc.OutTermsResponse, error) {
@@ -690,6 +988,9 @@ func (s *swapClientServer) LoopOutQuote(ctx context.Context,
 		SweepConfTarget:         confTarget,
 		SwapPublicationDeadline: publicactionDeadline,
 		Initiator:               defaultLoopdInitiator,
+		SweepFeeRate: chainfee.SatPerKVByte(
+			req.SweepSatPerVbyte * 1000,
+		).FeePerKWeight(),
 	})
 	if err != nil {
 		return nil, err
@@ -1035,6 +1336,31 @@ func (s *swapClientServer) GetInfo(ctx context.Context,
 	}, nil
 }
 
+// Health reports whether the subsystems that swaps depend on are healthy.
+func (s *swapClientServer) Health(ctx context.Context,
+	_ *clientrpc.HealthRequest) (*clientrpc.HealthResponse, error) {
+
+	health := s.impl.Health(ctx)
+
+	return &clientrpc.HealthResponse{
+		Healthy:       health.Healthy(),
+		LndError:      healthError(health.Lnd),
+		ServerError:   healthError(health.Server),
+		DatabaseError: healthError(health.Database),
+		ExecutorError: healthError(health.Executor),
+	}, nil
+}
+
+// healthError returns the message of a subsystem's health error, or an empty
+// string if the subsystem is healthy.
+func healthError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
 // GetLiquidityParams gets our current liquidity manager's parameters.
 func (s *swapClientServer) GetLiquidityParams(_ context.Context,
 	_ *clientrpc.GetLiquidityParamsRequest) (*clientrpc.LiquidityParameters,
@@ -1151,6 +1477,26 @@ func (s *swapClientServer) SuggestSwaps(ctx context.Context,
 	return resp, nil
 }
 
+// GetBudgetStatus returns how much of the autoloop fee budget is spent or
+// reserved for in flight swaps in the current budget period.
+func (s *swapClientServer) GetBudgetStatus(ctx context.Context,
+	_ *clientrpc.GetBudgetStatusRequest) (
+	*clientrpc.GetBudgetStatusResponse, error) {
+
+	budget, err := s.liquidityMgr.BudgetStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.GetBudgetStatusResponse{
+		BudgetSat:    uint64(budget.Budget),
+		SpentSat:     uint64(budget.Spent),
+		PendingSat:   uint64(budget.Pending),
+		RemainingSat: uint64(budget.Remaining),
+		NextRefresh:  uint64(budget.NextRefresh.Unix()),
+	}, nil
+}
+
 // ListReservations lists all existing reservations the client has ever made.
 func (s *swapClientServer) ListReservations(ctx context.Context,
 	_ *clientrpc.ListReservationsRequest) (
@@ -1316,6 +1662,10 @@ func rpcAutoloopReason(reason liquidity.Reason) (clientrpc.AutoReason, error) {
 	case liquidity.ReasonFeePPMInsufficient:
 		return clientrpc.AutoReason_AUTO_REASON_SWAP_FEE, nil
 
+	// The rpc has no reason for exclusions yet.
+	case liquidity.ReasonExcluded:
+		return clientrpc.AutoReason_AUTO_REASON_UNKNOWN, nil
+
 	default:
 		return 0, fmt.Errorf("unknown autoloop reason: %v", reason)
 	}
@@ -1334,6 +1684,14 @@ func (s *swapClientServer) processStatusUpdates(mainCtx context.Context) {
 			s.swapsLock.Lock()
 			s.swaps[swp.SwapHash] = swp
 
+			if s.metrics != nil {
+				s.metrics.update(swp)
+			}
+
+			if s.webhooks != nil {
+				s.webhooks.notify(&swp)
+			}
+
 			for _, subscriber := range s.subscribers {
 				select {
 				case subscriber <- swp:
@@ -1426,12 +1784,23 @@ func validateLoopOutRequest(ctx context.Context, lnd lndclient.LightningClient,
 		return 0, err
 	}
 
-	unlimitedChannels := len(req.OutgoingChanSet) == 0
+	outgoingPeers, err := parseOutgoingPeers(req.OutgoingPeers)
+	if err != nil {
+		return 0, err
+	}
+
+	unlimitedChannels := len(req.OutgoingChanSet) == 0 &&
+		len(outgoingPeers) == 0
 	outgoingChanSetMap := make(map[uint64]bool)
 	for _, chanID := range req.OutgoingChanSet {
 		outgoingChanSetMap[chanID] = true
 	}
 
+	outgoingPeersMap := make(map[route.Vertex]bool)
+	for _, peer := range outgoingPeers {
+		outgoingPeersMap[peer] = true
+	}
+
 	var activeChannelSet []lndclient.ChannelInfo
 	for _, c := range channels {
 		// Don't bother looking at inactive channels.
@@ -1439,10 +1808,13 @@ func validateLoopOutRequest(ctx context.Context, lnd lndclient.LightningClient,
 			continue
 		}
 
-		// If no outgoing channel set was specified then all active
-		// channels are considered. However, if a channel set was
-		// specified then only the specified channels are considered.
-		if unlimitedChannels || outgoingChanSetMap[c.ChannelID] {
+		// If no outgoing channel set or peers were specified then all
+		// active channels are considered. However, if a channel set
+		// or peers were specified then only the specified channels
+		// and the channels with the specified peers are considered.
+		if unlimitedChannels || outgoingChanSetMap[c.ChannelID] ||
+			outgoingPeersMap[c.PubKeyBytes] {
+
 			activeChannelSet = append(activeChannelSet, c)
 		}
 	}
@@ -1467,6 +1839,22 @@ func validateLoopOutRequest(ctx context.Context, lnd lndclient.LightningClient,
 	)
 }
 
+// parseOutgoingPeers parses the public keys of the outgoing peers of a loop
+// out request.
+func parseOutgoingPeers(peers [][]byte) ([]route.Vertex, error) {
+	vertices := make([]route.Vertex, 0, len(peers))
+	for _, peer := range peers {
+		vertex, err := route.NewVertexFromBytes(peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid outgoing peer: %w", err)
+		}
+
+		vertices = append(vertices, vertex)
+	}
+
+	return vertices, nil
+}
+
 // hasBandwidth simulates the MPP splitting logic that will be used by LND when
 // attempting to route the payment. This function is used to evaluate if a
 // payment will be routable given the splitting logic used by LND.
@@ -217,6 +217,7 @@ func TestValidateLoopOutRequest(t *testing.T) {
 		label           string
 		channels        []lndclient.ChannelInfo
 		outgoingChanSet []uint64
+		outgoingPeers   [][]byte
 		amount          int64
 		maxRoutingFee   int64
 		maxParts        uint32
@@ -400,6 +401,40 @@ func TestValidateLoopOutRequest(t *testing.T) {
 			err:            errBalanceTooLow,
 			expectedTarget: 0,
 		},
+		{
+			name:       "outgoing peers balance sufficient",
+			chain:      chaincfg.MainNetParams,
+			destAddr:   mainnetAddr,
+			label:      "label ok",
+			confTarget: 2,
+			channels: []lndclient.ChannelInfo{
+				channel1, channel2, channel3,
+			},
+			outgoingPeers: [][]byte{
+				peer2[:],
+			},
+			amount:         20000,
+			maxParts:       5,
+			err:            nil,
+			expectedTarget: 2,
+		},
+		{
+			name:       "outgoing peers balance not sufficient",
+			chain:      chaincfg.MainNetParams,
+			destAddr:   mainnetAddr,
+			label:      "label ok",
+			confTarget: 2,
+			channels: []lndclient.ChannelInfo{
+				channel1, channel2, channel3,
+			},
+			outgoingPeers: [][]byte{
+				peer1[:],
+			},
+			amount:         1000,
+			maxParts:       5,
+			err:            errBalanceTooLow,
+			expectedTarget: 0,
+		},
 		{
 			name:       "amount with routing fee too high",
 			chain:      chaincfg.MainNetParams,
@@ -466,6 +501,7 @@ func TestValidateLoopOutRequest(t *testing.T) {
 				Amt:               test.amount,
 				MaxSwapRoutingFee: test.maxRoutingFee,
 				OutgoingChanSet:   test.outgoingChanSet,
+				OutgoingPeers:     test.outgoingPeers,
 				Label:             test.label,
 				SweepConfTarget:   test.confTarget,
 			}
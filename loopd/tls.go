@@ -0,0 +1,130 @@
+package loopd
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/cert"
+)
+
+// tlsRenewalInterval is the interval at which the TLS certificate is checked
+// for renewal while the daemon is running.
+const tlsRenewalInterval = time.Hour
+
+// tlsCertRenewer serves the TLS certificate of the daemon and renews it while
+// the daemon is running, so that a long running daemon doesn't end up serving
+// an expired certificate.
+type tlsCertRenewer struct {
+	cfg *Config
+
+	reloader *cert.TLSReloader
+
+	// parsedCert is the certificate that is currently served. It is
+	// guarded by the mutex.
+	parsedCert *x509.Certificate
+
+	sync.Mutex
+}
+
+// newTLSCertRenewer returns a renewer that serves the certificate that is
+// stored at the configured path.
+func newTLSCertRenewer(cfg *Config,
+	parsedCert *x509.Certificate) (*tlsCertRenewer, error) {
+
+	certBytes, keyBytes, err := cert.GetCertBytesFromPath(
+		cfg.TLSCertPath, cfg.TLSKeyPath,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	reloader, err := cert.NewTLSReloader(certBytes, keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tlsCertRenewer{
+		cfg:        cfg,
+		reloader:   reloader,
+		parsedCert: parsedCert,
+	}, nil
+}
+
+// renewIfNeeded regenerates the certificate if it is due for renewal. New
+// connections are served with the new certificate, existing connections are
+// kept. It returns whether the certificate was renewed.
+func (r *tlsCertRenewer) renewIfNeeded(now time.Time) (bool, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	refresh, err := tlsNeedsRefresh(r.cfg, r.parsedCert, now)
+	if err != nil || !refresh {
+		return false, err
+	}
+
+	log.Info("TLS certificate is expiring or outdated, generating a new " +
+		"one")
+
+	_, parsedCert, err := regenerateCert(r.cfg)
+	if err != nil {
+		return false, err
+	}
+
+	certBytes, keyBytes, err := cert.GetCertBytesFromPath(
+		r.cfg.TLSCertPath, r.cfg.TLSKeyPath,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.reloader.AttemptReload(certBytes, keyBytes); err != nil {
+		return false, err
+	}
+
+	r.parsedCert = parsedCert
+
+	return true, nil
+}
+
+// verifyPeerCert checks that a server presents the certificate that is
+// currently served. The REST proxy connects to the gRPC server of the same
+// daemon with it, so that the connection keeps working once the certificate
+// was renewed.
+func (r *tlsCertRenewer) verifyPeerCert(rawCerts [][]byte,
+	_ [][]*x509.Certificate) error {
+
+	r.Lock()
+	defer r.Unlock()
+
+	if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], r.parsedCert.Raw) {
+		return errors.New("server presented an unknown TLS certificate")
+	}
+
+	return nil
+}
+
+// run checks the certificate for renewal until the context is canceled. A
+// failed renewal is retried at the next check, the current certificate is
+// served in the meantime.
+func (r *tlsCertRenewer) run(ctx context.Context) {
+	ticker := time.NewTicker(tlsRenewalInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_, err := r.renewIfNeeded(time.Now())
+			if err != nil {
+				log.Errorf("Unable to renew TLS certificate: %v",
+					err)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package loopd
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTLSCertRenewer tests that a certificate that is due for renewal is
+// regenerated and served while the daemon is running, and that the REST proxy
+// only accepts the certificate that is currently served.
+func TestTLSCertRenewer(t *testing.T) {
+	log = btclog.Disabled
+
+	dir := t.TempDir()
+	cfg := &Config{
+		TLSCertPath: filepath.Join(dir, "tls.cert"),
+		TLSKeyPath:  filepath.Join(dir, "tls.key"),
+		TLSValidity: 100 * 24 * time.Hour,
+	}
+
+	tlsCfg, _, renewer, err := getTLSConfig(cfg)
+	require.NoError(t, err)
+
+	served, err := tlsCfg.GetCertificate(nil)
+	require.NoError(t, err)
+	oldCert := served.Certificate[0]
+	require.NoError(t, renewer.verifyPeerCert([][]byte{oldCert}, nil))
+
+	// A fresh certificate isn't renewed.
+	renewed, err := renewer.renewIfNeeded(time.Now())
+	require.NoError(t, err)
+	require.False(t, renewed)
+
+	// Close to its expiry, the certificate is renewed and the new one is
+	// served.
+	renewed, err = renewer.renewIfNeeded(time.Now().Add(95 * 24 * time.Hour))
+	require.NoError(t, err)
+	require.True(t, renewed)
+
+	served, err = tlsCfg.GetCertificate(nil)
+	require.NoError(t, err)
+	newCert := served.Certificate[0]
+	require.NotEqual(t, oldCert, newCert)
+
+	require.NoError(t, renewer.verifyPeerCert([][]byte{newCert}, nil))
+	require.Error(t, renewer.verifyPeerCert([][]byte{oldCert}, nil))
+	require.Error(t, renewer.verifyPeerCert(nil, nil))
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweepbatcher"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/ticker"
 )
 
@@ -22,16 +24,41 @@ func getClient(cfg *Config, swapDb loopdb.SwapStore,
 	*loop.Client, func(), error) {
 
 	clientConfig := &loop.ClientConfig{
-		ServerAddress:       cfg.Server.Host,
-		ProxyAddress:        cfg.Server.Proxy,
-		SwapServerNoTLS:     cfg.Server.NoTLS,
-		TLSPathServer:       cfg.Server.TLSPath,
-		Lnd:                 lnd,
-		MaxLsatCost:         btcutil.Amount(cfg.MaxLSATCost),
-		MaxLsatFee:          btcutil.Amount(cfg.MaxLSATFee),
-		LoopOutMaxParts:     cfg.LoopOutMaxParts,
-		TotalPaymentTimeout: cfg.TotalPaymentTimeout,
-		MaxPaymentRetries:   cfg.MaxPaymentRetries,
+		ServerAddress:           cfg.Server.Host,
+		FallbackServerAddresses: cfg.Server.FallbackHosts,
+		ProxyAddress:            cfg.Server.Proxy,
+		SwapServerNoTLS:         cfg.Server.NoTLS,
+		TLSPathServer:           cfg.Server.TLSPath,
+		TLSFingerprintServer:    cfg.Server.TLSFingerprint,
+		Lnd:                     lnd,
+		MaxLsatCost:             btcutil.Amount(cfg.MaxLSATCost),
+		MaxLsatFee:              btcutil.Amount(cfg.MaxLSATFee),
+		LoopOutMaxParts:         cfg.LoopOutMaxParts,
+		TotalPaymentTimeout:     cfg.TotalPaymentTimeout,
+		MaxPaymentRetries:       cfg.MaxPaymentRetries,
+		RepushDelay:             cfg.RepushDelay,
+		StatusBufferSize:        cfg.StatusBufferSize,
+		ResumeRetries:           cfg.ResumeRetries,
+		TermsHistorySize:        cfg.TermsHistorySize,
+		MaxSweepFeeBumps:        cfg.MaxSweepFeeBumps,
+		SweepBatchWindow:        cfg.SweepBatchWindow,
+		MaxOutstandingValue:     btcutil.Amount(cfg.MaxOutstandingValue),
+		MaxChainSubscriptions:   cfg.MaxChainSubscriptions,
+		MaxSweepFeeRate: chainfee.SatPerKWeight(
+			cfg.MaxSweepFeeRate,
+		),
+		SweepStaticFeeRate: chainfee.SatPerKWeight(
+			cfg.SweepStaticFeeRate,
+		),
+		SweepFeeFunction: sweepFeeFunction(cfg.SweepFeeFunction),
+		DestAddrType:     destAddrType(cfg.DestAddrType),
+	}
+
+	if cfg.FiatCurrency != "" {
+		clientConfig.PriceSource = loop.NewHTTPPriceSource(
+			cfg.FiatPriceURL,
+		)
+		clientConfig.FiatCurrency = cfg.FiatCurrency
 	}
 
 	swapClient, cleanUp, err := loop.NewClient(
@@ -44,13 +71,47 @@ func getClient(cfg *Config, swapDb loopdb.SwapStore,
 	return swapClient, cleanUp, nil
 }
 
-func openDatabase(cfg *Config, chainParams *chaincfg.Params) (loopdb.SwapStore,
-	*loopdb.BaseDB, error) { //nolint:unparam
+// sweepFeeFunction returns the sweep fee function with the given config name.
+// The step function is the batcher's default, so it maps to nil.
+func sweepFeeFunction(name string) sweepbatcher.FeeFunction {
+	switch name {
+	case "linear":
+		return sweepbatcher.LinearFeeFunction{}
+
+	case "exponential":
+		return sweepbatcher.ExponentialFeeFunction{}
+
+	default:
+		return nil
+	}
+}
+
+// destAddrType returns the wallet address type with the given config name.
+// Loop outs sweep to p2wkh addresses by default.
+func destAddrType(name string) walletrpc.AddressType {
+	switch name {
+	case "np2wkh":
+		return walletrpc.AddressType_NESTED_WITNESS_PUBKEY_HASH
+
+	case "p2tr":
+		return walletrpc.AddressType_TAPROOT_PUBKEY
+
+	default:
+		return walletrpc.AddressType_WITNESS_PUBKEY_HASH
+	}
+}
+
+// openDatabase opens the configured swap database. If database encryption is
+// configured, the database encrypts the swap secrets with the key that is
+// derived from the key parameters stored in it.
+func openDatabase(ctx context.Context, cfg *Config,
+	chainParams *chaincfg.Params, lnd *lndclient.LndServices) (
+	loopdb.SwapStore, *loopdb.BaseDB, error) { //nolint:unparam
 
 	var (
 		db     loopdb.SwapStore
 		err    error
-		baseDb loopdb.BaseDB
+		baseDb *loopdb.BaseDB
 	)
 	switch cfg.DatabaseBackend {
 	case DatabaseBackendSqlite:
@@ -61,7 +122,7 @@ func openDatabase(cfg *Config, chainParams *chaincfg.Params) (loopdb.SwapStore,
 		if err != nil {
 			return nil, nil, err
 		}
-		baseDb = *db.(*loopdb.SqliteSwapStore).BaseDB
+		baseDb = db.(*loopdb.SqliteSwapStore).BaseDB
 
 	case DatabaseBackendPostgres:
 		log.Infof("Opening postgres database at: %v",
@@ -71,17 +132,39 @@ func openDatabase(cfg *Config, chainParams *chaincfg.Params) (loopdb.SwapStore,
 		if err != nil {
 			return nil, nil, err
 		}
-		baseDb = *db.(*loopdb.PostgresStore).BaseDB
+		baseDb = db.(*loopdb.PostgresStore).BaseDB
 
 	default:
 		return nil, nil, fmt.Errorf("unknown database backend: %s",
 			cfg.DatabaseBackend)
 	}
 
-	return db, &baseDb, nil
+	err = setupSecretEncryption(ctx, cfg, lnd, baseDb)
+	if err != nil {
+		db.Close()
+
+		return nil, nil, fmt.Errorf("unable to set up database "+
+			"encryption: %w", err)
+	}
+
+	// Preimages that were stored before encryption was enabled are
+	// encrypted now, so that none is left in plain text.
+	if baseDb.SecretCipher() != nil {
+		err := baseDb.EncryptStoredSecrets(ctx)
+		if err != nil {
+			db.Close()
+
+			return nil, nil, fmt.Errorf("unable to encrypt stored "+
+				"swap secrets: %v", err)
+		}
+	}
+
+	return db, baseDb, nil
 }
 
-func getLiquidityManager(client *loop.Client) *liquidity.Manager {
+func getLiquidityManager(cfg *Config,
+	client *loop.Client) *liquidity.Manager {
+
 	mngrCfg := &liquidity.Config{
 		AutoloopTicker: ticker.NewForce(liquidity.DefaultAutoloopTicker),
 		LoopOut:        client.LoopOut,
@@ -90,7 +173,7 @@ func getLiquidityManager(client *loop.Client) *liquidity.Manager {
 			initiator string) (*liquidity.Restrictions, error) {
 
 			if swapType == swap.TypeOut {
-				outTerms, err := client.Server.GetLoopOutTerms(ctx, initiator)
+				outTerms, err := client.LoopOutTerms(ctx, initiator)
 				if err != nil {
 					return nil, err
 				}
@@ -121,6 +204,7 @@ func getLiquidityManager(client *loop.Client) *liquidity.Manager {
 		MinimumConfirmations: minConfTarget,
 		PutLiquidityParams:   client.Store.PutLiquidityParams,
 		FetchLiquidityParams: client.Store.FetchLiquidityParams,
+		DestAddrType:         destAddrType(cfg.DestAddrType),
 	}
 
 	return liquidity.NewManager(mngrCfg)
@@ -27,7 +27,9 @@ func view(config *Config, lisCfg *ListenerCfg) error {
 		return err
 	}
 
-	swapDb, baseDb, err := openDatabase(config, chainParams)
+	swapDb, baseDb, err := openDatabase(
+		context.Background(), config, chainParams, &lnd.LndServices,
+	)
 	if err != nil {
 		return err
 	}
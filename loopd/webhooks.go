@@ -0,0 +1,231 @@
+package loopd
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/loop"
+)
+
+const (
+	// webhookSignatureHeader is the header that holds the hex encoded
+	// HMAC-SHA256 signature of the webhook payload.
+	webhookSignatureHeader = "X-Loop-Signature"
+
+	// webhookQueueSize is the number of notifications that are queued for
+	// an endpoint. Further notifications are dropped until the endpoint
+	// catches up.
+	webhookQueueSize = 100
+
+	// webhookMaxAttempts is the number of times that the delivery of a
+	// notification is attempted.
+	webhookMaxAttempts = 5
+
+	// webhookInitialBackoff is the delay before the first retry of a
+	// delivery. It is doubled for every further retry.
+	webhookInitialBackoff = time.Second
+
+	// webhookTimeout is the timeout of a single delivery attempt.
+	webhookTimeout = 10 * time.Second
+)
+
+// webhookPayload is the json payload that is posted to the webhook endpoints
+// when a swap changes its state.
+type webhookPayload struct {
+	SwapHash     string `json:"swap_hash"`
+	Type         string `json:"type"`
+	State        string `json:"state"`
+	Label        string `json:"label,omitempty"`
+	Amount       int64  `json:"amount_sat"`
+	HtlcAddress  string `json:"htlc_address,omitempty"`
+	CostServer   int64  `json:"cost_server_sat"`
+	CostOnchain  int64  `json:"cost_onchain_sat"`
+	CostOffchain int64  `json:"cost_offchain_sat"`
+	LastUpdate   int64  `json:"last_update_unix"`
+}
+
+// newWebhookPayload creates the webhook payload of a swap update.
+func newWebhookPayload(swp *loop.SwapInfo) *webhookPayload {
+	payload := &webhookPayload{
+		SwapHash:     swp.SwapHash.String(),
+		Type:         strings.ToLower(swp.SwapType.String()),
+		State:        swp.State.String(),
+		Label:        swp.Label,
+		Amount:       int64(swp.AmountRequested),
+		CostServer:   int64(swp.Cost.Server),
+		CostOnchain:  int64(swp.Cost.Onchain),
+		CostOffchain: int64(swp.Cost.Offchain),
+		LastUpdate:   swp.LastUpdate.Unix(),
+	}
+
+	switch {
+	case swp.HtlcAddressP2TR != nil:
+		payload.HtlcAddress = swp.HtlcAddressP2TR.EncodeAddress()
+
+	case swp.HtlcAddressP2WSH != nil:
+		payload.HtlcAddress = swp.HtlcAddressP2WSH.EncodeAddress()
+	}
+
+	return payload
+}
+
+// webhookNotifier posts signed swap updates to webhook endpoints. Every
+// endpoint is served by its own goroutine, so that the updates reach an
+// endpoint in order and a slow endpoint doesn't delay the others.
+type webhookNotifier struct {
+	log            btclog.Logger
+	secret         []byte
+	client         *http.Client
+	initialBackoff time.Duration
+	queues         map[string]chan []byte
+}
+
+// newWebhookNotifier creates a notifier for the given endpoints that signs
+// its payloads with the given secret and logs delivery failures to the given
+// logger.
+func newWebhookNotifier(urls []string, secret string,
+	logger btclog.Logger) *webhookNotifier {
+
+	n := &webhookNotifier{
+		log:            logger,
+		secret:         []byte(secret),
+		client:         &http.Client{Timeout: webhookTimeout},
+		initialBackoff: webhookInitialBackoff,
+		queues:         make(map[string]chan []byte, len(urls)),
+	}
+
+	for _, url := range urls {
+		n.queues[url] = make(chan []byte, webhookQueueSize)
+	}
+
+	return n
+}
+
+// run delivers the queued notifications until the context is canceled.
+//
+// NOTE: This must run inside a goroutine as it blocks until the context is
+// canceled.
+func (n *webhookNotifier) run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for url, queue := range n.queues {
+		url, queue := url, queue
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case body := <-queue:
+					n.deliver(ctx, url, body)
+
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// notify queues a swap update for delivery to all endpoints. It never blocks,
+// if the queue of an endpoint is full the update is dropped for it.
+func (n *webhookNotifier) notify(swp *loop.SwapInfo) {
+	body, err := json.Marshal(newWebhookPayload(swp))
+	if err != nil {
+		n.log.Errorf("Unable to encode webhook payload: %v", err)
+		return
+	}
+
+	for url, queue := range n.queues {
+		select {
+		case queue <- body:
+		default:
+			n.log.Warnf("Webhook queue of %v full, dropping update "+
+				"of swap %v", url, swp.SwapHash)
+		}
+	}
+}
+
+// deliver posts a payload to an endpoint, retrying with an exponential
+// backoff until it is accepted or the attempts are exhausted.
+func (n *webhookNotifier) deliver(ctx context.Context, url string,
+	body []byte) {
+
+	backoff := n.initialBackoff
+	for attempt := 1; ; attempt++ {
+		err := n.post(ctx, url, body)
+		if err == nil {
+			return
+		}
+
+		if attempt == webhookMaxAttempts {
+			n.log.Errorf("Giving up webhook delivery to %v after %v "+
+				"attempts: %v", url, attempt, err)
+
+			return
+		}
+
+		n.log.Warnf("Webhook delivery to %v failed, retrying in %v: %v",
+			url, backoff, err)
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// post makes a single delivery attempt. Any response other than a 2xx status
+// code is treated as a failure.
+func (n *webhookNotifier) post(ctx context.Context, url string,
+	body []byte) error {
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookSignatureHeader, signWebhookPayload(
+		n.secret, body,
+	))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %v", resp.Status)
+	}
+
+	return nil
+}
+
+// signWebhookPayload returns the hex encoded HMAC-SHA256 signature of a
+// webhook payload, which endpoints use to verify that the payload was sent by
+// loopd.
+func signWebhookPayload(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
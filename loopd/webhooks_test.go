@@ -0,0 +1,98 @@
+package loopd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWebhookNotifier tests that swap updates are posted signed to webhook
+// endpoints and that failed deliveries are retried.
+func TestWebhookNotifier(t *testing.T) {
+	const secret = "secret"
+
+	type delivery struct {
+		body      []byte
+		signature string
+	}
+
+	var (
+		attempts   int
+		deliveries = make(chan delivery, 1)
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			// Fail the first attempt, so that the delivery is
+			// retried.
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			deliveries <- delivery{
+				body:      body,
+				signature: r.Header.Get(webhookSignatureHeader),
+			}
+		},
+	))
+	defer server.Close()
+
+	notifier := newWebhookNotifier(
+		[]string{server.URL}, secret, btclog.Disabled,
+	)
+	notifier.initialBackoff = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		notifier.run(ctx)
+		close(done)
+	}()
+
+	swp := loop.SwapInfo{
+		SwapHash: lntypes.Hash{1},
+		SwapType: swap.TypeOut,
+	}
+	swp.State = loopdb.StateSuccess
+	swp.AmountRequested = 100_000
+	swp.Cost.Onchain = 300
+
+	notifier.notify(&swp)
+
+	select {
+	case d := <-deliveries:
+		require.Equal(
+			t, signWebhookPayload([]byte(secret), d.body),
+			d.signature,
+		)
+
+		var payload webhookPayload
+		require.NoError(t, json.Unmarshal(d.body, &payload))
+		require.Equal(t, swp.SwapHash.String(), payload.SwapHash)
+		require.Equal(t, "out", payload.Type)
+		require.Equal(t, loopdb.StateSuccess.String(), payload.State)
+		require.EqualValues(t, 100_000, payload.Amount)
+		require.EqualValues(t, 300, payload.CostOnchain)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not delivered")
+	}
+
+	require.Equal(t, 2, attempts)
+
+	cancel()
+	<-done
+}
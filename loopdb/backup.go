@@ -0,0 +1,222 @@
+package loopdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// ErrStoreNotEmpty is returned when swaps are imported into a store that
+// already holds swaps.
+var ErrStoreNotEmpty = errors.New("swap store not empty")
+
+// BackupCipher returns the cipher that the secrets of a backup are decrypted
+// with, given the parameters of the key that the backup was encrypted with.
+// The parameters are nil if the backup doesn't hold any.
+type BackupCipher func(params *KeyParams) (*SecretCipher, error)
+
+// ExportSwaps writes all swaps of the store, including the pending ones, and
+// the liquidity parameters to a new sqlite database at the given path. The
+// network must be the one of the store, as the addresses of the swaps are
+// encoded for it. If secrets is set, the preimages in the backup are
+// encrypted with it, so that the backup doesn't leak them, and the parameters
+// of its key are stored in the backup. The backup is checked to hold the
+// exact same swaps as the store, and a failed export leaves no file at the
+// path.
+func ExportSwaps(ctx context.Context, store SwapStore, path string,
+	network *chaincfg.Params, secrets *SecretCipher,
+	params *KeyParams) error {
+
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("backup file %v already exists", path)
+	}
+
+	if secrets != nil && params == nil {
+		return errors.New("key parameters required to export " +
+			"encrypted swaps")
+	}
+
+	loopOuts, err := store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, loopOut := range loopOuts {
+		if loopOut.Contract.DestAddr == nil {
+			return fmt.Errorf("swap %v has no destination address",
+				loopOut.Hash)
+		}
+
+		if !loopOut.Contract.DestAddr.IsForNet(network) {
+			return fmt.Errorf("destination of swap %v is not a %v "+
+				"address", loopOut.Hash, network.Name)
+		}
+	}
+
+	// The backup is written to a temporary file that is only renamed to
+	// the backup file once it is complete, so that a failed export
+	// doesn't leave a partial backup that blocks the next attempt.
+	tempFile, err := os.CreateTemp(
+		filepath.Dir(path), filepath.Base(path)+".tmp-*",
+	)
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+	defer removeSqliteFiles(tempPath)
+
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+
+	err = writeBackup(ctx, store, tempPath, network, secrets, params)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, path)
+}
+
+// writeBackup writes the swaps of the store to a new sqlite database at the
+// given path. The database is closed when it returns.
+func writeBackup(ctx context.Context, store SwapStore, path string,
+	network *chaincfg.Params, secrets *SecretCipher,
+	params *KeyParams) error {
+
+	backup, err := NewSqliteStore(
+		&SqliteConfig{DatabaseFileName: path}, network,
+	)
+	if err != nil {
+		return err
+	}
+	defer backup.Close()
+
+	backup.EnableSecretEncryption(secrets)
+
+	if secrets != nil {
+		err := backup.PutSecretKeyParams(ctx, params)
+		if err != nil {
+			return err
+		}
+	}
+
+	return NewMigratorManager(store, backup).RunMigrations(ctx)
+}
+
+// removeSqliteFiles removes a sqlite database and its journal files, if they
+// exist.
+func removeSqliteFiles(path string) {
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		_ = os.Remove(path + suffix)
+	}
+}
+
+// ImportSwaps restores the swaps and liquidity parameters of a backup that
+// was written by ExportSwaps into the store. The backup must have been
+// exported on the same network, and the store must not hold any swaps yet.
+// The secrets of the backup are decrypted with the cipher that backupCipher
+// returns for the key parameters in the backup and are encrypted again by the
+// store. Pending swaps are resumed once the client starts with the store,
+// which requires the lnd node that the swaps were initiated with. The backup
+// is read from a temporary copy, as opening it migrates its schema, so that
+// the backup itself is left unchanged.
+func ImportSwaps(ctx context.Context, store SwapStore, path string,
+	network *chaincfg.Params, backupCipher BackupCipher) error {
+
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("unable to open backup: %v", err)
+	}
+
+	loopOuts, err := store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return err
+	}
+
+	loopIns, err := store.FetchLoopInSwaps(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(loopOuts) > 0 || len(loopIns) > 0 {
+		return ErrStoreNotEmpty
+	}
+
+	tempDir, err := os.MkdirTemp("", "loop-import-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempDir)
+
+	tempPath := filepath.Join(tempDir, filepath.Base(path))
+	if err := copyFile(path, tempPath); err != nil {
+		return fmt.Errorf("unable to copy backup: %v", err)
+	}
+
+	backup, err := NewSqliteStore(
+		&SqliteConfig{DatabaseFileName: tempPath}, network,
+	)
+	if err != nil {
+		return err
+	}
+	defer backup.Close()
+
+	if backupCipher != nil {
+		params, err := backup.FetchSecretKeyParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		secrets, err := backupCipher(params)
+		if err != nil {
+			return fmt.Errorf("unable to derive backup key: %w",
+				err)
+		}
+
+		// A wrong key is reported before any swap is restored.
+		if secrets != nil {
+			err := backup.CheckSecretKey(ctx, secrets)
+			if err != nil {
+				return fmt.Errorf("unable to decrypt backup: "+
+					"%w", err)
+			}
+		}
+		backup.EnableSecretEncryption(secrets)
+	}
+
+	// Reading the swaps fails on a backup of another network, as their
+	// addresses can't be decoded, so we check them before any swap is
+	// written to the store.
+	_, err = backup.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to read backup of %v: %w",
+			network.Name, err)
+	}
+
+	return NewMigratorManager(backup, store).RunMigrations(ctx)
+}
+
+// copyFile copies the file at src to a new file at dst.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
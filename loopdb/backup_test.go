@@ -0,0 +1,198 @@
+package loopdb
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// failingLiquidityStore is a swap store whose liquidity parameters can't be
+// read.
+type failingLiquidityStore struct {
+	SwapStore
+}
+
+// FetchLiquidityParams always fails.
+func (f *failingLiquidityStore) FetchLiquidityParams(context.Context) ([]byte,
+	error) {
+
+	return nil, errors.New("liquidity params unavailable")
+}
+
+// TestExportImportSwaps tests that pending swaps are exported and imported
+// with their preimages, client ids, metadata and costs, and that the backup
+// is encrypted with the cipher of the database and carries the parameters of
+// its key.
+func TestExportImportSwaps(t *testing.T) {
+	ctx := context.Background()
+	store := NewTestDB(t)
+
+	password := []byte("password")
+	passwordCipher := func(t *testing.T, params *KeyParams) *SecretCipher {
+		key, err := PasswordKey(password, params)
+		require.NoError(t, err)
+
+		secrets, err := NewSecretCipher(key)
+		require.NoError(t, err)
+
+		return secrets
+	}
+
+	salt, err := NewPasswordSalt()
+	require.NoError(t, err)
+	params := NewPasswordKeyParams(salt)
+	secrets := passwordCipher(t, params)
+	store.EnableSecretEncryption(secrets)
+
+	preimage := lntypes.Preimage{1}
+	hash := preimage.Hash()
+	require.NoError(t, store.CreateLoopOut(ctx, hash, &LoopOutContract{
+		SwapContract: SwapContract{
+			AmountRequested: 100,
+			Preimage:        preimage,
+			CltvExpiry:      144,
+			HtlcKeys: HtlcKeys{
+				SenderScriptKey:        senderKey,
+				ReceiverScriptKey:      receiverKey,
+				SenderInternalPubKey:   senderInternalKey,
+				ReceiverInternalPubKey: receiverInternalKey,
+			},
+			InitiationTime:  time.Unix(1000, 0),
+			ProtocolVersion: ProtocolVersionMuSig2,
+			Metadata:        []byte(`{"order":7}`),
+		},
+		DestAddr:          test.GetDestAddr(t, 0),
+		ClientID:          "order-7",
+		SwapInvoice:       "swapinvoice",
+		PrepayInvoice:     "prepayinvoice",
+		SweepConfTarget:   2,
+		HtlcConfirmations: 2,
+
+		// The deadline must be valid, as faulty deadlines of older
+		// versions are fixed up whenever a store is opened.
+		SwapPublicationDeadline: time.Unix(1700000000, 0),
+	}))
+
+	// The costs and the time of the last update are restored with the
+	// events, so that the fiat value of the costs can be annotated again.
+	revealed := SwapStateData{
+		State: StatePreimageRevealed,
+		Cost: SwapCost{
+			Server:   10,
+			Offchain: 2,
+		},
+	}
+	require.NoError(t, store.UpdateLoopOut(
+		ctx, hash, time.Unix(2000, 0), revealed,
+	))
+
+	original, err := store.FetchLoopOutSwap(ctx, hash)
+	require.NoError(t, err)
+
+	// The addresses of the swaps are encoded for the network of the
+	// store, so the backup must be exported on the same network.
+	dir := t.TempDir()
+	err = ExportSwaps(
+		ctx, store, filepath.Join(dir, "testnet.db"),
+		&chaincfg.TestNet3Params, secrets, params,
+	)
+	require.ErrorContains(t, err, "is not a testnet3 address")
+
+	// The parameters of the key are required to export encrypted swaps.
+	path := filepath.Join(dir, "backup.db")
+	network := &chaincfg.MainNetParams
+	require.Error(t, ExportSwaps(ctx, store, path, network, secrets, nil))
+
+	// A failed export leaves no partial backup behind, so that it can be
+	// retried.
+	failing := &failingLiquidityStore{SwapStore: store}
+	require.Error(t, ExportSwaps(
+		ctx, failing, path, network, secrets, params,
+	))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	require.NoError(t, ExportSwaps(
+		ctx, store, path, network, secrets, params,
+	))
+
+	// An existing backup isn't overwritten.
+	require.Error(t, ExportSwaps(
+		ctx, store, path, network, secrets, params,
+	))
+
+	// Importing reads a copy of the backup, so the backup itself isn't
+	// migrated or otherwise changed.
+	exported, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// The backup can't be imported without its key or on another network.
+	restored := NewTestDB(t)
+	err = ImportSwaps(ctx, restored, path, network, nil)
+	require.ErrorIs(t, err, ErrSecretKeyRequired)
+
+	// The backup is decrypted with a key derived from the parameters in
+	// the backup, so it is imported into a database whose key was derived
+	// with another salt.
+	var backupParams *KeyParams
+	backupCipher := func(params *KeyParams) (*SecretCipher, error) {
+		backupParams = params
+		return passwordCipher(t, params), nil
+	}
+
+	err = ImportSwaps(
+		ctx, restored, path, &chaincfg.TestNet3Params, backupCipher,
+	)
+	require.ErrorContains(t, err, "unable to read backup")
+
+	otherSalt, err := NewPasswordSalt()
+	require.NoError(t, err)
+	restoredSecrets := passwordCipher(t, NewPasswordKeyParams(otherSalt))
+	restored.EnableSecretEncryption(restoredSecrets)
+
+	// A backup key that doesn't match the key check of the backup is
+	// rejected before any swap is restored.
+	wrongCipher := func(*KeyParams) (*SecretCipher, error) {
+		return restoredSecrets, nil
+	}
+	err = ImportSwaps(ctx, restored, path, network, wrongCipher)
+	require.ErrorIs(t, err, ErrWrongSecretKey)
+
+	require.NoError(t, ImportSwaps(
+		ctx, restored, path, network, backupCipher,
+	))
+	require.Equal(t, params, backupParams)
+
+	imported, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, exported, imported)
+
+	swap, err := restored.FetchLoopOutSwapByClientID(ctx, "order-7")
+	require.NoError(t, err)
+	require.Equal(t, original, swap)
+	require.Equal(t, preimage, swap.Contract.Preimage)
+	require.Equal(t, []byte(`{"order":7}`), swap.Contract.Metadata)
+	require.Equal(t, revealed.Cost, swap.State().Cost)
+	require.Equal(t, StatePreimageRevealed, swap.State().State)
+
+	// The preimage was encrypted again with the key of the restored
+	// database.
+	restored.EnableSecretEncryption(secrets)
+	_, err = restored.FetchLoopOutSwap(ctx, hash)
+	require.ErrorIs(t, err, ErrWrongSecretKey)
+	restored.EnableSecretEncryption(restoredSecrets)
+
+	// Swaps are only imported into an empty store.
+	err = ImportSwaps(ctx, restored, path, network, backupCipher)
+	require.ErrorIs(t, err, ErrStoreNotEmpty)
+}
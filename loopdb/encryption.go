@@ -0,0 +1,228 @@
+package loopdb
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// SecretKeySize is the size of the key that swap secrets are
+	// encrypted with.
+	SecretKeySize = chacha20poly1305.KeySize
+
+	// passwordSaltSize is the size of the salt that password keys are
+	// derived with.
+	passwordSaltSize = 16
+
+	// The scrypt parameters of password keys.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+
+	// keyCheckPlaintext is the known plaintext that the key check of the
+	// swap secrets is encrypted from.
+	keyCheckPlaintext = "loop swap secret key check"
+)
+
+var (
+	// ErrSecretKeyRequired is returned when an encrypted swap secret is
+	// read from a database that was opened without a key.
+	ErrSecretKeyRequired = errors.New("swap secrets are encrypted, " +
+		"database key required")
+
+	// ErrWrongSecretKey is returned when a swap secret can't be decrypted
+	// with the key that the database was opened with.
+	ErrWrongSecretKey = errors.New("unable to decrypt swap secret, " +
+		"wrong database key")
+
+	// ErrPlaintextSecret is returned when a swap secret is read in plain
+	// text from a database whose stored secrets were all encrypted. Such
+	// a secret wasn't written by the store.
+	ErrPlaintextSecret = errors.New("swap secret stored in plain text " +
+		"in an encrypted database")
+)
+
+// KeyScheme is the source that the key of the swap secrets is derived from.
+type KeyScheme string
+
+const (
+	// KeySchemeLnd derives the key from lnd's wallet.
+	KeySchemeLnd KeyScheme = "lnd"
+
+	// KeySchemePassword derives the key from a password with scrypt.
+	KeySchemePassword KeyScheme = "password"
+)
+
+// KeyParams are the parameters that the key of the swap secrets is derived
+// with. They are stored in backups, so that the secrets of a backup can be
+// decrypted on another machine.
+type KeyParams struct {
+	// Scheme is the source that the key is derived from.
+	Scheme KeyScheme
+
+	// Salt is the salt of a password key.
+	Salt []byte
+
+	// ScryptN, ScryptR and ScryptP are the scrypt costs of a password
+	// key.
+	ScryptN, ScryptR, ScryptP int
+}
+
+// NewPasswordKeyParams returns the parameters of a password key with the
+// given salt and the default scrypt costs.
+func NewPasswordKeyParams(salt []byte) *KeyParams {
+	return &KeyParams{
+		Scheme:  KeySchemePassword,
+		Salt:    salt,
+		ScryptN: scryptN,
+		ScryptR: scryptR,
+		ScryptP: scryptP,
+	}
+}
+
+// SecretCipher encrypts the swap secrets that are stored in the database, so
+// that a copy of the database doesn't leak them.
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher creates a cipher that encrypts swap secrets with the given
+// key.
+func NewSecretCipher(key [SecretKeySize]byte) (*SecretCipher, error) {
+	aead, err := chacha20poly1305.NewX(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretCipher{
+		aead: aead,
+	}, nil
+}
+
+// NewPasswordSalt returns a random salt to derive a password key with.
+func NewPasswordSalt() ([]byte, error) {
+	salt := make([]byte, passwordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return salt, nil
+}
+
+// PasswordKey derives the key that swap secrets are encrypted with from a
+// password. The parameters must be stored alongside the database, the same key
+// is only derived again with the same salt and scrypt costs.
+func PasswordKey(password []byte, params *KeyParams) ([SecretKeySize]byte,
+	error) {
+
+	var key [SecretKeySize]byte
+
+	if params.Scheme != KeySchemePassword {
+		return key, fmt.Errorf("not a password key: %v", params.Scheme)
+	}
+
+	if len(password) == 0 {
+		return key, errors.New("empty database password")
+	}
+
+	derived, err := scrypt.Key(
+		password, params.Salt, params.ScryptN, params.ScryptR,
+		params.ScryptP, SecretKeySize,
+	)
+	if err != nil {
+		return key, err
+	}
+	copy(key[:], derived)
+
+	return key, nil
+}
+
+// encrypt encrypts a secret. The random nonce is prepended to the
+// ciphertext.
+func (c *SecretCipher) encrypt(secret []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize(),
+		c.aead.NonceSize()+len(secret)+c.aead.Overhead())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return c.aead.Seal(nonce, nonce, secret, nil), nil
+}
+
+// decrypt decrypts a secret that was encrypted with encrypt.
+func (c *SecretCipher) decrypt(ciphertext []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(ciphertext) < nonceSize+c.aead.Overhead() {
+		return nil, fmt.Errorf("encrypted secret too short: %v bytes",
+			len(ciphertext))
+	}
+
+	secret, err := c.aead.Open(
+		nil, ciphertext[:nonceSize], ciphertext[nonceSize:], nil,
+	)
+	if err != nil {
+		return nil, ErrWrongSecretKey
+	}
+
+	return secret, nil
+}
+
+// keyCheck returns a new key check, the known plaintext encrypted with the
+// cipher.
+func (c *SecretCipher) keyCheck() ([]byte, error) {
+	return c.encrypt([]byte(keyCheckPlaintext))
+}
+
+// verifyKeyCheck returns ErrWrongSecretKey if the key check wasn't encrypted
+// with the key of the cipher.
+func (c *SecretCipher) verifyKeyCheck(check []byte) error {
+	plaintext, err := c.decrypt(check)
+	if err != nil {
+		return ErrWrongSecretKey
+	}
+
+	if string(plaintext) != keyCheckPlaintext {
+		return ErrWrongSecretKey
+	}
+
+	return nil
+}
+
+// sealPreimage returns the preimage in the form that it is stored in. Without
+// a cipher, the preimage is stored in plain text.
+func (c *SecretCipher) sealPreimage(preimage lntypes.Preimage) ([]byte,
+	error) {
+
+	if c == nil {
+		return preimage[:], nil
+	}
+
+	return c.encrypt(preimage[:])
+}
+
+// openPreimage reads a stored preimage. Preimages that were stored before
+// encryption was enabled are still read in plain text, which is told apart
+// by their size, as an encrypted preimage is longer.
+func (c *SecretCipher) openPreimage(stored []byte) (lntypes.Preimage, error) {
+	if len(stored) == lntypes.PreimageSize {
+		return lntypes.MakePreimage(stored)
+	}
+
+	if c == nil {
+		return lntypes.Preimage{}, ErrSecretKeyRequired
+	}
+
+	secret, err := c.decrypt(stored)
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+
+	return lntypes.MakePreimage(secret)
+}
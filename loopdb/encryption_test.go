@@ -0,0 +1,288 @@
+package loopdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSecretEncryption tests that the preimages of swaps are encrypted in the
+// database once encryption is enabled, and that preimages stored before stay
+// readable.
+func TestSecretEncryption(t *testing.T) {
+	ctx := context.Background()
+	store := NewTestDB(t)
+
+	htlcKeys := HtlcKeys{
+		SenderScriptKey:        senderKey,
+		ReceiverScriptKey:      receiverKey,
+		SenderInternalPubKey:   senderInternalKey,
+		ReceiverInternalPubKey: receiverInternalKey,
+	}
+
+	newSwap := func(preimage lntypes.Preimage) *LoopOutContract {
+		return &LoopOutContract{
+			SwapContract: SwapContract{
+				AmountRequested: 100,
+				Preimage:        preimage,
+				CltvExpiry:      144,
+				HtlcKeys:        htlcKeys,
+				InitiationTime:  time.Unix(1000, 0),
+				ProtocolVersion: ProtocolVersionMuSig2,
+			},
+			DestAddr:          test.GetDestAddr(t, 0),
+			SwapInvoice:       "swapinvoice",
+			PrepayInvoice:     "prepayinvoice",
+			SweepConfTarget:   2,
+			HtlcConfirmations: 2,
+		}
+	}
+
+	// A swap that was stored before encryption was enabled.
+	plainPreimage := lntypes.Preimage{1}
+	plainHash := plainPreimage.Hash()
+	require.NoError(t, store.CreateLoopOut(
+		ctx, plainHash, newSwap(plainPreimage),
+	))
+
+	secrets, err := NewSecretCipher([SecretKeySize]byte{1, 2, 3})
+	require.NoError(t, err)
+	store.EnableSecretEncryption(secrets)
+
+	encryptedPreimage := lntypes.Preimage{2}
+	encryptedHash := encryptedPreimage.Hash()
+	require.NoError(t, store.CreateLoopOut(
+		ctx, encryptedHash, newSwap(encryptedPreimage),
+	))
+
+	// The preimage isn't stored in plain text.
+	row, err := store.Queries.GetLoopOutSwap(ctx, encryptedHash[:])
+	require.NoError(t, err)
+	require.NotContains(t, string(row.Preimage),
+		string(encryptedPreimage[:]))
+
+	// Both swaps are read with their preimages.
+	swap, err := store.FetchLoopOutSwap(ctx, plainHash)
+	require.NoError(t, err)
+	require.Equal(t, plainPreimage, swap.Contract.Preimage)
+
+	swap, err = store.FetchLoopOutSwap(ctx, encryptedHash)
+	require.NoError(t, err)
+	require.Equal(t, encryptedPreimage, swap.Contract.Preimage)
+
+	// The encrypted preimage can't be read without the right key.
+	wrongSecrets, err := NewSecretCipher([SecretKeySize]byte{4, 5, 6})
+	require.NoError(t, err)
+	store.EnableSecretEncryption(wrongSecrets)
+
+	_, err = store.FetchLoopOutSwap(ctx, encryptedHash)
+	require.ErrorIs(t, err, ErrWrongSecretKey)
+
+	store.EnableSecretEncryption(nil)
+
+	_, err = store.FetchLoopOutSwap(ctx, encryptedHash)
+	require.ErrorIs(t, err, ErrSecretKeyRequired)
+}
+
+// TestEncryptStoredSecrets tests that the preimages that were stored in plain
+// text are encrypted once encryption is enabled, and that they stay readable.
+func TestEncryptStoredSecrets(t *testing.T) {
+	ctx := context.Background()
+	store := NewTestDB(t)
+
+	htlcKeys := HtlcKeys{
+		SenderScriptKey:        senderKey,
+		ReceiverScriptKey:      receiverKey,
+		SenderInternalPubKey:   senderInternalKey,
+		ReceiverInternalPubKey: receiverInternalKey,
+	}
+
+	// The swaps are stored before encryption is enabled.
+	preimages := []lntypes.Preimage{{1}, {2}, {3}}
+	for _, preimage := range preimages {
+		require.NoError(t, store.CreateLoopOut(
+			ctx, preimage.Hash(), &LoopOutContract{
+				SwapContract: SwapContract{
+					AmountRequested: 100,
+					Preimage:        preimage,
+					CltvExpiry:      144,
+					HtlcKeys:        htlcKeys,
+					InitiationTime:  time.Unix(1000, 0),
+					ProtocolVersion: ProtocolVersionMuSig2,
+				},
+				DestAddr:          test.GetDestAddr(t, 0),
+				SwapInvoice:       "swapinvoice",
+				PrepayInvoice:     "prepayinvoice",
+				SweepConfTarget:   2,
+				HtlcConfirmations: 2,
+			},
+		))
+	}
+
+	// Without a cipher, the stored preimages can't be encrypted.
+	require.Error(t, store.EncryptStoredSecrets(ctx))
+
+	secrets, err := NewSecretCipher([SecretKeySize]byte{1, 2, 3})
+	require.NoError(t, err)
+	store.EnableSecretEncryption(secrets)
+	require.NoError(t, store.EncryptStoredSecrets(ctx))
+
+	// No preimage is left in plain text.
+	rows, err := store.Queries.GetSwapPreimages(ctx)
+	require.NoError(t, err)
+	require.Len(t, rows, len(preimages))
+
+	stored := make(map[string][]byte, len(rows))
+	for _, row := range rows {
+		require.NotEqual(t, lntypes.PreimageSize, len(row.Preimage))
+		stored[string(row.SwapHash)] = row.Preimage
+	}
+
+	// Encrypting again leaves the encrypted preimages as they are.
+	require.NoError(t, store.EncryptStoredSecrets(ctx))
+
+	rows, err = store.Queries.GetSwapPreimages(ctx)
+	require.NoError(t, err)
+	for _, row := range rows {
+		require.Equal(t, stored[string(row.SwapHash)], row.Preimage)
+	}
+
+	// The swaps are read with their preimages, but not without the key.
+	for _, preimage := range preimages {
+		swap, err := store.FetchLoopOutSwap(ctx, preimage.Hash())
+		require.NoError(t, err)
+		require.Equal(t, preimage, swap.Contract.Preimage)
+	}
+
+	// Once the key parameters are stored, a preimage that is written in
+	// plain text afterwards is rejected.
+	require.NoError(t, store.PutSecretKeyParams(
+		ctx, &KeyParams{Scheme: KeySchemeLnd},
+	))
+	require.NoError(t, store.EncryptStoredSecrets(ctx))
+
+	tampered := preimages[1].Hash()
+	require.NoError(t, store.Queries.UpdateSwapPreimage(
+		ctx, sqlc.UpdateSwapPreimageParams{
+			SwapHash: tampered[:],
+			Preimage: preimages[1][:],
+		},
+	))
+
+	_, err = store.FetchLoopOutSwap(ctx, tampered)
+	require.ErrorIs(t, err, ErrPlaintextSecret)
+
+	store.EnableSecretEncryption(nil)
+
+	_, err = store.FetchLoopOutSwap(ctx, preimages[0].Hash())
+	require.ErrorIs(t, err, ErrSecretKeyRequired)
+}
+
+// TestCheckSecretKey tests that a key is checked against the stored key check,
+// or against an encrypted preimage if no key check is stored.
+func TestCheckSecretKey(t *testing.T) {
+	ctx := context.Background()
+	store := NewTestDB(t)
+
+	secrets, err := NewSecretCipher([SecretKeySize]byte{1, 2, 3})
+	require.NoError(t, err)
+
+	wrongSecrets, err := NewSecretCipher([SecretKeySize]byte{4, 5, 6})
+	require.NoError(t, err)
+
+	// Any key matches a store without encrypted secrets.
+	require.NoError(t, store.CheckSecretKey(ctx, wrongSecrets))
+
+	preimage := lntypes.Preimage{1}
+	require.NoError(t, store.CreateLoopOut(
+		ctx, preimage.Hash(), &LoopOutContract{
+			SwapContract: SwapContract{
+				AmountRequested: 100,
+				Preimage:        preimage,
+				CltvExpiry:      144,
+				HtlcKeys: HtlcKeys{
+					SenderScriptKey:        senderKey,
+					ReceiverScriptKey:      receiverKey,
+					SenderInternalPubKey:   senderInternalKey,
+					ReceiverInternalPubKey: receiverInternalKey,
+				},
+				InitiationTime:  time.Unix(1000, 0),
+				ProtocolVersion: ProtocolVersionMuSig2,
+			},
+			DestAddr:    test.GetDestAddr(t, 0),
+			SwapInvoice: "swapinvoice",
+		},
+	))
+
+	// Without a key check, the key is checked against the encrypted
+	// preimage.
+	store.EnableSecretEncryption(secrets)
+	require.NoError(t, store.EncryptStoredSecrets(ctx))
+
+	require.NoError(t, store.CheckSecretKey(ctx, secrets))
+	err = store.CheckSecretKey(ctx, wrongSecrets)
+	require.ErrorIs(t, err, ErrWrongSecretKey)
+
+	// The key check is stored with the key parameters, which requires
+	// the key.
+	params := &KeyParams{
+		Scheme: KeySchemeLnd,
+	}
+	store.EnableSecretEncryption(nil)
+	require.Error(t, store.PutSecretKeyParams(ctx, params))
+
+	store.EnableSecretEncryption(secrets)
+	require.NoError(t, store.PutSecretKeyParams(ctx, params))
+
+	stored, err := store.FetchSecretKeyParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, stored)
+
+	require.NoError(t, store.CheckSecretKey(ctx, secrets))
+	err = store.CheckSecretKey(ctx, wrongSecrets)
+	require.ErrorIs(t, err, ErrWrongSecretKey)
+}
+
+// TestPasswordKey tests that the same key is only derived from a password
+// with the same salt and scrypt costs.
+func TestPasswordKey(t *testing.T) {
+	salt, err := NewPasswordSalt()
+	require.NoError(t, err)
+	params := NewPasswordKeyParams(salt)
+
+	key, err := PasswordKey([]byte("password"), params)
+	require.NoError(t, err)
+
+	sameKey, err := PasswordKey([]byte("password"), params)
+	require.NoError(t, err)
+	require.Equal(t, key, sameKey)
+
+	otherSalt, err := NewPasswordSalt()
+	require.NoError(t, err)
+
+	otherKey, err := PasswordKey(
+		[]byte("password"), NewPasswordKeyParams(otherSalt),
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherKey)
+
+	otherCosts := NewPasswordKeyParams(salt)
+	otherCosts.ScryptN = 1 << 10
+
+	otherKey, err = PasswordKey([]byte("password"), otherCosts)
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherKey)
+
+	_, err = PasswordKey(nil, params)
+	require.Error(t, err)
+
+	_, err = PasswordKey(
+		[]byte("password"), &KeyParams{Scheme: KeySchemeLnd},
+	)
+	require.Error(t, err)
+}
@@ -2,20 +2,32 @@ package loopdb
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lntypes"
 )
 
+// errRollbackWriteCheck is returned from the transaction of a write check to
+// roll back the test write.
+var errRollbackWriteCheck = errors.New("rollback write check")
+
 // SwapStore is the primary database interface used by the loopd system. It
 // houses information for all pending completed/failed swaps.
 type SwapStore interface {
 	// FetchLoopOutSwaps returns all swaps currently in the store.
 	FetchLoopOutSwaps(ctx context.Context) ([]*LoopOut, error)
 
-	// FetchLoopOutSwap returns the loop out swap with the given hash.
+	// FetchLoopOutSwap returns the loop out swap with the given hash. If
+	// there is no such swap, ErrSwapNotFound is returned.
 	FetchLoopOutSwap(ctx context.Context, hash lntypes.Hash) (*LoopOut, error)
 
+	// FetchLoopOutSwapByClientID returns the loop out swap that was
+	// assigned the given client id. If there is no such swap,
+	// ErrSwapNotFound is returned.
+	FetchLoopOutSwapByClientID(ctx context.Context,
+		clientID string) (*LoopOut, error)
+
 	// CreateLoopOut adds an initiated swap to the store.
 	CreateLoopOut(ctx context.Context, hash lntypes.Hash,
 		swap *LoopOutContract) error
@@ -30,9 +42,33 @@ type SwapStore interface {
 	UpdateLoopOut(ctx context.Context, hash lntypes.Hash, time time.Time,
 		state SwapStateData) error
 
+	// UpdateLoopOutSweepConfTarget changes the sweep confirmation target
+	// of a loop out swap.
+	UpdateLoopOutSweepConfTarget(ctx context.Context, hash lntypes.Hash,
+		confTarget int32) error
+
+	// UpdateLoopOutSettlement records which of the off-chain payments of
+	// a loop out swap the server has settled.
+	UpdateLoopOutSettlement(ctx context.Context, hash lntypes.Hash,
+		prepaySettled, swapPaymentSettled bool) error
+
+	// UpdateLoopOutSweepVerification records the result of checking the
+	// confirmed sweep of a loop out swap against its destination.
+	UpdateLoopOutSweepVerification(ctx context.Context, hash lntypes.Hash,
+		verified bool, discrepancy string) error
+
+	// FilterSwaps returns the loop ins and loop outs that pass the filter,
+	// ordered by their initiation time and hash.
+	FilterSwaps(ctx context.Context, filter *SwapFilter) ([]FilteredSwap,
+		error)
+
 	// FetchLoopInSwaps returns all swaps currently in the store.
 	FetchLoopInSwaps(ctx context.Context) ([]*LoopIn, error)
 
+	// FetchLoopInSwap returns the loop in swap with the given hash. If
+	// there is no such swap, ErrSwapNotFound is returned.
+	FetchLoopInSwap(ctx context.Context, hash lntypes.Hash) (*LoopIn, error)
+
 	// CreateLoopIn adds an initiated swap to the store.
 	CreateLoopIn(ctx context.Context, hash lntypes.Hash,
 		swap *LoopInContract) error
@@ -65,6 +101,21 @@ type SwapStore interface {
 	// it's decoding using the proto package's `Unmarshal` method.
 	FetchLiquidityParams(ctx context.Context) ([]byte, error)
 
+	// AddTermsSnapshot stores the snapshot if its terms differ from the
+	// most recent snapshot, and drops the oldest snapshots so that at most
+	// retention snapshots are kept. It returns true if the snapshot was
+	// added.
+	AddTermsSnapshot(ctx context.Context, snapshot *TermsSnapshot,
+		retention int) (bool, error)
+
+	// FetchTermsSnapshots returns the stored terms snapshots, oldest
+	// first.
+	FetchTermsSnapshots(ctx context.Context) ([]TermsSnapshot, error)
+
+	// CheckWritable verifies that the store accepts writes. The write is
+	// rolled back, so the stored data isn't changed.
+	CheckWritable(ctx context.Context) error
+
 	// Close closes the underlying database.
 	Close() error
 }
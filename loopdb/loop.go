@@ -3,6 +3,7 @@ package loopdb
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
@@ -10,6 +11,17 @@ import (
 	"github.com/lightningnetwork/lnd/lntypes"
 )
 
+// MaxMetadataSize is the maximum size in bytes of the metadata of a swap.
+const MaxMetadataSize = 1024
+
+// ErrMetadataTooLarge is returned when a swap is created with metadata that
+// exceeds MaxMetadataSize.
+var ErrMetadataTooLarge = errors.New("swap metadata too large")
+
+// ErrSwapNotFound is returned when a swap that is looked up by its hash or
+// client id is not in the store.
+var ErrSwapNotFound = errors.New("swap not found")
+
 // HtlcKeys is a holder of all keys used when constructing the swap HTLC. Since
 // it's used for both loop in and loop out swaps it may hold partial information
 // about the sender or receiver depending on the swap type.
@@ -68,9 +80,31 @@ type SwapContract struct {
 	// Label contains an optional label for the swap.
 	Label string
 
+	// Metadata is an optional opaque blob that the creator of the swap
+	// attached to it. It is at most MaxMetadataSize bytes long.
+	Metadata []byte
+
 	// ProtocolVersion stores the protocol version when the swap was
 	// created.
 	ProtocolVersion ProtocolVersion
+
+	// Quote is the quote that the swap was initiated with. It is nil for
+	// swaps that were stored without a quote.
+	Quote *SwapQuote
+}
+
+// SwapQuote contains the fees that were quoted for a swap when it was
+// initiated.
+type SwapQuote struct {
+	// SwapFee is the quoted swap fee.
+	SwapFee btcutil.Amount
+
+	// PrepayAmount is the quoted prepay amount of a loop out. It is zero
+	// for loop ins.
+	PrepayAmount btcutil.Amount
+
+	// MinerFee is the quoted on-chain fee.
+	MinerFee btcutil.Amount
 }
 
 // Loop contains fields shared between LoopIn and LoopOut.
@@ -140,6 +140,32 @@ func getLabel(bucket *bbolt.Bucket) string {
 	return string(label)
 }
 
+// putMetadata writes the metadata of a swap to the bucket provided under the
+// metadata key if it is non-empty.
+func putMetadata(bucket *bbolt.Bucket, metadata []byte) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	if len(metadata) > MaxMetadataSize {
+		return ErrMetadataTooLarge
+	}
+
+	return bucket.Put(metadataKey, metadata)
+}
+
+// getMetadata returns the metadata that is stored under the metadata key in a
+// bucket, or nil if there is none.
+func getMetadata(bucket *bbolt.Bucket) []byte {
+	metadata := bucket.Get(metadataKey)
+	if metadata == nil {
+		return nil
+	}
+
+	// The value is only valid during the transaction, so we copy it.
+	return append([]byte(nil), metadata...)
+}
+
 // deserializeLoopInContract deserializes the loop in contract from a byte slice.
 func deserializeLoopInContract(value []byte) (*LoopInContract, error) {
 	r := bytes.NewReader(value)
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // LoopOutContract contains the data that is serialized to persistent storage
@@ -61,6 +62,41 @@ type LoopOutContract struct {
 	// allow the server to delay the publication in exchange for possibly
 	// lower fees.
 	SwapPublicationDeadline time.Time
+
+	// ClientID is an optional identifier that the caller assigned to the
+	// swap. It is unique among all loop out swaps if set.
+	ClientID string
+
+	// SweepWhenFeeBelow is the fee rate below which the htlc is swept. If
+	// the fee rate doesn't drop below it, the htlc is swept once the swap
+	// comes close to its expiry. Zero means the htlc is swept right away.
+	SweepWhenFeeBelow chainfee.SatPerKWeight
+
+	// HtlcConfTimeout is the number of blocks after the initiation height
+	// within which the htlc must confirm before the swap is failed. Zero
+	// means that the swap waits for the htlc until the preimage reveal
+	// deadline.
+	HtlcConfTimeout int32
+
+	// SweepFeeRate is the fee rate that the htlc is swept with instead of
+	// the fee rate estimated for the sweep conf target. Zero means that
+	// the fee rate is estimated.
+	SweepFeeRate chainfee.SatPerKWeight
+
+	// PrepaySettled is true once the server has settled the prepayment.
+	PrepaySettled bool
+
+	// SwapPaymentSettled is true once the server has settled the swap
+	// payment.
+	SwapPaymentSettled bool
+
+	// SweepVerified is true once the confirmed sweep has been checked to
+	// pay the swap's funds to its destination.
+	SweepVerified bool
+
+	// SweepDiscrepancy describes why the confirmed sweep didn't pass the
+	// check. It is empty if no discrepancy was found.
+	SweepDiscrepancy string
 }
 
 // ChannelSet stores a set of channels.
@@ -16,6 +16,15 @@ import (
 
 const (
 	dsnTemplate = "postgres://%v:%v@%v:%d/%v?sslmode=%v"
+
+	// defaultMaxConnections is the number of open connections to the
+	// database server that are kept if none is configured.
+	defaultMaxConnections = 25
+
+	// defaultConnMaxLifetime is the time after which a connection to the
+	// database server is replaced if none is configured, so that a
+	// managed database can rebalance or fail over its connections.
+	defaultConnMaxLifetime = 10 * time.Minute
 )
 
 var (
@@ -29,14 +38,15 @@ var (
 
 // PostgresConfig holds the postgres database configuration.
 type PostgresConfig struct {
-	SkipMigrations     bool   `long:"skipmigrations" description:"Skip applying migrations on startup."`
-	Host               string `long:"host" description:"Database server hostname."`
-	Port               int    `long:"port" description:"Database server port."`
-	User               string `long:"user" description:"Database user."`
-	Password           string `long:"password" description:"Database user's password."`
-	DBName             string `long:"dbname" description:"Database name to use."`
-	MaxOpenConnections int32  `long:"maxconnections" description:"Max open connections to keep alive to the database server."`
-	RequireSSL         bool   `long:"requiressl" description:"Whether to require using SSL (mode: require) when connecting to the server."`
+	SkipMigrations     bool          `long:"skipmigrations" description:"Skip applying migrations on startup."`
+	Host               string        `long:"host" description:"Database server hostname."`
+	Port               int           `long:"port" description:"Database server port."`
+	User               string        `long:"user" description:"Database user."`
+	Password           string        `long:"password" description:"Database user's password."`
+	DBName             string        `long:"dbname" description:"Database name to use."`
+	MaxOpenConnections int32         `long:"maxconnections" description:"Max open connections to keep alive to the database server. Set to 0 to use the default of 25."`
+	ConnMaxLifetime    time.Duration `long:"connmaxlifetime" description:"The time after which a connection to the database server is closed and replaced. Set to 0 to use the default of 10 minutes."`
+	RequireSSL         bool          `long:"requiressl" description:"Whether to require using SSL (mode: require) when connecting to the server."`
 }
 
 // DSN returns the dns to connect to the database.
@@ -76,6 +86,22 @@ func NewPostgresStore(cfg *PostgresConfig,
 		return nil, err
 	}
 
+	maxConns := defaultMaxConnections
+	if cfg.MaxOpenConnections > 0 {
+		maxConns = int(cfg.MaxOpenConnections)
+	}
+
+	connMaxLifetime := defaultConnMaxLifetime
+	if cfg.ConnMaxLifetime > 0 {
+		connMaxLifetime = cfg.ConnMaxLifetime
+	}
+
+	// Keep the idle connections open, so that the pool doesn't need to
+	// reconnect to the database server under load.
+	rawDb.SetMaxOpenConns(maxConns)
+	rawDb.SetMaxIdleConns(maxConns)
+	rawDb.SetConnMaxLifetime(connMaxLifetime)
+
 	if !cfg.SkipMigrations {
 		// Now that the database is open, populate the database with
 		// our set of schemas based on our embedded in-memory file
@@ -4,16 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
-	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/routing/route"
 )
 
@@ -29,6 +31,26 @@ func (s *BaseDB) FetchLoopOutSwaps(ctx context.Context) ([]*LoopOut,
 			return err
 		}
 
+		clientIDs, err := s.Queries.GetSwapClientIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		swapClientIDs := make(map[lntypes.Hash]string, len(clientIDs))
+		for _, clientID := range clientIDs {
+			swapHash, err := lntypes.MakeHash(clientID.SwapHash)
+			if err != nil {
+				return err
+			}
+
+			swapClientIDs[swapHash] = clientID.ClientID
+		}
+
+		quotes, err := s.fetchSwapQuotes(ctx)
+		if err != nil {
+			return err
+		}
+
 		loopOuts = make([]*LoopOut, len(swaps))
 
 		for i, swap := range swaps {
@@ -39,14 +61,16 @@ func (s *BaseDB) FetchLoopOutSwaps(ctx context.Context) ([]*LoopOut,
 				return err
 			}
 
-			loopOut, err := ConvertLoopOutRow(
-				s.network, sqlc.GetLoopOutSwapRow(swap),
-				updates,
+			loopOut, err := s.ConvertLoopOutRow(
+				sqlc.GetLoopOutSwapRow(swap), updates,
 			)
 			if err != nil {
 				return err
 			}
 
+			loopOut.Contract.ClientID = swapClientIDs[loopOut.Hash]
+			loopOut.Contract.Quote = quotes[loopOut.Hash]
+
 			loopOuts[i] = loopOut
 		}
 
@@ -67,6 +91,9 @@ func (s *BaseDB) FetchLoopOutSwap(ctx context.Context,
 
 	err := s.ExecTx(ctx, NewSqlReadOpts(), func(*sqlc.Queries) error {
 		swap, err := s.Queries.GetLoopOutSwap(ctx, hash[:])
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSwapNotFound
+		}
 		if err != nil {
 			return err
 		}
@@ -76,14 +103,23 @@ func (s *BaseDB) FetchLoopOutSwap(ctx context.Context,
 			return err
 		}
 
-		loopOut, err = ConvertLoopOutRow(
-			s.network, swap, updates,
-		)
+		loopOut, err = s.ConvertLoopOutRow(swap, updates)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		// Swaps without a client id don't have an entry in the client
+		// ids table.
+		clientID, err := s.Queries.GetSwapClientID(ctx, swap.SwapHash)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		loopOut.Contract.ClientID = clientID
+
+		loopOut.Contract.Quote, err = s.fetchSwapQuote(ctx, hash)
+
+		return err
 	})
 	if err != nil {
 		return nil, err
@@ -92,18 +128,128 @@ func (s *BaseDB) FetchLoopOutSwap(ctx context.Context,
 	return loopOut, nil
 }
 
+// FilterSwaps returns the swaps that pass the filter. The filter, the ordering
+// and the paging are applied by the database. The swaps are ordered by their
+// initiation time and hash, so that consecutive pages can be fetched by
+// increasing the offset.
+func (s *BaseDB) FilterSwaps(ctx context.Context, filter *SwapFilter) (
+	[]FilteredSwap, error) {
+
+	if filter == nil {
+		filter = &SwapFilter{}
+	}
+
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
+	params := sqlc.FilterSwapsParams{
+		NumLimit:  math.MaxInt32,
+		NumOffset: int32(filter.Offset),
+	}
+
+	if filter.Limit > 0 {
+		params.NumLimit = int32(filter.Limit)
+	}
+
+	if filter.SwapType != nil {
+		params.IsLoopOut = sql.NullBool{
+			Bool:  *filter.SwapType == swap.TypeOut,
+			Valid: true,
+		}
+	}
+
+	if filter.StateType != nil {
+		params.StateMask = sql.NullInt32{
+			Int32: stateMask(*filter.StateType),
+			Valid: true,
+		}
+	}
+
+	// Times are stored in UTC, so they are compared in UTC too.
+	if !filter.InitiatedAfter.IsZero() {
+		params.InitiatedAfter = sql.NullTime{
+			Time:  filter.InitiatedAfter.UTC(),
+			Valid: true,
+		}
+	}
+
+	if !filter.InitiatedBefore.IsZero() {
+		params.InitiatedBefore = sql.NullTime{
+			Time:  filter.InitiatedBefore.UTC(),
+			Valid: true,
+		}
+	}
+
+	if filter.Label != "" {
+		params.Label = sql.NullString{
+			String: filter.Label,
+			Valid:  true,
+		}
+	}
+
+	rows, err := s.Queries.FilterSwaps(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	swaps := make([]FilteredSwap, len(rows))
+	for i, row := range rows {
+		hash, err := lntypes.MakeHash(row.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		swapType := swap.TypeIn
+		if row.IsLoopOut {
+			swapType = swap.TypeOut
+		}
+
+		swaps[i] = FilteredSwap{
+			Hash: hash,
+			Type: swapType,
+		}
+	}
+
+	return swaps, nil
+}
+
+// FetchLoopOutSwapByClientID returns the loop out swap that was assigned the
+// given client id.
+func (s *BaseDB) FetchLoopOutSwapByClientID(ctx context.Context,
+	clientID string) (*LoopOut, error) {
+
+	swapHash, err := s.Queries.GetSwapHashByClientID(ctx, clientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSwapNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := lntypes.MakeHash(swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FetchLoopOutSwap(ctx, hash)
+}
+
 // CreateLoopOut adds an initiated swap to the store.
 func (s *BaseDB) CreateLoopOut(ctx context.Context, hash lntypes.Hash,
 	swap *LoopOutContract) error {
 
 	writeOpts := &SqliteTxOptions{}
 	return s.ExecTx(ctx, writeOpts, func(tx *sqlc.Queries) error {
-		insertArgs := loopToInsertArgs(
+		insertArgs, err := s.loopToInsertArgs(
 			hash, &swap.SwapContract,
 		)
+		if err != nil {
+			return err
+		}
 
 		// First we'll insert the swap itself.
-		err := tx.InsertSwap(ctx, insertArgs)
+		err = tx.InsertSwap(ctx, insertArgs)
 		if err != nil {
 			return err
 		}
@@ -126,7 +272,12 @@ func (s *BaseDB) CreateLoopOut(ctx context.Context, hash lntypes.Hash,
 			return err
 		}
 
-		return nil
+		err = insertSwapQuote(ctx, tx, hash, &swap.SwapContract)
+		if err != nil {
+			return err
+		}
+
+		return insertSwapClientID(ctx, tx, hash, swap)
 	})
 }
 
@@ -139,12 +290,15 @@ func (s *BaseDB) BatchCreateLoopOut(ctx context.Context,
 		for swapHash, swap := range swaps {
 			swap := swap
 
-			insertArgs := loopToInsertArgs(
+			insertArgs, err := s.loopToInsertArgs(
 				swapHash, &swap.SwapContract,
 			)
+			if err != nil {
+				return err
+			}
 
 			// First we'll insert the swap itself.
-			err := tx.InsertSwap(ctx, insertArgs)
+			err = tx.InsertSwap(ctx, insertArgs)
 			if err != nil {
 				return err
 			}
@@ -166,11 +320,101 @@ func (s *BaseDB) BatchCreateLoopOut(ctx context.Context,
 			if err != nil {
 				return err
 			}
+
+			err = insertSwapQuote(
+				ctx, tx, swapHash, &swap.SwapContract,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = insertSwapClientID(ctx, tx, swapHash, swap)
+			if err != nil {
+				return err
+			}
 		}
 		return nil
 	})
 }
 
+// insertSwapClientID stores the client id of the loop out swap, if it has one.
+func insertSwapClientID(ctx context.Context, tx *sqlc.Queries,
+	hash lntypes.Hash, swap *LoopOutContract) error {
+
+	if swap.ClientID == "" {
+		return nil
+	}
+
+	return tx.InsertSwapClientID(ctx, sqlc.InsertSwapClientIDParams{
+		SwapHash: hash[:],
+		ClientID: swap.ClientID,
+	})
+}
+
+// insertSwapQuote stores the quote of the swap, if it has one.
+func insertSwapQuote(ctx context.Context, tx *sqlc.Queries,
+	hash lntypes.Hash, swap *SwapContract) error {
+
+	if swap.Quote == nil {
+		return nil
+	}
+
+	return tx.InsertSwapQuote(ctx, sqlc.InsertSwapQuoteParams{
+		SwapHash:  hash[:],
+		SwapFee:   int64(swap.Quote.SwapFee),
+		PrepayAmt: int64(swap.Quote.PrepayAmount),
+		MinerFee:  int64(swap.Quote.MinerFee),
+	})
+}
+
+// fetchSwapQuotes returns the quotes of all swaps that were stored with a
+// quote.
+func (s *BaseDB) fetchSwapQuotes(ctx context.Context) (
+	map[lntypes.Hash]*SwapQuote, error) {
+
+	rows, err := s.Queries.GetSwapQuotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := make(map[lntypes.Hash]*SwapQuote, len(rows))
+	for _, row := range rows {
+		swapHash, err := lntypes.MakeHash(row.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		quotes[swapHash] = convertSwapQuoteRow(row)
+	}
+
+	return quotes, nil
+}
+
+// fetchSwapQuote returns the quote of the swap with the given hash, or nil if
+// the swap was stored without a quote.
+func (s *BaseDB) fetchSwapQuote(ctx context.Context,
+	hash lntypes.Hash) (*SwapQuote, error) {
+
+	row, err := s.Queries.GetSwapQuote(ctx, hash[:])
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSwapQuoteRow(row), nil
+}
+
+// convertSwapQuoteRow converts a stored swap quote.
+func convertSwapQuoteRow(row sqlc.SwapQuote) *SwapQuote {
+	return &SwapQuote{
+		SwapFee:      btcutil.Amount(row.SwapFee),
+		PrepayAmount: btcutil.Amount(row.PrepayAmt),
+		MinerFee:     btcutil.Amount(row.MinerFee),
+	}
+}
+
 // UpdateLoopOut stores a new event for a target loop out swap. This
 // appends to the event log for a particular swap as it goes through
 // the various stages in its lifetime.
@@ -180,6 +424,47 @@ func (s *BaseDB) UpdateLoopOut(ctx context.Context, hash lntypes.Hash,
 	return s.updateLoop(ctx, hash, time, state)
 }
 
+// UpdateLoopOutSweepConfTarget changes the sweep confirmation target of a loop
+// out swap.
+func (s *BaseDB) UpdateLoopOutSweepConfTarget(ctx context.Context,
+	hash lntypes.Hash, confTarget int32) error {
+
+	return s.Queries.UpdateLoopOutSweepConfTarget(
+		ctx, sqlc.UpdateLoopOutSweepConfTargetParams{
+			SwapHash:        hash[:],
+			SweepConfTarget: confTarget,
+		},
+	)
+}
+
+// UpdateLoopOutSettlement records which of the off-chain payments of a loop
+// out swap the server has settled.
+func (s *BaseDB) UpdateLoopOutSettlement(ctx context.Context,
+	hash lntypes.Hash, prepaySettled, swapPaymentSettled bool) error {
+
+	return s.Queries.UpdateLoopOutSettlement(
+		ctx, sqlc.UpdateLoopOutSettlementParams{
+			SwapHash:           hash[:],
+			PrepaySettled:      prepaySettled,
+			SwapPaymentSettled: swapPaymentSettled,
+		},
+	)
+}
+
+// UpdateLoopOutSweepVerification records the result of checking the confirmed
+// sweep of a loop out swap against its destination.
+func (s *BaseDB) UpdateLoopOutSweepVerification(ctx context.Context,
+	hash lntypes.Hash, verified bool, discrepancy string) error {
+
+	return s.Queries.UpdateLoopOutSweepVerification(
+		ctx, sqlc.UpdateLoopOutSweepVerificationParams{
+			SwapHash:         hash[:],
+			SweepVerified:    verified,
+			SweepDiscrepancy: discrepancy,
+		},
+	)
+}
+
 // FetchLoopInSwaps returns all swaps currently in the store.
 func (s *BaseDB) FetchLoopInSwaps(ctx context.Context) (
 	[]*LoopIn, error) {
@@ -192,6 +477,11 @@ func (s *BaseDB) FetchLoopInSwaps(ctx context.Context) (
 			return err
 		}
 
+		quotes, err := s.fetchSwapQuotes(ctx)
+		if err != nil {
+			return err
+		}
+
 		loopIns = make([]*LoopIn, len(swaps))
 
 		for i, swap := range swaps {
@@ -207,6 +497,8 @@ func (s *BaseDB) FetchLoopInSwaps(ctx context.Context) (
 				return err
 			}
 
+			loopIn.Contract.Quote = quotes[loopIn.Hash]
+
 			loopIns[i] = loopIn
 		}
 
@@ -219,18 +511,59 @@ func (s *BaseDB) FetchLoopInSwaps(ctx context.Context) (
 	return loopIns, nil
 }
 
+// FetchLoopInSwap returns the loop in swap with the given hash.
+func (s *BaseDB) FetchLoopInSwap(ctx context.Context,
+	hash lntypes.Hash) (*LoopIn, error) {
+
+	var loopIn *LoopIn
+
+	err := s.ExecTx(ctx, NewSqlReadOpts(), func(*sqlc.Queries) error {
+		swap, err := s.Queries.GetLoopInSwap(ctx, hash[:])
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSwapNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		updates, err := s.Queries.GetSwapUpdates(ctx, swap.SwapHash)
+		if err != nil {
+			return err
+		}
+
+		loopIn, err = s.convertLoopInRow(
+			sqlc.GetLoopInSwapsRow(swap), updates,
+		)
+		if err != nil {
+			return err
+		}
+
+		loopIn.Contract.Quote, err = s.fetchSwapQuote(ctx, hash)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return loopIn, nil
+}
+
 // CreateLoopIn adds an initiated swap to the store.
 func (s *BaseDB) CreateLoopIn(ctx context.Context, hash lntypes.Hash,
 	swap *LoopInContract) error {
 
 	writeOpts := &SqliteTxOptions{}
 	return s.ExecTx(ctx, writeOpts, func(tx *sqlc.Queries) error {
-		insertArgs := loopToInsertArgs(
+		insertArgs, err := s.loopToInsertArgs(
 			hash, &swap.SwapContract,
 		)
+		if err != nil {
+			return err
+		}
 
 		// First we'll insert the swap itself.
-		err := tx.InsertSwap(ctx, insertArgs)
+		err = tx.InsertSwap(ctx, insertArgs)
 		if err != nil {
 			return err
 		}
@@ -252,7 +585,7 @@ func (s *BaseDB) CreateLoopIn(ctx context.Context, hash lntypes.Hash,
 			return err
 		}
 
-		return nil
+		return insertSwapQuote(ctx, tx, hash, &swap.SwapContract)
 	})
 }
 
@@ -265,12 +598,15 @@ func (s *BaseDB) BatchCreateLoopIn(ctx context.Context,
 		for swapHash, swap := range swaps {
 			swap := swap
 
-			insertArgs := loopToInsertArgs(
+			insertArgs, err := s.loopToInsertArgs(
 				swapHash, &swap.SwapContract,
 			)
+			if err != nil {
+				return err
+			}
 
 			// First we'll insert the swap itself.
-			err := tx.InsertSwap(ctx, insertArgs)
+			err = tx.InsertSwap(ctx, insertArgs)
 			if err != nil {
 				return err
 			}
@@ -292,6 +628,13 @@ func (s *BaseDB) BatchCreateLoopIn(ctx context.Context,
 			if err != nil {
 				return err
 			}
+
+			err = insertSwapQuote(
+				ctx, tx, swapHash, &swap.SwapContract,
+			)
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -342,6 +685,30 @@ func (s *BaseDB) FetchLiquidityParams(ctx context.Context) ([]byte,
 	return params, nil
 }
 
+// CheckWritable verifies that the store accepts writes by writing the stored
+// liquidity parameters in a transaction that is rolled back.
+func (s *BaseDB) CheckWritable(ctx context.Context) error {
+	writeOpts := &SqliteTxOptions{}
+	err := s.ExecTx(ctx, writeOpts, func(tx *sqlc.Queries) error {
+		params, err := tx.FetchLiquidityParams(ctx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		err = tx.UpsertLiquidityParams(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return errRollbackWriteCheck
+	})
+	if errors.Is(err, errRollbackWriteCheck) {
+		return nil
+	}
+
+	return err
+}
+
 // A compile time assertion to ensure that SqliteStore satisfies the
 // SwapStore interface.
 var _ SwapStore = (*BaseDB)(nil)
@@ -408,13 +775,19 @@ func (s *BaseDB) BatchInsertUpdate(ctx context.Context,
 }
 
 // loopToInsertArgs converts a SwapContract struct to the arguments needed to
-// insert it into the database.
-func loopToInsertArgs(hash lntypes.Hash,
-	swap *SwapContract) sqlc.InsertSwapParams {
+// insert it into the database. The preimage is encrypted if the database
+// encrypts swap secrets.
+func (s *BaseDB) loopToInsertArgs(hash lntypes.Hash,
+	swap *SwapContract) (sqlc.InsertSwapParams, error) {
+
+	preimage, err := s.secrets.sealPreimage(swap.Preimage)
+	if err != nil {
+		return sqlc.InsertSwapParams{}, err
+	}
 
 	return sqlc.InsertSwapParams{
 		SwapHash:         hash[:],
-		Preimage:         swap.Preimage[:],
+		Preimage:         preimage,
 		InitiationTime:   swap.InitiationTime.UTC(),
 		AmountRequested:  int64(swap.AmountRequested),
 		CltvExpiry:       swap.CltvExpiry,
@@ -423,7 +796,8 @@ func loopToInsertArgs(hash lntypes.Hash,
 		InitiationHeight: swap.InitiationHeight,
 		ProtocolVersion:  int32(swap.ProtocolVersion),
 		Label:            swap.Label,
-	}
+		Metadata:         swap.Metadata,
+	}, nil
 }
 
 // loopOutToInsertArgs converts a LoopOutContract struct to the arguments
@@ -443,6 +817,13 @@ func loopOutToInsertArgs(hash lntypes.Hash,
 		PrepayInvoice:       loopOut.PrepayInvoice,
 		MaxPrepayRoutingFee: int64(loopOut.MaxPrepayRoutingFee),
 		PublicationDeadline: loopOut.SwapPublicationDeadline.UTC(),
+		SweepWhenFeeBelow:   int64(loopOut.SweepWhenFeeBelow),
+		HtlcConfTimeout:     loopOut.HtlcConfTimeout,
+		SweepFeeRate:        int64(loopOut.SweepFeeRate),
+		PrepaySettled:       loopOut.PrepaySettled,
+		SwapPaymentSettled:  loopOut.SwapPaymentSettled,
+		SweepVerified:       loopOut.SweepVerified,
+		SweepDiscrepancy:    loopOut.SweepDiscrepancy,
 	}
 }
 
@@ -486,7 +867,7 @@ func swapToHtlcKeysInsertArgs(hash lntypes.Hash,
 
 // ConvertLoopOutRow converts a database row containing a loop out swap to a
 // LoopOut struct.
-func ConvertLoopOutRow(network *chaincfg.Params, row sqlc.GetLoopOutSwapRow,
+func (s *BaseDB) ConvertLoopOutRow(row sqlc.GetLoopOutSwapRow,
 	updates []sqlc.SwapUpdate) (*LoopOut, error) {
 
 	htlcKeys, err := fetchHtlcKeys(
@@ -498,12 +879,12 @@ func ConvertLoopOutRow(network *chaincfg.Params, row sqlc.GetLoopOutSwapRow,
 		return nil, err
 	}
 
-	preimage, err := lntypes.MakePreimage(row.Preimage)
+	preimage, err := s.openPreimage(row.Preimage)
 	if err != nil {
 		return nil, err
 	}
 
-	destAddress, err := btcutil.DecodeAddress(row.DestAddress, network)
+	destAddress, err := btcutil.DecodeAddress(row.DestAddress, s.network)
 	if err != nil {
 		return nil, err
 	}
@@ -525,6 +906,7 @@ func ConvertLoopOutRow(network *chaincfg.Params, row sqlc.GetLoopOutSwapRow,
 				InitiationHeight: row.InitiationHeight,
 				InitiationTime:   row.InitiationTime,
 				Label:            row.Label,
+				Metadata:         row.Metadata,
 				ProtocolVersion:  ProtocolVersion(row.ProtocolVersion),
 			},
 			DestAddr:                destAddress,
@@ -536,6 +918,17 @@ func ConvertLoopOutRow(network *chaincfg.Params, row sqlc.GetLoopOutSwapRow,
 			PrepayInvoice:           row.PrepayInvoice,
 			MaxPrepayRoutingFee:     btcutil.Amount(row.MaxPrepayRoutingFee),
 			SwapPublicationDeadline: row.PublicationDeadline,
+			SweepWhenFeeBelow: chainfee.SatPerKWeight(
+				row.SweepWhenFeeBelow,
+			),
+			HtlcConfTimeout: row.HtlcConfTimeout,
+			SweepFeeRate: chainfee.SatPerKWeight(
+				row.SweepFeeRate,
+			),
+			PrepaySettled:      row.PrepaySettled,
+			SwapPaymentSettled: row.SwapPaymentSettled,
+			SweepVerified:      row.SweepVerified,
+			SweepDiscrepancy:   row.SweepDiscrepancy,
 		},
 		Loop: Loop{
 			Hash: swapHash,
@@ -580,7 +973,7 @@ func (s *BaseDB) convertLoopInRow(row sqlc.GetLoopInSwapsRow,
 		return nil, err
 	}
 
-	preimage, err := lntypes.MakePreimage(row.Preimage)
+	preimage, err := s.openPreimage(row.Preimage)
 	if err != nil {
 		return nil, err
 	}
@@ -602,6 +995,7 @@ func (s *BaseDB) convertLoopInRow(row sqlc.GetLoopInSwapsRow,
 				InitiationHeight: row.InitiationHeight,
 				InitiationTime:   row.InitiationTime,
 				Label:            row.Label,
+				Metadata:         row.Metadata,
 				ProtocolVersion:  ProtocolVersion(row.ProtocolVersion),
 			},
 			HtlcConfTarget: row.HtlcConfTarget,
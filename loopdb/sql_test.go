@@ -11,8 +11,10 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
 )
@@ -78,6 +80,46 @@ func TestSqliteLoopOutStore(t *testing.T) {
 	t.Run("labelled swap", func(t *testing.T) {
 		testSqliteLoopOutStore(t, &labelledSwap)
 	})
+
+	metadataSwap := unrestrictedSwap
+	metadataSwap.Metadata = []byte(`{"batch":42}`)
+	t.Run("metadata swap", func(t *testing.T) {
+		testSqliteLoopOutStore(t, &metadataSwap)
+	})
+
+	clientIDSwap := unrestrictedSwap
+	clientIDSwap.ClientID = "client id"
+	t.Run("client id swap", func(t *testing.T) {
+		testSqliteLoopOutStore(t, &clientIDSwap)
+	})
+
+	feeLimitSwap := unrestrictedSwap
+	feeLimitSwap.SweepWhenFeeBelow = 1000
+	t.Run("sweep fee limit swap", func(t *testing.T) {
+		testSqliteLoopOutStore(t, &feeLimitSwap)
+	})
+
+	confTimeoutSwap := unrestrictedSwap
+	confTimeoutSwap.HtlcConfTimeout = 144
+	t.Run("htlc conf timeout swap", func(t *testing.T) {
+		testSqliteLoopOutStore(t, &confTimeoutSwap)
+	})
+
+	feeRateSwap := unrestrictedSwap
+	feeRateSwap.SweepFeeRate = 2500
+	t.Run("sweep fee rate swap", func(t *testing.T) {
+		testSqliteLoopOutStore(t, &feeRateSwap)
+	})
+
+	quotedSwap := unrestrictedSwap
+	quotedSwap.Quote = &SwapQuote{
+		SwapFee:      15,
+		PrepayAmount: 5,
+		MinerFee:     8,
+	}
+	t.Run("quoted swap", func(t *testing.T) {
+		testSqliteLoopOutStore(t, &quotedSwap)
+	})
 }
 
 // testSqliteLoopOutStore tests the basic functionality of the current sqlite
@@ -110,6 +152,7 @@ func testSqliteLoopOutStore(t *testing.T, pendingSwap *LoopOutContract) {
 
 		require.Equal(t, hash, swap.Hash)
 		require.Equal(t, hash, swaps[0].Hash)
+		require.Equal(t, pendingSwap.Quote, swaps[0].Contract.Quote)
 
 		swapContract := swap.Contract
 
@@ -120,6 +163,19 @@ func testSqliteLoopOutStore(t *testing.T, pendingSwap *LoopOutContract) {
 		if expectedState == StatePreimageRevealed {
 			require.NotNil(t, swap.State().HtlcTxHash)
 		}
+
+		if pendingSwap.ClientID != "" {
+			swap, err := store.FetchLoopOutSwapByClientID(
+				ctxb, pendingSwap.ClientID,
+			)
+			require.NoError(t, err)
+			require.Equal(t, hash, swap.Hash)
+
+			_, err = store.FetchLoopOutSwapByClientID(
+				ctxb, "unknown",
+			)
+			require.ErrorIs(t, err, ErrSwapNotFound)
+		}
 	}
 
 	// If we create a new swap, then it should show up as being initialized
@@ -147,6 +203,29 @@ func testSqliteLoopOutStore(t *testing.T, pendingSwap *LoopOutContract) {
 
 	checkSwap(StatePreimageRevealed)
 
+	// The sweep confirmation target of a pending swap can be changed.
+	err = store.UpdateLoopOutSweepConfTarget(ctxb, hash, 6)
+	require.NoError(t, err)
+
+	pendingSwap.SweepConfTarget = 6
+	checkSwap(StatePreimageRevealed)
+
+	// The settlement of the off-chain payments is stored.
+	err = store.UpdateLoopOutSettlement(ctxb, hash, true, false)
+	require.NoError(t, err)
+
+	pendingSwap.PrepaySettled = true
+	checkSwap(StatePreimageRevealed)
+
+	// The result of the sweep check is stored.
+	err = store.UpdateLoopOutSweepVerification(
+		ctxb, hash, false, "sweep has no output paying to dest",
+	)
+	require.NoError(t, err)
+
+	pendingSwap.SweepDiscrepancy = "sweep has no output paying to dest"
+	checkSwap(StatePreimageRevealed)
+
 	// Next, we'll update to the final state to ensure that the state is
 	// properly updated.
 	err = store.UpdateLoopOut(
@@ -209,6 +288,21 @@ func TestSQLliteLoopInStore(t *testing.T) {
 	t.Run("loop in with label", func(t *testing.T) {
 		testSqliteLoopInStore(t, labelledSwap)
 	})
+
+	metadataSwap := pendingSwap
+	metadataSwap.Metadata = []byte(`{"batch":42}`)
+	t.Run("loop in with metadata", func(t *testing.T) {
+		testSqliteLoopInStore(t, metadataSwap)
+	})
+
+	quotedSwap := pendingSwap
+	quotedSwap.Quote = &SwapQuote{
+		SwapFee:  15,
+		MinerFee: 8,
+	}
+	t.Run("loop in with quote", func(t *testing.T) {
+		testSqliteLoopInStore(t, quotedSwap)
+	})
 }
 
 func testSqliteLoopInStore(t *testing.T, pendingSwap LoopInContract) {
@@ -237,6 +331,13 @@ func testSqliteLoopInStore(t *testing.T, pendingSwap LoopInContract) {
 		require.Equal(t, swap, &pendingSwap)
 
 		require.Equal(t, swaps[0].State().State, expectedState)
+
+		loopIn, err := store.FetchLoopInSwap(ctxb, hash)
+		require.NoError(t, err)
+		require.Equal(t, swaps[0], loopIn)
+
+		_, err = store.FetchLoopInSwap(ctxb, lntypes.Hash{})
+		require.ErrorIs(t, err, ErrSwapNotFound)
 	}
 
 	// If we create a new swap, then it should show up as being initialized
@@ -305,6 +406,200 @@ func TestSqliteLiquidityParams(t *testing.T) {
 	require.Equal(t, params, paramsRead, "unexpected return value")
 }
 
+// TestSqliteTermsSnapshots tests that only changed terms are stored and that
+// the oldest snapshots are dropped beyond the retention.
+func TestSqliteTermsSnapshots(t *testing.T) {
+	ctxb := context.Background()
+	store := NewTestDB(t)
+
+	snapshots, err := store.FetchTermsSnapshots(ctxb)
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	first := TermsSnapshot{
+		Time:          time.Unix(1000, 0).UTC(),
+		MinSwapAmount: 10000,
+		MaxSwapAmount: 100000,
+		MinCltvDelta:  20,
+		MaxCltvDelta:  60,
+	}
+	added, err := store.AddTermsSnapshot(ctxb, &first, 2)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	// The same terms aren't stored again.
+	unchanged := first
+	unchanged.Time = time.Unix(2000, 0).UTC()
+	added, err = store.AddTermsSnapshot(ctxb, &unchanged, 2)
+	require.NoError(t, err)
+	require.False(t, added)
+
+	second := unchanged
+	second.MaxSwapAmount = 200000
+	added, err = store.AddTermsSnapshot(ctxb, &second, 2)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	third := second
+	third.Time = time.Unix(3000, 0).UTC()
+	third.AmountIncrement = 1000
+	added, err = store.AddTermsSnapshot(ctxb, &third, 2)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	snapshots, err = store.FetchTermsSnapshots(ctxb)
+	require.NoError(t, err)
+	require.Equal(t, []TermsSnapshot{second, third}, snapshots)
+}
+
+// TestSqliteFilterSwaps tests that the store filters, orders and pages swaps.
+func TestSqliteFilterSwaps(t *testing.T) {
+	ctxb := context.Background()
+	store := NewTestDB(t)
+
+	start := time.Date(2018, 11, 1, 0, 0, 0, 0, time.UTC)
+	contract := func(i int, label string) SwapContract {
+		return SwapContract{
+			AmountRequested: 100,
+			Preimage:        lntypes.Preimage{byte(i + 1)},
+			CltvExpiry:      144,
+			HtlcKeys: HtlcKeys{
+				SenderScriptKey:        senderKey,
+				ReceiverScriptKey:      receiverKey,
+				SenderInternalPubKey:   senderInternalKey,
+				ReceiverInternalPubKey: receiverInternalKey,
+			},
+			InitiationTime: start.Add(
+				time.Duration(i) * time.Minute,
+			),
+			Label:           label,
+			ProtocolVersion: ProtocolVersionMuSig2,
+		}
+	}
+
+	// Add three loop outs and one loop in, initiated one minute apart.
+	outStates := []SwapState{
+		StateSuccess, StateInitiated, StateFailTimeout,
+	}
+	hashes := make([]lntypes.Hash, 0, len(outStates)+1)
+	for i, state := range outStates {
+		loopOut := LoopOutContract{
+			SwapContract: contract(i, "out"),
+			DestAddr:     test.GetDestAddr(t, 0),
+		}
+		hash := loopOut.Preimage.Hash()
+		hashes = append(hashes, hash)
+
+		err := store.CreateLoopOut(ctxb, hash, &loopOut)
+		require.NoError(t, err)
+
+		if state == StateInitiated {
+			continue
+		}
+
+		err = store.UpdateLoopOut(
+			ctxb, hash, testTime, SwapStateData{State: state},
+		)
+		require.NoError(t, err)
+	}
+
+	loopIn := LoopInContract{
+		SwapContract: contract(len(outStates), "in"),
+	}
+	inHash := loopIn.Preimage.Hash()
+	hashes = append(hashes, inHash)
+
+	err := store.CreateLoopIn(ctxb, inHash, &loopIn)
+	require.NoError(t, err)
+
+	err = store.UpdateLoopIn(
+		ctxb, inHash, testTime, SwapStateData{State: StateSuccess},
+	)
+	require.NoError(t, err)
+
+	typeOut := swap.TypeOut
+	success := StateTypeSuccess
+	pending := StateTypePending
+
+	tests := []struct {
+		name    string
+		filter  *SwapFilter
+		indices []int
+	}{
+		{
+			name:    "no filter",
+			filter:  nil,
+			indices: []int{0, 1, 2, 3},
+		},
+		{
+			name:    "swap type",
+			filter:  &SwapFilter{SwapType: &typeOut},
+			indices: []int{0, 1, 2},
+		},
+		{
+			name:    "successful",
+			filter:  &SwapFilter{StateType: &success},
+			indices: []int{0, 3},
+		},
+		{
+			name:    "pending",
+			filter:  &SwapFilter{StateType: &pending},
+			indices: []int{1},
+		},
+		{
+			name: "time range",
+			filter: &SwapFilter{
+				InitiatedAfter:  start.Add(time.Minute),
+				InitiatedBefore: start.Add(3 * time.Minute),
+			},
+			indices: []int{1, 2},
+		},
+		{
+			name:    "label",
+			filter:  &SwapFilter{Label: "in"},
+			indices: []int{3},
+		},
+		{
+			name:    "page",
+			filter:  &SwapFilter{Offset: 1, Limit: 2},
+			indices: []int{1, 2},
+		},
+		{
+			name:    "offset past end",
+			filter:  &SwapFilter{Offset: 4},
+			indices: []int{},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			swaps, err := store.FilterSwaps(ctxb, testCase.filter)
+			require.NoError(t, err)
+
+			expected := make(
+				[]FilteredSwap, 0, len(testCase.indices),
+			)
+			for _, i := range testCase.indices {
+				swapType := swap.TypeOut
+				if i == len(outStates) {
+					swapType = swap.TypeIn
+				}
+
+				expected = append(expected, FilteredSwap{
+					Hash: hashes[i],
+					Type: swapType,
+				})
+			}
+			require.Equal(t, expected, swaps)
+		})
+	}
+
+	_, err = store.FilterSwaps(ctxb, &SwapFilter{Limit: -1})
+	require.Error(t, err)
+}
+
 // TestSqliteTypeConversion is a small test that checks that we can safely
 // convert between the :one and :many types from sqlc.
 func TestSqliteTypeConversion(t *testing.T) {
@@ -28,8 +28,8 @@ func (q *Queries) ConfirmBatch(ctx context.Context, id int32) error {
 const getBatchSweeps = `-- name: GetBatchSweeps :many
 SELECT
         sweeps.id, sweeps.swap_hash, sweeps.batch_id, sweeps.outpoint_txid, sweeps.outpoint_index, sweeps.amt, sweeps.completed,
-        swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
-        loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep,
+        swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label, swaps.metadata,
+        loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep, loopout_swaps.sweep_when_fee_below, loopout_swaps.htlc_conf_timeout, loopout_swaps.sweep_fee_rate, loopout_swaps.prepay_settled, loopout_swaps.swap_payment_settled, loopout_swaps.sweep_verified, loopout_swaps.sweep_discrepancy,
         htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM
         sweeps
@@ -64,6 +64,7 @@ type GetBatchSweepsRow struct {
 	InitiationHeight       int32
 	ProtocolVersion        int32
 	Label                  string
+	Metadata               []byte
 	SwapHash_3             []byte
 	DestAddress            string
 	SwapInvoice            string
@@ -75,6 +76,13 @@ type GetBatchSweepsRow struct {
 	MaxPrepayRoutingFee    int64
 	PublicationDeadline    time.Time
 	SingleSweep            bool
+	SweepWhenFeeBelow      int64
+	HtlcConfTimeout        int32
+	SweepFeeRate           int64
+	PrepaySettled          bool
+	SwapPaymentSettled     bool
+	SweepVerified          bool
+	SweepDiscrepancy       string
 	SwapHash_4             []byte
 	SenderScriptPubkey     []byte
 	ReceiverScriptPubkey   []byte
@@ -112,6 +120,7 @@ func (q *Queries) GetBatchSweeps(ctx context.Context, batchID int32) ([]GetBatch
 			&i.InitiationHeight,
 			&i.ProtocolVersion,
 			&i.Label,
+			&i.Metadata,
 			&i.SwapHash_3,
 			&i.DestAddress,
 			&i.SwapInvoice,
@@ -123,6 +132,13 @@ func (q *Queries) GetBatchSweeps(ctx context.Context, batchID int32) ([]GetBatch
 			&i.MaxPrepayRoutingFee,
 			&i.PublicationDeadline,
 			&i.SingleSweep,
+			&i.SweepWhenFeeBelow,
+			&i.HtlcConfTimeout,
+			&i.SweepFeeRate,
+			&i.PrepaySettled,
+			&i.SwapPaymentSettled,
+			&i.SweepVerified,
+			&i.SweepDiscrepancy,
 			&i.SwapHash_4,
 			&i.SenderScriptPubkey,
 			&i.ReceiverScriptPubkey,
@@ -164,7 +180,7 @@ func (q *Queries) GetBatchSweptAmount(ctx context.Context, batchID int32) (int64
 
 const getParentBatch = `-- name: GetParentBatch :one
 SELECT
-        sweep_batches.id, sweep_batches.confirmed, sweep_batches.batch_tx_id, sweep_batches.batch_pk_script, sweep_batches.last_rbf_height, sweep_batches.last_rbf_sat_per_kw, sweep_batches.max_timeout_distance
+        sweep_batches.id, sweep_batches.confirmed, sweep_batches.batch_tx_id, sweep_batches.batch_pk_script, sweep_batches.last_rbf_height, sweep_batches.last_rbf_sat_per_kw, sweep_batches.max_timeout_distance, sweep_batches.fee_bumps
 FROM
         sweep_batches
 JOIN
@@ -188,6 +204,7 @@ func (q *Queries) GetParentBatch(ctx context.Context, swapHash []byte) (SweepBat
 		&i.LastRbfHeight,
 		&i.LastRbfSatPerKw,
 		&i.MaxTimeoutDistance,
+		&i.FeeBumps,
 	)
 	return i, err
 }
@@ -210,7 +227,7 @@ func (q *Queries) GetSweepStatus(ctx context.Context, swapHash []byte) (bool, er
 
 const getUnconfirmedBatches = `-- name: GetUnconfirmedBatches :many
 SELECT
-        id, confirmed, batch_tx_id, batch_pk_script, last_rbf_height, last_rbf_sat_per_kw, max_timeout_distance
+        id, confirmed, batch_tx_id, batch_pk_script, last_rbf_height, last_rbf_sat_per_kw, max_timeout_distance, fee_bumps
 FROM
         sweep_batches
 WHERE
@@ -234,6 +251,7 @@ func (q *Queries) GetUnconfirmedBatches(ctx context.Context) ([]SweepBatch, erro
 			&i.LastRbfHeight,
 			&i.LastRbfSatPerKw,
 			&i.MaxTimeoutDistance,
+			&i.FeeBumps,
 		); err != nil {
 			return nil, err
 		}
@@ -255,14 +273,16 @@ INSERT INTO sweep_batches (
         batch_pk_script,
         last_rbf_height,
         last_rbf_sat_per_kw,
-        max_timeout_distance
+        max_timeout_distance,
+        fee_bumps
 ) VALUES (
         $1,
         $2,
         $3,
         $4,
         $5,
-        $6
+        $6,
+        $7
 ) RETURNING id
 `
 
@@ -273,6 +293,7 @@ type InsertBatchParams struct {
 	LastRbfHeight      sql.NullInt32
 	LastRbfSatPerKw    sql.NullInt32
 	MaxTimeoutDistance int32
+	FeeBumps           int32
 }
 
 func (q *Queries) InsertBatch(ctx context.Context, arg InsertBatchParams) (int32, error) {
@@ -283,6 +304,7 @@ func (q *Queries) InsertBatch(ctx context.Context, arg InsertBatchParams) (int32
 		arg.LastRbfHeight,
 		arg.LastRbfSatPerKw,
 		arg.MaxTimeoutDistance,
+		arg.FeeBumps,
 	)
 	var id int32
 	err := row.Scan(&id)
@@ -295,7 +317,8 @@ UPDATE sweep_batches SET
         batch_tx_id = $3,
         batch_pk_script = $4,
         last_rbf_height = $5,
-        last_rbf_sat_per_kw = $6
+        last_rbf_sat_per_kw = $6,
+        fee_bumps = $7
 WHERE id = $1
 `
 
@@ -306,6 +329,7 @@ type UpdateBatchParams struct {
 	BatchPkScript   []byte
 	LastRbfHeight   sql.NullInt32
 	LastRbfSatPerKw sql.NullInt32
+	FeeBumps        int32
 }
 
 func (q *Queries) UpdateBatch(ctx context.Context, arg UpdateBatchParams) error {
@@ -316,6 +340,7 @@ func (q *Queries) UpdateBatch(ctx context.Context, arg UpdateBatchParams) error
 		arg.BatchPkScript,
 		arg.LastRbfHeight,
 		arg.LastRbfSatPerKw,
+		arg.FeeBumps,
 	)
 	return err
 }
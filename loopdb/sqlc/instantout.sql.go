@@ -13,7 +13,7 @@ import (
 
 const getInstantOutSwap = `-- name: GetInstantOutSwap :one
 SELECT
-    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
+    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label, swaps.metadata,
     instantout_swaps.swap_hash, instantout_swaps.preimage, instantout_swaps.sweep_address, instantout_swaps.outgoing_chan_set, instantout_swaps.htlc_fee_rate, instantout_swaps.reservation_ids, instantout_swaps.swap_invoice, instantout_swaps.finalized_htlc_tx, instantout_swaps.sweep_tx_hash, instantout_swaps.finalized_sweepless_sweep_tx, instantout_swaps.sweep_confirmation_height,
     htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM
@@ -38,6 +38,7 @@ type GetInstantOutSwapRow struct {
 	InitiationHeight          int32
 	ProtocolVersion           int32
 	Label                     string
+	Metadata                  []byte
 	SwapHash_2                []byte
 	Preimage_2                []byte
 	SweepAddress              string
@@ -73,6 +74,7 @@ func (q *Queries) GetInstantOutSwap(ctx context.Context, swapHash []byte) (GetIn
 		&i.InitiationHeight,
 		&i.ProtocolVersion,
 		&i.Label,
+		&i.Metadata,
 		&i.SwapHash_2,
 		&i.Preimage_2,
 		&i.SweepAddress,
@@ -134,7 +136,7 @@ func (q *Queries) GetInstantOutSwapUpdates(ctx context.Context, swapHash []byte)
 
 const getInstantOutSwaps = `-- name: GetInstantOutSwaps :many
 SELECT 
-    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
+    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label, swaps.metadata,
     instantout_swaps.swap_hash, instantout_swaps.preimage, instantout_swaps.sweep_address, instantout_swaps.outgoing_chan_set, instantout_swaps.htlc_fee_rate, instantout_swaps.reservation_ids, instantout_swaps.swap_invoice, instantout_swaps.finalized_htlc_tx, instantout_swaps.sweep_tx_hash, instantout_swaps.finalized_sweepless_sweep_tx, instantout_swaps.sweep_confirmation_height,
     htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM
@@ -159,6 +161,7 @@ type GetInstantOutSwapsRow struct {
 	InitiationHeight          int32
 	ProtocolVersion           int32
 	Label                     string
+	Metadata                  []byte
 	SwapHash_2                []byte
 	Preimage_2                []byte
 	SweepAddress              string
@@ -200,6 +203,7 @@ func (q *Queries) GetInstantOutSwaps(ctx context.Context) ([]GetInstantOutSwapsR
 			&i.InitiationHeight,
 			&i.ProtocolVersion,
 			&i.Label,
+			&i.Metadata,
 			&i.SwapHash_2,
 			&i.Preimage_2,
 			&i.SweepAddress,
@@ -0,0 +1,9 @@
+-- swap_client_ids maps the identifiers that callers assigned to their swaps
+-- to the swap hashes, so that swaps can be looked up by these identifiers.
+CREATE TABLE IF NOT EXISTS swap_client_ids (
+    -- swap_hash is the hash of the swap.
+    swap_hash BLOB NOT NULL UNIQUE REFERENCES swaps(swap_hash),
+
+    -- client_id is the identifier that the caller assigned to the swap.
+    client_id TEXT NOT NULL UNIQUE
+);
@@ -0,0 +1 @@
+ALTER TABLE loopout_swaps DROP COLUMN sweep_when_fee_below;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- sweep_when_fee_below is the fee rate in sat/kw below which the htlc of the
+-- swap is swept. Zero means that the htlc is swept right away.
+ALTER TABLE loopout_swaps ADD sweep_when_fee_below BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE loopout_swaps DROP COLUMN htlc_conf_timeout;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- htlc_conf_timeout is the number of blocks after the initiation height of the
+-- swap within which the htlc must confirm. Zero means that the swap waits for
+-- the htlc until the preimage reveal deadline.
+ALTER TABLE loopout_swaps ADD htlc_conf_timeout INTEGER NOT NULL DEFAULT 0;
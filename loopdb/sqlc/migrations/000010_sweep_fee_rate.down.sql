@@ -0,0 +1 @@
+ALTER TABLE loopout_swaps DROP COLUMN sweep_fee_rate;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- sweep_fee_rate is the fee rate in sat/kw that the htlc of the swap is swept
+-- with instead of the fee rate estimated for its sweep conf target. Zero means
+-- that the fee rate is estimated.
+ALTER TABLE loopout_swaps ADD sweep_fee_rate BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE swaps DROP COLUMN metadata;
\ No newline at end of file
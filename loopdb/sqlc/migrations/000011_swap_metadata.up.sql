@@ -0,0 +1,3 @@
+-- metadata is an optional opaque blob that the creator of a swap attaches to
+-- it, for example to link the swap to records of an external application.
+ALTER TABLE swaps ADD metadata BLOB;
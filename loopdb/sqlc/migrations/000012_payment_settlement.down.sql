@@ -0,0 +1,2 @@
+ALTER TABLE loopout_swaps DROP COLUMN swap_payment_settled;
+ALTER TABLE loopout_swaps DROP COLUMN prepay_settled;
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- prepay_settled is true once the server has settled the prepayment of the
+-- loop out.
+ALTER TABLE loopout_swaps ADD prepay_settled BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- swap_payment_settled is true once the server has settled the swap payment of
+-- the loop out.
+ALTER TABLE loopout_swaps ADD swap_payment_settled BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS terms_snapshots;
\ No newline at end of file
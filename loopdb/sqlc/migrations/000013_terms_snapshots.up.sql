@@ -0,0 +1,26 @@
+-- terms_snapshots holds the loop out terms that were observed from the server.
+-- A snapshot is only added when the terms changed since the last one, so the
+-- table shows when and how the server's terms changed.
+CREATE TABLE IF NOT EXISTS terms_snapshots (
+    -- id is the autoincrementing primary key.
+    id INTEGER PRIMARY KEY,
+
+    -- fetched_at is the time at which the terms were first fetched.
+    fetched_at TIMESTAMP NOT NULL,
+
+    -- min_swap_amount is the minimum swap amount in satoshis.
+    min_swap_amount BIGINT NOT NULL,
+
+    -- max_swap_amount is the maximum swap amount in satoshis.
+    max_swap_amount BIGINT NOT NULL,
+
+    -- min_cltv_delta is the minimum expiry delta of a swap.
+    min_cltv_delta INTEGER NOT NULL,
+
+    -- max_cltv_delta is the maximum expiry delta of a swap.
+    max_cltv_delta INTEGER NOT NULL,
+
+    -- amount_increment is the increment in satoshis in which swap amounts
+    -- are accepted. Zero means that any amount is accepted.
+    amount_increment BIGINT NOT NULL
+);
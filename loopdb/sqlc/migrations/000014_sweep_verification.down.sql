@@ -0,0 +1,2 @@
+ALTER TABLE loopout_swaps DROP COLUMN sweep_discrepancy;
+ALTER TABLE loopout_swaps DROP COLUMN sweep_verified;
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- sweep_verified is true once the confirmed sweep of the loop out has been
+-- checked to pay the swap's funds to its destination.
+ALTER TABLE loopout_swaps ADD sweep_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- sweep_discrepancy describes why the confirmed sweep of the loop out didn't
+-- pass the check. It is empty if no discrepancy was found.
+ALTER TABLE loopout_swaps ADD sweep_discrepancy TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,16 @@
+-- swap_quotes holds the quote that a swap was initiated with, so that the
+-- realized costs of the swap can be compared with the quoted ones.
+CREATE TABLE IF NOT EXISTS swap_quotes (
+    -- swap_hash is the hash of the swap.
+    swap_hash BLOB NOT NULL UNIQUE REFERENCES swaps(swap_hash),
+
+    -- swap_fee is the quoted swap fee in satoshis.
+    swap_fee BIGINT NOT NULL,
+
+    -- prepay_amt is the quoted prepay amount of a loop out in satoshis.
+    -- It is zero for loop ins.
+    prepay_amt BIGINT NOT NULL,
+
+    -- miner_fee is the quoted on-chain fee in satoshis.
+    miner_fee BIGINT NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE sweep_batches DROP COLUMN fee_bumps;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- fee_bumps is the number of times that the fee rate of the batch transaction
+-- was bumped.
+ALTER TABLE sweep_batches ADD fee_bumps INTEGER NOT NULL DEFAULT 0;
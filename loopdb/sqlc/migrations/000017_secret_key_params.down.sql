@@ -0,0 +1 @@
+DROP TABLE IF EXISTS secret_key_params;
\ No newline at end of file
@@ -0,0 +1,23 @@
+-- secret_key_params stores the parameters that the key of the encrypted swap
+-- secrets was derived with as a single row. It is written to backups, so that
+-- their secrets can be decrypted on another machine.
+CREATE TABLE IF NOT EXISTS secret_key_params (
+    id INTEGER PRIMARY KEY,
+
+    -- scheme is the source of the key, either lnd or password.
+    scheme TEXT NOT NULL,
+
+    -- salt is the salt of a password key.
+    salt BLOB,
+
+    -- scrypt_n, scrypt_r and scrypt_p are the scrypt costs of a password
+    -- key.
+    scrypt_n INTEGER NOT NULL,
+    scrypt_r INTEGER NOT NULL,
+    scrypt_p INTEGER NOT NULL,
+
+    -- key_check is a known plaintext that is encrypted with the key of the
+    -- swap secrets. It tells whether a key is the one that the secrets were
+    -- encrypted with before any secret is written with it.
+    key_check BLOB
+);
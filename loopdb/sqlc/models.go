@@ -64,6 +64,13 @@ type LoopoutSwap struct {
 	MaxPrepayRoutingFee int64
 	PublicationDeadline time.Time
 	SingleSweep         bool
+	SweepWhenFeeBelow   int64
+	HtlcConfTimeout     int32
+	SweepFeeRate        int64
+	PrepaySettled       bool
+	SwapPaymentSettled  bool
+	SweepVerified       bool
+	SweepDiscrepancy    string
 }
 
 type Reservation struct {
@@ -88,6 +95,16 @@ type ReservationUpdate struct {
 	UpdateTimestamp time.Time
 }
 
+type SecretKeyParam struct {
+	ID       int32
+	Scheme   string
+	Salt     []byte
+	ScryptN  int32
+	ScryptR  int32
+	ScryptP  int32
+	KeyCheck []byte
+}
+
 type Swap struct {
 	ID               int32
 	SwapHash         []byte
@@ -100,6 +117,19 @@ type Swap struct {
 	InitiationHeight int32
 	ProtocolVersion  int32
 	Label            string
+	Metadata         []byte
+}
+
+type SwapClientID struct {
+	SwapHash []byte
+	ClientID string
+}
+
+type SwapQuote struct {
+	SwapHash  []byte
+	SwapFee   int64
+	PrepayAmt int64
+	MinerFee  int64
 }
 
 type SwapUpdate struct {
@@ -131,4 +161,15 @@ type SweepBatch struct {
 	LastRbfHeight      sql.NullInt32
 	LastRbfSatPerKw    sql.NullInt32
 	MaxTimeoutDistance int32
+	FeeBumps           int32
+}
+
+type TermsSnapshot struct {
+	ID              int32
+	FetchedAt       time.Time
+	MinSwapAmount   int64
+	MaxSwapAmount   int64
+	MinCltvDelta    int32
+	MaxCltvDelta    int32
+	AmountIncrement int64
 }
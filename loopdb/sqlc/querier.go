@@ -11,12 +11,16 @@ import (
 type Querier interface {
 	ConfirmBatch(ctx context.Context, id int32) error
 	CreateReservation(ctx context.Context, arg CreateReservationParams) error
+	DeleteOldTermsSnapshots(ctx context.Context, limit int32) error
 	FetchLiquidityParams(ctx context.Context) ([]byte, error)
+	FetchSecretKeyParams(ctx context.Context) (FetchSecretKeyParamsRow, error)
+	FilterSwaps(ctx context.Context, arg FilterSwapsParams) ([]FilterSwapsRow, error)
 	GetBatchSweeps(ctx context.Context, batchID int32) ([]GetBatchSweepsRow, error)
 	GetBatchSweptAmount(ctx context.Context, batchID int32) (int64, error)
 	GetInstantOutSwap(ctx context.Context, swapHash []byte) (GetInstantOutSwapRow, error)
 	GetInstantOutSwapUpdates(ctx context.Context, swapHash []byte) ([]InstantoutUpdate, error)
 	GetInstantOutSwaps(ctx context.Context) ([]GetInstantOutSwapsRow, error)
+	GetLatestTermsSnapshot(ctx context.Context) (TermsSnapshot, error)
 	GetLoopInSwap(ctx context.Context, swapHash []byte) (GetLoopInSwapRow, error)
 	GetLoopInSwaps(ctx context.Context) ([]GetLoopInSwapsRow, error)
 	GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopOutSwapRow, error)
@@ -25,8 +29,15 @@ type Querier interface {
 	GetReservation(ctx context.Context, reservationID []byte) (Reservation, error)
 	GetReservationUpdates(ctx context.Context, reservationID []byte) ([]ReservationUpdate, error)
 	GetReservations(ctx context.Context) ([]Reservation, error)
+	GetSwapClientID(ctx context.Context, swapHash []byte) (string, error)
+	GetSwapClientIDs(ctx context.Context) ([]SwapClientID, error)
+	GetSwapHashByClientID(ctx context.Context, clientID string) ([]byte, error)
+	GetSwapPreimages(ctx context.Context) ([]GetSwapPreimagesRow, error)
+	GetSwapQuote(ctx context.Context, swapHash []byte) (SwapQuote, error)
+	GetSwapQuotes(ctx context.Context) ([]SwapQuote, error)
 	GetSwapUpdates(ctx context.Context, swapHash []byte) ([]SwapUpdate, error)
 	GetSweepStatus(ctx context.Context, swapHash []byte) (bool, error)
+	GetTermsSnapshots(ctx context.Context) ([]TermsSnapshot, error)
 	GetUnconfirmedBatches(ctx context.Context) ([]SweepBatch, error)
 	InsertBatch(ctx context.Context, arg InsertBatchParams) (int32, error)
 	InsertHtlcKeys(ctx context.Context, arg InsertHtlcKeysParams) error
@@ -36,11 +47,19 @@ type Querier interface {
 	InsertLoopOut(ctx context.Context, arg InsertLoopOutParams) error
 	InsertReservationUpdate(ctx context.Context, arg InsertReservationUpdateParams) error
 	InsertSwap(ctx context.Context, arg InsertSwapParams) error
+	InsertSwapClientID(ctx context.Context, arg InsertSwapClientIDParams) error
+	InsertSwapQuote(ctx context.Context, arg InsertSwapQuoteParams) error
 	InsertSwapUpdate(ctx context.Context, arg InsertSwapUpdateParams) error
+	InsertTermsSnapshot(ctx context.Context, arg InsertTermsSnapshotParams) error
 	UpdateBatch(ctx context.Context, arg UpdateBatchParams) error
 	UpdateInstantOut(ctx context.Context, arg UpdateInstantOutParams) error
+	UpdateLoopOutSettlement(ctx context.Context, arg UpdateLoopOutSettlementParams) error
+	UpdateLoopOutSweepConfTarget(ctx context.Context, arg UpdateLoopOutSweepConfTargetParams) error
+	UpdateLoopOutSweepVerification(ctx context.Context, arg UpdateLoopOutSweepVerificationParams) error
 	UpdateReservation(ctx context.Context, arg UpdateReservationParams) error
+	UpdateSwapPreimage(ctx context.Context, arg UpdateSwapPreimageParams) error
 	UpsertLiquidityParams(ctx context.Context, params []byte) error
+	UpsertSecretKeyParams(ctx context.Context, arg UpsertSecretKeyParamsParams) error
 	UpsertSweep(ctx context.Context, arg UpsertSweepParams) error
 }
 
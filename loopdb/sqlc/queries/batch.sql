@@ -13,14 +13,16 @@ INSERT INTO sweep_batches (
         batch_pk_script,
         last_rbf_height,
         last_rbf_sat_per_kw,
-        max_timeout_distance
+        max_timeout_distance,
+        fee_bumps
 ) VALUES (
         $1,
         $2,
         $3,
         $4,
         $5,
-        $6
+        $6,
+        $7
 ) RETURNING id;
 
 -- name: UpdateBatch :exec
@@ -29,7 +31,8 @@ UPDATE sweep_batches SET
         batch_tx_id = $3,
         batch_pk_script = $4,
         last_rbf_height = $5,
-        last_rbf_sat_per_kw = $6
+        last_rbf_sat_per_kw = $6,
+        fee_bumps = $7
 WHERE id = $1;
 
 -- name: ConfirmBatch :exec
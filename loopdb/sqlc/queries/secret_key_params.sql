@@ -0,0 +1,20 @@
+-- name: UpsertSecretKeyParams :exec
+INSERT INTO secret_key_params (
+    id, scheme, salt, scrypt_n, scrypt_r, scrypt_p, key_check
+) VALUES (
+    1, $1, $2, $3, $4, $5, $6
+) ON CONFLICT (id) DO UPDATE SET
+    scheme = excluded.scheme,
+    salt = excluded.salt,
+    scrypt_n = excluded.scrypt_n,
+    scrypt_r = excluded.scrypt_r,
+    scrypt_p = excluded.scrypt_p,
+    key_check = excluded.key_check;
+
+-- name: FetchSecretKeyParams :one
+SELECT
+    scheme, salt, scrypt_n, scrypt_r, scrypt_p, key_check
+FROM
+    secret_key_params
+WHERE
+    id = 1;
@@ -0,0 +1,29 @@
+-- name: InsertSwapClientID :exec
+INSERT INTO swap_client_ids (
+    swap_hash,
+    client_id
+) VALUES (
+    $1, $2
+);
+
+-- name: GetSwapClientIDs :many
+SELECT
+    *
+FROM
+    swap_client_ids;
+
+-- name: GetSwapClientID :one
+SELECT
+    client_id
+FROM
+    swap_client_ids
+WHERE
+    swap_hash = $1;
+
+-- name: GetSwapHashByClientID :one
+SELECT
+    swap_hash
+FROM
+    swap_client_ids
+WHERE
+    client_id = $1;
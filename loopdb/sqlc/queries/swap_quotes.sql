@@ -0,0 +1,23 @@
+-- name: InsertSwapQuote :exec
+INSERT INTO swap_quotes (
+    swap_hash,
+    swap_fee,
+    prepay_amt,
+    miner_fee
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: GetSwapQuotes :many
+SELECT
+    *
+FROM
+    swap_quotes;
+
+-- name: GetSwapQuote :one
+SELECT
+    *
+FROM
+    swap_quotes
+WHERE
+    swap_hash = $1;
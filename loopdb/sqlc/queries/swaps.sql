@@ -75,9 +75,10 @@ INSERT INTO swaps (
     max_swap_fee,
     initiation_height,
     protocol_version,
-    label
+    label,
+    metadata
 ) VALUES (
-     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 );
 
 -- name: InsertSwapUpdate :exec
@@ -105,9 +106,16 @@ INSERT INTO loopout_swaps (
     prepay_invoice,
     max_prepay_routing_fee,
     publication_deadline,
-    single_sweep
+    single_sweep,
+    sweep_when_fee_below,
+    htlc_conf_timeout,
+    sweep_fee_rate,
+    prepay_settled,
+    swap_payment_settled,
+    sweep_verified,
+    sweep_discrepancy
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
 );
 
 -- name: InsertLoopIn :exec
@@ -131,4 +139,68 @@ INSERT INTO htlc_keys(
     client_key_index
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7
-);
\ No newline at end of file
+);
+
+-- name: UpdateLoopOutSweepConfTarget :exec
+UPDATE loopout_swaps
+SET sweep_conf_target = $2
+WHERE swap_hash = $1;
+
+-- name: UpdateLoopOutSettlement :exec
+UPDATE loopout_swaps
+SET prepay_settled = $2, swap_payment_settled = $3
+WHERE swap_hash = $1;
+
+-- name: UpdateLoopOutSweepVerification :exec
+UPDATE loopout_swaps
+SET sweep_verified = $2, sweep_discrepancy = $3
+WHERE swap_hash = $1;
+
+-- name: FilterSwaps :many
+SELECT
+    swaps.swap_hash,
+    loopout_swaps.swap_hash IS NOT NULL AS is_loop_out
+FROM
+    swaps
+LEFT JOIN
+    loopout_swaps ON swaps.swap_hash = loopout_swaps.swap_hash
+WHERE
+    COALESCE(
+        (loopout_swaps.swap_hash IS NOT NULL) = sqlc.narg('is_loop_out'),
+        TRUE
+    )
+    AND COALESCE(
+        swaps.initiation_time >= sqlc.narg('initiated_after'), TRUE
+    )
+    AND COALESCE(
+        swaps.initiation_time < sqlc.narg('initiated_before'), TRUE
+    )
+    AND COALESCE(swaps.label = sqlc.narg('label'), TRUE)
+    AND COALESCE(
+        ((1 << COALESCE((
+            SELECT
+                update_state
+            FROM
+                swap_updates
+            WHERE
+                swap_updates.swap_hash = swaps.swap_hash
+            ORDER BY
+                id DESC
+            LIMIT 1
+        ), 0)) & sqlc.narg('state_mask')) != 0,
+        TRUE
+    )
+ORDER BY
+    swaps.initiation_time, swaps.swap_hash
+LIMIT sqlc.arg('num_limit') OFFSET sqlc.arg('num_offset');
+
+-- name: GetSwapPreimages :many
+SELECT
+    swap_hash, preimage
+FROM
+    swaps;
+
+-- name: UpdateSwapPreimage :exec
+UPDATE swaps
+SET preimage = $2
+WHERE swap_hash = $1;
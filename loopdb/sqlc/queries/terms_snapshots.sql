@@ -0,0 +1,34 @@
+-- name: InsertTermsSnapshot :exec
+INSERT INTO terms_snapshots (
+    fetched_at,
+    min_swap_amount,
+    max_swap_amount,
+    min_cltv_delta,
+    max_cltv_delta,
+    amount_increment
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: GetLatestTermsSnapshot :one
+SELECT
+    *
+FROM
+    terms_snapshots
+ORDER BY
+    id DESC
+LIMIT 1;
+
+-- name: GetTermsSnapshots :many
+SELECT
+    *
+FROM
+    terms_snapshots
+ORDER BY
+    id;
+
+-- name: DeleteOldTermsSnapshots :exec
+DELETE FROM terms_snapshots
+WHERE id NOT IN (
+    SELECT id FROM terms_snapshots ORDER BY id DESC LIMIT $1
+);
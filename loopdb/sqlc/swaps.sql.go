@@ -7,12 +7,98 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
+const filterSwaps = `-- name: FilterSwaps :many
+SELECT
+    swaps.swap_hash,
+    loopout_swaps.swap_hash IS NOT NULL AS is_loop_out
+FROM
+    swaps
+LEFT JOIN
+    loopout_swaps ON swaps.swap_hash = loopout_swaps.swap_hash
+WHERE
+    COALESCE(
+        (loopout_swaps.swap_hash IS NOT NULL) = $1,
+        TRUE
+    )
+    AND COALESCE(
+        swaps.initiation_time >= $2, TRUE
+    )
+    AND COALESCE(
+        swaps.initiation_time < $3, TRUE
+    )
+    AND COALESCE(swaps.label = $4, TRUE)
+    AND COALESCE(
+        ((1 << COALESCE((
+            SELECT
+                update_state
+            FROM
+                swap_updates
+            WHERE
+                swap_updates.swap_hash = swaps.swap_hash
+            ORDER BY
+                id DESC
+            LIMIT 1
+        ), 0)) & $5) != 0,
+        TRUE
+    )
+ORDER BY
+    swaps.initiation_time, swaps.swap_hash
+LIMIT $6 OFFSET $7
+`
+
+type FilterSwapsParams struct {
+	IsLoopOut       sql.NullBool
+	InitiatedAfter  sql.NullTime
+	InitiatedBefore sql.NullTime
+	Label           sql.NullString
+	StateMask       sql.NullInt32
+	NumLimit        int32
+	NumOffset       int32
+}
+
+type FilterSwapsRow struct {
+	SwapHash  []byte
+	IsLoopOut bool
+}
+
+func (q *Queries) FilterSwaps(ctx context.Context, arg FilterSwapsParams) ([]FilterSwapsRow, error) {
+	rows, err := q.db.QueryContext(ctx, filterSwaps,
+		arg.IsLoopOut,
+		arg.InitiatedAfter,
+		arg.InitiatedBefore,
+		arg.Label,
+		arg.StateMask,
+		arg.NumLimit,
+		arg.NumOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FilterSwapsRow
+	for rows.Next() {
+		var i FilterSwapsRow
+		if err := rows.Scan(&i.SwapHash, &i.IsLoopOut); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getLoopInSwap = `-- name: GetLoopInSwap :one
 SELECT 
-    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
+    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label, swaps.metadata,
     loopin_swaps.swap_hash, loopin_swaps.htlc_conf_target, loopin_swaps.last_hop, loopin_swaps.external_htlc,
     htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM
@@ -37,6 +123,7 @@ type GetLoopInSwapRow struct {
 	InitiationHeight       int32
 	ProtocolVersion        int32
 	Label                  string
+	Metadata               []byte
 	SwapHash_2             []byte
 	HtlcConfTarget         int32
 	LastHop                []byte
@@ -65,6 +152,7 @@ func (q *Queries) GetLoopInSwap(ctx context.Context, swapHash []byte) (GetLoopIn
 		&i.InitiationHeight,
 		&i.ProtocolVersion,
 		&i.Label,
+		&i.Metadata,
 		&i.SwapHash_2,
 		&i.HtlcConfTarget,
 		&i.LastHop,
@@ -82,7 +170,7 @@ func (q *Queries) GetLoopInSwap(ctx context.Context, swapHash []byte) (GetLoopIn
 
 const getLoopInSwaps = `-- name: GetLoopInSwaps :many
 SELECT 
-    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
+    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label, swaps.metadata,
     loopin_swaps.swap_hash, loopin_swaps.htlc_conf_target, loopin_swaps.last_hop, loopin_swaps.external_htlc,
     htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM
@@ -107,6 +195,7 @@ type GetLoopInSwapsRow struct {
 	InitiationHeight       int32
 	ProtocolVersion        int32
 	Label                  string
+	Metadata               []byte
 	SwapHash_2             []byte
 	HtlcConfTarget         int32
 	LastHop                []byte
@@ -141,6 +230,7 @@ func (q *Queries) GetLoopInSwaps(ctx context.Context) ([]GetLoopInSwapsRow, erro
 			&i.InitiationHeight,
 			&i.ProtocolVersion,
 			&i.Label,
+			&i.Metadata,
 			&i.SwapHash_2,
 			&i.HtlcConfTarget,
 			&i.LastHop,
@@ -168,8 +258,8 @@ func (q *Queries) GetLoopInSwaps(ctx context.Context) ([]GetLoopInSwapsRow, erro
 
 const getLoopOutSwap = `-- name: GetLoopOutSwap :one
 SELECT 
-    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
-    loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep,
+    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label, swaps.metadata,
+    loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep, loopout_swaps.sweep_when_fee_below, loopout_swaps.htlc_conf_timeout, loopout_swaps.sweep_fee_rate, loopout_swaps.prepay_settled, loopout_swaps.swap_payment_settled, loopout_swaps.sweep_verified, loopout_swaps.sweep_discrepancy,
     htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM
     swaps
@@ -193,6 +283,7 @@ type GetLoopOutSwapRow struct {
 	InitiationHeight       int32
 	ProtocolVersion        int32
 	Label                  string
+	Metadata               []byte
 	SwapHash_2             []byte
 	DestAddress            string
 	SwapInvoice            string
@@ -204,6 +295,13 @@ type GetLoopOutSwapRow struct {
 	MaxPrepayRoutingFee    int64
 	PublicationDeadline    time.Time
 	SingleSweep            bool
+	SweepWhenFeeBelow      int64
+	HtlcConfTimeout        int32
+	SweepFeeRate           int64
+	PrepaySettled          bool
+	SwapPaymentSettled     bool
+	SweepVerified          bool
+	SweepDiscrepancy       string
 	SwapHash_3             []byte
 	SenderScriptPubkey     []byte
 	ReceiverScriptPubkey   []byte
@@ -228,6 +326,7 @@ func (q *Queries) GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopO
 		&i.InitiationHeight,
 		&i.ProtocolVersion,
 		&i.Label,
+		&i.Metadata,
 		&i.SwapHash_2,
 		&i.DestAddress,
 		&i.SwapInvoice,
@@ -239,6 +338,13 @@ func (q *Queries) GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopO
 		&i.MaxPrepayRoutingFee,
 		&i.PublicationDeadline,
 		&i.SingleSweep,
+		&i.SweepWhenFeeBelow,
+		&i.HtlcConfTimeout,
+		&i.SweepFeeRate,
+		&i.PrepaySettled,
+		&i.SwapPaymentSettled,
+		&i.SweepVerified,
+		&i.SweepDiscrepancy,
 		&i.SwapHash_3,
 		&i.SenderScriptPubkey,
 		&i.ReceiverScriptPubkey,
@@ -252,8 +358,8 @@ func (q *Queries) GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopO
 
 const getLoopOutSwaps = `-- name: GetLoopOutSwaps :many
 SELECT 
-    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
-    loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep,
+    swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label, swaps.metadata,
+    loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep, loopout_swaps.sweep_when_fee_below, loopout_swaps.htlc_conf_timeout, loopout_swaps.sweep_fee_rate, loopout_swaps.prepay_settled, loopout_swaps.swap_payment_settled, loopout_swaps.sweep_verified, loopout_swaps.sweep_discrepancy,
     htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM 
     swaps
@@ -277,6 +383,7 @@ type GetLoopOutSwapsRow struct {
 	InitiationHeight       int32
 	ProtocolVersion        int32
 	Label                  string
+	Metadata               []byte
 	SwapHash_2             []byte
 	DestAddress            string
 	SwapInvoice            string
@@ -288,6 +395,13 @@ type GetLoopOutSwapsRow struct {
 	MaxPrepayRoutingFee    int64
 	PublicationDeadline    time.Time
 	SingleSweep            bool
+	SweepWhenFeeBelow      int64
+	HtlcConfTimeout        int32
+	SweepFeeRate           int64
+	PrepaySettled          bool
+	SwapPaymentSettled     bool
+	SweepVerified          bool
+	SweepDiscrepancy       string
 	SwapHash_3             []byte
 	SenderScriptPubkey     []byte
 	ReceiverScriptPubkey   []byte
@@ -318,6 +432,7 @@ func (q *Queries) GetLoopOutSwaps(ctx context.Context) ([]GetLoopOutSwapsRow, er
 			&i.InitiationHeight,
 			&i.ProtocolVersion,
 			&i.Label,
+			&i.Metadata,
 			&i.SwapHash_2,
 			&i.DestAddress,
 			&i.SwapInvoice,
@@ -329,6 +444,13 @@ func (q *Queries) GetLoopOutSwaps(ctx context.Context) ([]GetLoopOutSwapsRow, er
 			&i.MaxPrepayRoutingFee,
 			&i.PublicationDeadline,
 			&i.SingleSweep,
+			&i.SweepWhenFeeBelow,
+			&i.HtlcConfTimeout,
+			&i.SweepFeeRate,
+			&i.PrepaySettled,
+			&i.SwapPaymentSettled,
+			&i.SweepVerified,
+			&i.SweepDiscrepancy,
 			&i.SwapHash_3,
 			&i.SenderScriptPubkey,
 			&i.ReceiverScriptPubkey,
@@ -350,6 +472,41 @@ func (q *Queries) GetLoopOutSwaps(ctx context.Context) ([]GetLoopOutSwapsRow, er
 	return items, nil
 }
 
+const getSwapPreimages = `-- name: GetSwapPreimages :many
+SELECT
+    swap_hash, preimage
+FROM
+    swaps
+`
+
+type GetSwapPreimagesRow struct {
+	SwapHash []byte
+	Preimage []byte
+}
+
+func (q *Queries) GetSwapPreimages(ctx context.Context) ([]GetSwapPreimagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSwapPreimages)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSwapPreimagesRow
+	for rows.Next() {
+		var i GetSwapPreimagesRow
+		if err := rows.Scan(&i.SwapHash, &i.Preimage); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSwapUpdates = `-- name: GetSwapUpdates :many
 SELECT 
     id, swap_hash, update_timestamp, update_state, htlc_txhash, server_cost, onchain_cost, offchain_cost
@@ -470,9 +627,16 @@ INSERT INTO loopout_swaps (
     prepay_invoice,
     max_prepay_routing_fee,
     publication_deadline,
-    single_sweep
+    single_sweep,
+    sweep_when_fee_below,
+    htlc_conf_timeout,
+    sweep_fee_rate,
+    prepay_settled,
+    swap_payment_settled,
+    sweep_verified,
+    sweep_discrepancy
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
 )
 `
 
@@ -488,6 +652,13 @@ type InsertLoopOutParams struct {
 	MaxPrepayRoutingFee int64
 	PublicationDeadline time.Time
 	SingleSweep         bool
+	SweepWhenFeeBelow   int64
+	HtlcConfTimeout     int32
+	SweepFeeRate        int64
+	PrepaySettled       bool
+	SwapPaymentSettled  bool
+	SweepVerified       bool
+	SweepDiscrepancy    string
 }
 
 func (q *Queries) InsertLoopOut(ctx context.Context, arg InsertLoopOutParams) error {
@@ -503,6 +674,13 @@ func (q *Queries) InsertLoopOut(ctx context.Context, arg InsertLoopOutParams) er
 		arg.MaxPrepayRoutingFee,
 		arg.PublicationDeadline,
 		arg.SingleSweep,
+		arg.SweepWhenFeeBelow,
+		arg.HtlcConfTimeout,
+		arg.SweepFeeRate,
+		arg.PrepaySettled,
+		arg.SwapPaymentSettled,
+		arg.SweepVerified,
+		arg.SweepDiscrepancy,
 	)
 	return err
 }
@@ -518,9 +696,10 @@ INSERT INTO swaps (
     max_swap_fee,
     initiation_height,
     protocol_version,
-    label
+    label,
+    metadata
 ) VALUES (
-     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
 `
 
@@ -535,6 +714,7 @@ type InsertSwapParams struct {
 	InitiationHeight int32
 	ProtocolVersion  int32
 	Label            string
+	Metadata         []byte
 }
 
 func (q *Queries) InsertSwap(ctx context.Context, arg InsertSwapParams) error {
@@ -549,6 +729,7 @@ func (q *Queries) InsertSwap(ctx context.Context, arg InsertSwapParams) error {
 		arg.InitiationHeight,
 		arg.ProtocolVersion,
 		arg.Label,
+		arg.Metadata,
 	)
 	return err
 }
@@ -589,3 +770,69 @@ func (q *Queries) InsertSwapUpdate(ctx context.Context, arg InsertSwapUpdatePara
 	)
 	return err
 }
+
+const updateLoopOutSweepConfTarget = `-- name: UpdateLoopOutSweepConfTarget :exec
+UPDATE loopout_swaps
+SET sweep_conf_target = $2
+WHERE swap_hash = $1
+`
+
+type UpdateLoopOutSweepConfTargetParams struct {
+	SwapHash        []byte
+	SweepConfTarget int32
+}
+
+func (q *Queries) UpdateLoopOutSweepConfTarget(ctx context.Context, arg UpdateLoopOutSweepConfTargetParams) error {
+	_, err := q.db.ExecContext(ctx, updateLoopOutSweepConfTarget, arg.SwapHash, arg.SweepConfTarget)
+	return err
+}
+
+const updateLoopOutSettlement = `-- name: UpdateLoopOutSettlement :exec
+UPDATE loopout_swaps
+SET prepay_settled = $2, swap_payment_settled = $3
+WHERE swap_hash = $1
+`
+
+type UpdateLoopOutSettlementParams struct {
+	SwapHash           []byte
+	PrepaySettled      bool
+	SwapPaymentSettled bool
+}
+
+func (q *Queries) UpdateLoopOutSettlement(ctx context.Context, arg UpdateLoopOutSettlementParams) error {
+	_, err := q.db.ExecContext(ctx, updateLoopOutSettlement, arg.SwapHash, arg.PrepaySettled, arg.SwapPaymentSettled)
+	return err
+}
+
+const updateLoopOutSweepVerification = `-- name: UpdateLoopOutSweepVerification :exec
+UPDATE loopout_swaps
+SET sweep_verified = $2, sweep_discrepancy = $3
+WHERE swap_hash = $1
+`
+
+type UpdateLoopOutSweepVerificationParams struct {
+	SwapHash         []byte
+	SweepVerified    bool
+	SweepDiscrepancy string
+}
+
+func (q *Queries) UpdateLoopOutSweepVerification(ctx context.Context, arg UpdateLoopOutSweepVerificationParams) error {
+	_, err := q.db.ExecContext(ctx, updateLoopOutSweepVerification, arg.SwapHash, arg.SweepVerified, arg.SweepDiscrepancy)
+	return err
+}
+
+const updateSwapPreimage = `-- name: UpdateSwapPreimage :exec
+UPDATE swaps
+SET preimage = $2
+WHERE swap_hash = $1
+`
+
+type UpdateSwapPreimageParams struct {
+	SwapHash []byte
+	Preimage []byte
+}
+
+func (q *Queries) UpdateSwapPreimage(ctx context.Context, arg UpdateSwapPreimageParams) error {
+	_, err := q.db.ExecContext(ctx, updateSwapPreimage, arg.SwapHash, arg.Preimage)
+	return err
+}
@@ -3,6 +3,7 @@ package loopdb
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/url"
 	"path/filepath"
@@ -14,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	sqlite_migrate "github.com/golang-migrate/migrate/v4/database/sqlite"
 	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/zpay32"
 
 	"github.com/stretchr/testify/require"
@@ -177,11 +179,197 @@ func NewTestSqliteDB(t *testing.T) *SqliteSwapStore {
 type BaseDB struct {
 	network *chaincfg.Params
 
+	// secrets encrypts the swap secrets. If it is nil, they are stored in
+	// plain text.
+	secrets *SecretCipher
+
+	// secretsSealed is set once the key parameters are stored and the
+	// preimages that were stored in plain text were encrypted. From then
+	// on, a preimage in plain text is rejected instead of read.
+	secretsSealed bool
+
 	*sql.DB
 
 	*sqlc.Queries
 }
 
+// EnableSecretEncryption makes the store encrypt the secrets of new swaps
+// with the given cipher and decrypt the stored ones. Secrets that were stored
+// before encryption was enabled stay readable.
+func (db *BaseDB) EnableSecretEncryption(secrets *SecretCipher) {
+	db.secrets = secrets
+	db.secretsSealed = false
+}
+
+// EncryptStoredSecrets encrypts the preimages that were stored in plain text
+// before encryption was enabled. All preimages are encrypted in a single
+// transaction, so that a failure leaves the database unchanged. Preimages that
+// are already encrypted are left as they are. If the key parameters are
+// stored, preimages in plain text are rejected from then on. The preimages
+// are the only swap secrets that the store holds, the htlc keys are derived
+// from lnd and only their public keys and locators are stored.
+func (db *BaseDB) EncryptStoredSecrets(ctx context.Context) error {
+	if db.secrets == nil {
+		return errors.New("secret encryption not enabled")
+	}
+
+	var encrypted int
+	writeOpts := &SqliteTxOptions{}
+	err := db.ExecTx(ctx, writeOpts, func(tx *sqlc.Queries) error {
+		rows, err := tx.GetSwapPreimages(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			// Encrypted preimages are longer than plain text
+			// ones, see openPreimage.
+			if len(row.Preimage) != lntypes.PreimageSize {
+				continue
+			}
+
+			preimage, err := lntypes.MakePreimage(row.Preimage)
+			if err != nil {
+				return err
+			}
+
+			sealed, err := db.secrets.sealPreimage(preimage)
+			if err != nil {
+				return err
+			}
+
+			err = tx.UpdateSwapPreimage(
+				ctx, sqlc.UpdateSwapPreimageParams{
+					SwapHash: row.SwapHash,
+					Preimage: sealed,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			encrypted++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if encrypted > 0 {
+		log.Infof("Encrypted %v swap preimages that were stored in "+
+			"plain text", encrypted)
+	}
+
+	params, err := db.FetchSecretKeyParams(ctx)
+	if err != nil {
+		return err
+	}
+	db.secretsSealed = params != nil
+
+	return nil
+}
+
+// openPreimage reads a stored preimage with the cipher of the store. Once the
+// stored secrets are sealed, a preimage in plain text is rejected.
+func (db *BaseDB) openPreimage(stored []byte) (lntypes.Preimage, error) {
+	if db.secretsSealed && len(stored) == lntypes.PreimageSize {
+		return lntypes.Preimage{}, ErrPlaintextSecret
+	}
+
+	return db.secrets.openPreimage(stored)
+}
+
+// PutSecretKeyParams stores the parameters that the key of the swap secrets
+// was derived with, together with a key check that is encrypted with the
+// cipher of the store. Secret encryption must be enabled.
+func (db *BaseDB) PutSecretKeyParams(ctx context.Context,
+	params *KeyParams) error {
+
+	if db.secrets == nil {
+		return errors.New("secret encryption not enabled")
+	}
+
+	keyCheck, err := db.secrets.keyCheck()
+	if err != nil {
+		return err
+	}
+
+	return db.Queries.UpsertSecretKeyParams(
+		ctx, sqlc.UpsertSecretKeyParamsParams{
+			Scheme:   string(params.Scheme),
+			Salt:     params.Salt,
+			ScryptN:  int32(params.ScryptN),
+			ScryptR:  int32(params.ScryptR),
+			ScryptP:  int32(params.ScryptP),
+			KeyCheck: keyCheck,
+		},
+	)
+}
+
+// CheckSecretKey returns ErrWrongSecretKey if the swap secrets of the store
+// weren't encrypted with the key of the given cipher. The key is checked
+// against the stored key check, or against an encrypted preimage if there is
+// none. Nothing is written, so a key can be checked before it is enabled.
+func (db *BaseDB) CheckSecretKey(ctx context.Context,
+	secrets *SecretCipher) error {
+
+	row, err := db.Queries.FetchSecretKeyParams(ctx)
+	switch {
+	case err == nil && row.KeyCheck != nil:
+		return secrets.verifyKeyCheck(row.KeyCheck)
+
+	case err != nil && !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+
+	rows, err := db.Queries.GetSwapPreimages(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if len(row.Preimage) == lntypes.PreimageSize {
+			continue
+		}
+
+		_, err := secrets.openPreimage(row.Preimage)
+		return err
+	}
+
+	return nil
+}
+
+// SecretCipher returns the cipher that the store encrypts the swap secrets
+// with, or nil if secret encryption isn't enabled.
+func (db *BaseDB) SecretCipher() *SecretCipher {
+	return db.secrets
+}
+
+// FetchSecretKeyParams returns the stored parameters that the key of the swap
+// secrets was derived with, or nil if none are stored.
+func (db *BaseDB) FetchSecretKeyParams(ctx context.Context) (*KeyParams,
+	error) {
+
+	row, err := db.Queries.FetchSecretKeyParams(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &KeyParams{
+		Scheme:  KeyScheme(row.Scheme),
+		Salt:    row.Salt,
+		ScryptN: int(row.ScryptN),
+		ScryptR: int(row.ScryptR),
+		ScryptP: int(row.ScryptP),
+	}, nil
+}
+
 // BeginTx wraps the normal sql specific BeginTx method with the TxOptions
 // interface. This interface is then mapped to the concrete sql tx options
 // struct.
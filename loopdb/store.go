@@ -95,6 +95,14 @@ var (
 	// value: uint32 confirmation value
 	confirmationsKey = []byte("confirmations")
 
+	// metadataKey is the key that stores the optional metadata of a swap.
+	// Swaps without metadata don't have this key.
+	//
+	// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> metadataKey
+	//
+	// value: opaque metadata blob
+	metadataKey = []byte("metadata")
+
 	// liquidtyBucket is a root bucket used to save liquidity manager
 	// related info.
 	liquidityBucket = []byte("liquidity")
@@ -403,6 +411,10 @@ func (s *boltSwapStore) FetchLoopOutSwap(ctx context.Context,
 			return errors.New("bucket does not exist")
 		}
 
+		if rootBucket.Bucket(hash[:]) == nil {
+			return ErrSwapNotFound
+		}
+
 		loop, err := s.fetchLoopOutSwap(rootBucket, hash[:])
 		if err != nil {
 			return err
@@ -460,6 +472,41 @@ func (s *boltSwapStore) FetchLoopInSwaps(ctx context.Context) ([]*LoopIn,
 	return swaps, nil
 }
 
+// FetchLoopInSwap returns the loop in swap with the given hash.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchLoopInSwap(ctx context.Context,
+	hash lntypes.Hash) (*LoopIn, error) {
+
+	var swap *LoopIn
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		// First, we'll grab our main loop in bucket key.
+		rootBucket := tx.Bucket(loopInBucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		if rootBucket.Bucket(hash[:]) == nil {
+			return ErrSwapNotFound
+		}
+
+		loop, err := s.fetchLoopInSwap(rootBucket, hash[:])
+		if err != nil {
+			return err
+		}
+
+		swap = loop
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swap, nil
+}
+
 // createLoopBucket creates the bucket for a particular swap.
 func createLoopBucket(tx *bbolt.Tx, swapTypeKey []byte, hash lntypes.Hash) (
 	*bbolt.Bucket, error) {
@@ -535,6 +582,11 @@ func (s *boltSwapStore) CreateLoopOut(ctx context.Context, hash lntypes.Hash,
 			return err
 		}
 
+		err = putMetadata(swapBucket, swap.Metadata)
+		if err != nil {
+			return err
+		}
+
 		// Write our confirmation target under its own key.
 		var buf bytes.Buffer
 		err = binary.Write(&buf, byteOrder, swap.HtlcConfirmations)
@@ -612,6 +664,11 @@ func (s *boltSwapStore) CreateLoopIn(ctx context.Context, hash lntypes.Hash,
 			return err
 		}
 
+		err = putMetadata(swapBucket, swap.Metadata)
+		if err != nil {
+			return err
+		}
+
 		// Store the htlc keys and server key locator.
 		err = marshalHtlcKeys(swapBucket, &swap.SwapContract)
 		if err != nil {
@@ -694,6 +751,66 @@ func (s *boltSwapStore) UpdateLoopOut(ctx context.Context,
 	return s.updateLoop(loopOutBucketKey, hash, time, state)
 }
 
+// UpdateLoopOutSweepConfTarget changes the sweep confirmation target of a loop
+// out swap. The target is part of the serialized contract, which is rewritten.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) UpdateLoopOutSweepConfTarget(ctx context.Context,
+	hash lntypes.Hash, confTarget int32) error {
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(loopOutBucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+		swapBucket := rootBucket.Bucket(hash[:])
+		if swapBucket == nil {
+			return ErrSwapNotFound
+		}
+
+		contractBytes := swapBucket.Get(contractKey)
+		if contractBytes == nil {
+			return errors.New("contract not found")
+		}
+
+		contract, err := deserializeLoopOutContract(
+			contractBytes, s.chainParams,
+		)
+		if err != nil {
+			return err
+		}
+
+		contract.SweepConfTarget = confTarget
+
+		contractBytes, err = serializeLoopOutContract(contract)
+		if err != nil {
+			return err
+		}
+
+		return swapBucket.Put(contractKey, contractBytes)
+	})
+}
+
+// UpdateLoopOutSettlement isn't supported by the bolt store, as its contract
+// serialization has no room for the settlement of the payments.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) UpdateLoopOutSettlement(ctx context.Context,
+	hash lntypes.Hash, prepaySettled, swapPaymentSettled bool) error {
+
+	return errUnimplemented
+}
+
+// UpdateLoopOutSweepVerification isn't supported by the bolt store, as its
+// contract serialization has no room for the result of the sweep check.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) UpdateLoopOutSweepVerification(ctx context.Context,
+	hash lntypes.Hash, verified bool, discrepancy string) error {
+
+	return errUnimplemented
+}
+
 // UpdateLoopIn stores a swap update. This appends to the event log for
 // a particular swap as it goes through the various stages in its lifetime.
 //
@@ -753,6 +870,64 @@ func (s *boltSwapStore) FetchLiquidityParams(ctx context.Context) ([]byte,
 	return params, err
 }
 
+// FilterSwaps isn't supported by the bolt store, which is only kept to migrate
+// its swaps.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FilterSwaps(ctx context.Context, filter *SwapFilter) (
+	[]FilteredSwap, error) {
+
+	return nil, errUnimplemented
+}
+
+// AddTermsSnapshot isn't supported by the bolt store, which is only kept to
+// migrate its swaps.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) AddTermsSnapshot(ctx context.Context,
+	snapshot *TermsSnapshot, retention int) (bool, error) {
+
+	return false, errUnimplemented
+}
+
+// FetchTermsSnapshots returns no snapshots, as the bolt store doesn't store
+// them.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchTermsSnapshots(ctx context.Context) (
+	[]TermsSnapshot, error) {
+
+	return nil, nil
+}
+
+// CheckWritable verifies that the store accepts writes by writing the stored
+// liquidity parameters in a transaction that is rolled back.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) CheckWritable(ctx context.Context) error {
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(liquidityBucket)
+		if rootBucket == nil {
+			return errors.New("liquidity bucket does not exist")
+		}
+
+		params := rootBucket.Get(liquidtyParamsKey)
+		err := rootBucket.Put(
+			liquidtyParamsKey, append([]byte(nil), params...),
+		)
+		if err != nil {
+			return err
+		}
+
+		return errRollbackWriteCheck
+	})
+	if errors.Is(err, errRollbackWriteCheck) {
+		return nil
+	}
+
+	return err
+}
+
 // fetchUpdates deserializes the list of swap updates that are stored as a
 // key of the given bucket.
 func fetchUpdates(swapBucket *bbolt.Bucket) ([]*LoopEvent, error) {
@@ -836,6 +1011,7 @@ func (s *boltSwapStore) fetchLoopOutSwap(rootBucket *bbolt.Bucket,
 
 	// Get our label for this swap, if it is present.
 	contract.Label = getLabel(swapBucket)
+	contract.Metadata = getMetadata(swapBucket)
 
 	// Read the list of concatenated outgoing channel ids
 	// that form the outgoing set.
@@ -951,6 +1127,7 @@ func (s *boltSwapStore) fetchLoopInSwap(rootBucket *bbolt.Bucket,
 
 	// Get our label for this swap, if it is present.
 	contract.Label = getLabel(swapBucket)
+	contract.Metadata = getMetadata(swapBucket)
 
 	updates, err := fetchUpdates(swapBucket)
 	if err != nil {
@@ -989,6 +1166,16 @@ func (s *boltSwapStore) fetchLoopInSwap(rootBucket *bbolt.Bucket,
 	return &loop, nil
 }
 
+// FetchLoopOutSwapByClientID isn't supported by the bolt store, as its
+// contract serialization has no room for the client id.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchLoopOutSwapByClientID(ctx context.Context,
+	clientID string) (*LoopOut, error) {
+
+	return nil, errUnimplemented
+}
+
 // BatchCreateLoopOut creates a batch of swaps to the store.
 func (b *boltSwapStore) BatchCreateLoopOut(ctx context.Context,
 	swaps map[lntypes.Hash]*LoopOutContract) error {
@@ -1,11 +1,14 @@
 package loopdb
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/stretchr/testify/require"
@@ -23,6 +26,8 @@ type StoreMock struct {
 	loopInStoreChan  chan LoopInContract
 	loopInUpdateChan chan SwapStateData
 
+	TermsSnapshots []TermsSnapshot
+
 	t *testing.T
 }
 
@@ -78,7 +83,7 @@ func (s *StoreMock) FetchLoopOutSwap(ctx context.Context,
 
 	contract, ok := s.LoopOutSwaps[hash]
 	if !ok {
-		return nil, errors.New("swap not found")
+		return nil, ErrSwapNotFound
 	}
 
 	updates := s.LoopOutUpdates[hash]
@@ -100,6 +105,24 @@ func (s *StoreMock) FetchLoopOutSwap(ctx context.Context,
 	return swap, nil
 }
 
+// FetchLoopOutSwapByClientID returns the loop out swap that was assigned the
+// given client id.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) FetchLoopOutSwapByClientID(ctx context.Context,
+	clientID string) (*LoopOut, error) {
+
+	for hash, contract := range s.LoopOutSwaps {
+		if contract.ClientID != clientID {
+			continue
+		}
+
+		return s.FetchLoopOutSwap(ctx, hash)
+	}
+
+	return nil, ErrSwapNotFound
+}
+
 // CreateLoopOut adds an initiated swap to the store.
 //
 // NOTE: Part of the SwapStore interface.
@@ -146,6 +169,121 @@ func (s *StoreMock) FetchLoopInSwaps(ctx context.Context) ([]*LoopIn,
 	return result, nil
 }
 
+// FetchLoopInSwap returns the loop in swap with the given hash.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) FetchLoopInSwap(ctx context.Context,
+	hash lntypes.Hash) (*LoopIn, error) {
+
+	contract, ok := s.LoopInSwaps[hash]
+	if !ok {
+		return nil, ErrSwapNotFound
+	}
+
+	updates := s.LoopInUpdates[hash]
+	events := make([]*LoopEvent, len(updates))
+	for i, u := range updates {
+		events[i] = &LoopEvent{
+			SwapStateData: u,
+		}
+	}
+
+	return &LoopIn{
+		Loop: Loop{
+			Hash:   hash,
+			Events: events,
+		},
+		Contract: contract,
+	}, nil
+}
+
+// FilterSwaps returns the swaps that pass the filter.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) FilterSwaps(ctx context.Context, filter *SwapFilter) (
+	[]FilteredSwap, error) {
+
+	if filter == nil {
+		filter = &SwapFilter{}
+	}
+
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
+	type candidate struct {
+		FilteredSwap
+		initiationTime time.Time
+	}
+
+	lastState := func(updates []SwapStateData) SwapState {
+		if len(updates) == 0 {
+			return StateInitiated
+		}
+
+		return updates[len(updates)-1].State
+	}
+
+	var candidates []candidate
+	for hash, contract := range s.LoopOutSwaps {
+		state := lastState(s.LoopOutUpdates[hash])
+		if !filter.matches(swap.TypeOut, state,
+			contract.InitiationTime, contract.Label) {
+
+			continue
+		}
+
+		candidates = append(candidates, candidate{
+			FilteredSwap: FilteredSwap{
+				Hash: hash,
+				Type: swap.TypeOut,
+			},
+			initiationTime: contract.InitiationTime,
+		})
+	}
+
+	for hash, contract := range s.LoopInSwaps {
+		state := lastState(s.LoopInUpdates[hash])
+		if !filter.matches(swap.TypeIn, state,
+			contract.InitiationTime, contract.Label) {
+
+			continue
+		}
+
+		candidates = append(candidates, candidate{
+			FilteredSwap: FilteredSwap{
+				Hash: hash,
+				Type: swap.TypeIn,
+			},
+			initiationTime: contract.InitiationTime,
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if !a.initiationTime.Equal(b.initiationTime) {
+			return a.initiationTime.Before(b.initiationTime)
+		}
+
+		return bytes.Compare(a.Hash[:], b.Hash[:]) < 0
+	})
+
+	result := []FilteredSwap{}
+	for i, c := range candidates {
+		if i < filter.Offset {
+			continue
+		}
+
+		if filter.Limit > 0 && len(result) == filter.Limit {
+			break
+		}
+
+		result = append(result, c.FilteredSwap)
+	}
+
+	return result, nil
+}
+
 // CreateLoopIn adds an initiated loop in swap to the store.
 //
 // NOTE: Part of the SwapStore interface.
@@ -184,6 +322,59 @@ func (s *StoreMock) UpdateLoopOut(ctx context.Context, hash lntypes.Hash,
 	return nil
 }
 
+// UpdateLoopOutSweepConfTarget changes the sweep confirmation target of a loop
+// out swap.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) UpdateLoopOutSweepConfTarget(ctx context.Context,
+	hash lntypes.Hash, confTarget int32) error {
+
+	contract, ok := s.LoopOutSwaps[hash]
+	if !ok {
+		return ErrSwapNotFound
+	}
+
+	contract.SweepConfTarget = confTarget
+
+	return nil
+}
+
+// UpdateLoopOutSettlement records which of the off-chain payments of a loop
+// out swap the server has settled.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) UpdateLoopOutSettlement(ctx context.Context,
+	hash lntypes.Hash, prepaySettled, swapPaymentSettled bool) error {
+
+	contract, ok := s.LoopOutSwaps[hash]
+	if !ok {
+		return errors.New("swap does not exists")
+	}
+
+	contract.PrepaySettled = prepaySettled
+	contract.SwapPaymentSettled = swapPaymentSettled
+
+	return nil
+}
+
+// UpdateLoopOutSweepVerification records the result of checking the confirmed
+// sweep of a loop out swap against its destination.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) UpdateLoopOutSweepVerification(ctx context.Context,
+	hash lntypes.Hash, verified bool, discrepancy string) error {
+
+	contract, ok := s.LoopOutSwaps[hash]
+	if !ok {
+		return errors.New("swap does not exists")
+	}
+
+	contract.SweepVerified = verified
+	contract.SweepDiscrepancy = discrepancy
+
+	return nil
+}
+
 // UpdateLoopIn stores a new event for a target loop in swap. This appends to
 // the event log for a particular swap as it goes through the various stages in
 // its lifetime.
@@ -222,6 +413,43 @@ func (s *StoreMock) FetchLiquidityParams(ctx context.Context) ([]byte, error) {
 	return nil, nil
 }
 
+// AddTermsSnapshot stores the snapshot if its terms differ from the most
+// recent snapshot, and drops the oldest snapshots beyond the retention.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) AddTermsSnapshot(ctx context.Context,
+	snapshot *TermsSnapshot, retention int) (bool, error) {
+
+	count := len(s.TermsSnapshots)
+	if count > 0 && s.TermsSnapshots[count-1].sameTerms(snapshot) {
+		return false, nil
+	}
+
+	s.TermsSnapshots = append(s.TermsSnapshots, *snapshot)
+	if len(s.TermsSnapshots) > retention {
+		s.TermsSnapshots = s.TermsSnapshots[len(s.TermsSnapshots)-
+			retention:]
+	}
+
+	return true, nil
+}
+
+// FetchTermsSnapshots returns the stored terms snapshots, oldest first.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) FetchTermsSnapshots(ctx context.Context) ([]TermsSnapshot,
+	error) {
+
+	return append([]TermsSnapshot(nil), s.TermsSnapshots...), nil
+}
+
+// CheckWritable verifies that the store accepts writes.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) CheckWritable(ctx context.Context) error {
+	return nil
+}
+
 // Close closes the store.
 func (s *StoreMock) Close() error {
 	return nil
@@ -109,6 +109,12 @@ func TestLoopOutStore(t *testing.T) {
 	t.Run("labelled swap", func(t *testing.T) {
 		testLoopOutStore(t, &labelledSwap)
 	})
+
+	metadataSwap := unrestrictedSwap
+	metadataSwap.Metadata = []byte(`{"batch":42}`)
+	t.Run("metadata swap", func(t *testing.T) {
+		testLoopOutStore(t, &metadataSwap)
+	})
 }
 
 // testLoopOutStore tests the basic functionality of the current bbolt
@@ -184,6 +190,20 @@ func testLoopOutStore(t *testing.T, pendingSwap *LoopOutContract) {
 
 	checkSwap(StatePreimageRevealed)
 
+	// The sweep confirmation target is stored within the contract, which
+	// is rewritten with the new target.
+	err = store.UpdateLoopOutSweepConfTarget(ctxb, hash, 6)
+	require.NoError(t, err)
+
+	err = store.UpdateLoopOutSweepConfTarget(ctxb, lntypes.Hash{9}, 6)
+	require.ErrorIs(t, err, ErrSwapNotFound)
+
+	updatedSwap := *pendingSwap
+	updatedSwap.SweepConfTarget = 6
+	pendingSwap = &updatedSwap
+
+	checkSwap(StatePreimageRevealed)
+
 	// Next, we'll update to the final state to ensure that the state is
 	// properly updated.
 	err = store.UpdateLoopOut(
@@ -253,6 +273,12 @@ func TestLoopInStore(t *testing.T) {
 	t.Run("loop in with label", func(t *testing.T) {
 		testLoopInStore(t, labelledSwap)
 	})
+
+	metadataSwap := pendingSwap
+	metadataSwap.Metadata = []byte(`{"batch":42}`)
+	t.Run("loop in with metadata", func(t *testing.T) {
+		testLoopInStore(t, metadataSwap)
+	})
 }
 
 func testLoopInStore(t *testing.T, pendingSwap LoopInContract) {
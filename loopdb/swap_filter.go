@@ -0,0 +1,106 @@
+package loopdb
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// maxFilterState is the highest swap state that a state type filter selects.
+const maxFilterState = StateFailInvoiceExpired
+
+// SwapFilter selects the swaps that FilterSwaps returns. Unset fields don't
+// restrict the result.
+type SwapFilter struct {
+	// SwapType restricts the result to loop ins or loop outs.
+	SwapType *swap.Type
+
+	// StateType restricts the result to swaps whose latest state is of
+	// this type.
+	StateType *SwapStateType
+
+	// InitiatedAfter restricts the result to swaps that were initiated at
+	// or after this time.
+	InitiatedAfter time.Time
+
+	// InitiatedBefore restricts the result to swaps that were initiated
+	// before this time.
+	InitiatedBefore time.Time
+
+	// Label restricts the result to swaps with this label.
+	Label string
+
+	// Offset is the number of matching swaps that are skipped.
+	Offset int
+
+	// Limit is the maximum number of swaps that are returned. Zero means
+	// no limit.
+	Limit int
+}
+
+// FilteredSwap identifies a swap that passed a swap filter.
+type FilteredSwap struct {
+	// Hash is the hash of the swap.
+	Hash lntypes.Hash
+
+	// Type is the type of the swap.
+	Type swap.Type
+}
+
+// validate checks that the offset and the limit of the filter are within the
+// range that the store supports.
+func (f *SwapFilter) validate() error {
+	if f.Offset < 0 || f.Limit < 0 {
+		return errors.New("offset and limit must not be negative")
+	}
+
+	if f.Offset > math.MaxInt32 || f.Limit > math.MaxInt32 {
+		return errors.New("offset and limit must fit into 32 bits")
+	}
+
+	return nil
+}
+
+// matches returns true if a swap with the given properties passes the filter.
+// The offset and the limit are not applied.
+func (f *SwapFilter) matches(swapType swap.Type, state SwapState,
+	initiationTime time.Time, label string) bool {
+
+	if f.SwapType != nil && swapType != *f.SwapType {
+		return false
+	}
+
+	if f.StateType != nil && state.Type() != *f.StateType {
+		return false
+	}
+
+	if !f.InitiatedAfter.IsZero() &&
+		initiationTime.Before(f.InitiatedAfter) {
+
+		return false
+	}
+
+	if !f.InitiatedBefore.IsZero() &&
+		!initiationTime.Before(f.InitiatedBefore) {
+
+		return false
+	}
+
+	return f.Label == "" || label == f.Label
+}
+
+// stateMask returns a bit mask with the bits of all swap states of the given
+// type set. The store selects swaps by the bit of their latest state.
+func stateMask(stateType SwapStateType) int32 {
+	var mask int32
+	for state := StateInitiated; state <= maxFilterState; state++ {
+		if state.Type() == stateType {
+			mask |= 1 << state
+		}
+	}
+
+	return mask
+}
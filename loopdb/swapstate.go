@@ -36,7 +36,8 @@ const (
 
 	// StateFailTimeout indicates that the on-chain htlc wasn't confirmed
 	// before its expiry or confirmed too late (MinPreimageRevealDelta
-	// violated).
+	// violated). For loop outs with a htlc conf timeout, it also indicates
+	// that the htlc wasn't confirmed within the timeout.
 	StateFailTimeout SwapState = 4
 
 	// StateFailSweepTimeout indicates that the on-chain htlc wasn't swept
@@ -77,6 +78,10 @@ const (
 	// externally published loop in htlc that didn't match the swap amount
 	// has been swept back to the user after the htlc timeout period.
 	StateFailIncorrectHtlcAmtSwept SwapState = 13
+
+	// StateFailInvoiceExpired indicates that a loop out failed because the
+	// swap or prepay invoice expired before it could be paid.
+	StateFailInvoiceExpired SwapState = 14
 )
 
 // SwapStateType defines the types of swap states that exist. Every swap state
@@ -165,6 +170,9 @@ func (s SwapState) String() string {
 	case StateFailIncorrectHtlcAmtSwept:
 		return "StateFailIncorrectHtlcAmtSwept"
 
+	case StateFailInvoiceExpired:
+		return "FailInvoiceExpired"
+
 	default:
 		return "Unknown"
 	}
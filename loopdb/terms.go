@@ -0,0 +1,119 @@
+package loopdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+)
+
+// TermsSnapshot is a set of loop out terms that was observed from the server,
+// along with the time at which the terms were first fetched.
+type TermsSnapshot struct {
+	// Time is the time at which the terms were first fetched.
+	Time time.Time
+
+	// MinSwapAmount is the minimum amount that the server requires for a
+	// swap.
+	MinSwapAmount btcutil.Amount
+
+	// MaxSwapAmount is the maximum amount that the server accepts for a
+	// swap.
+	MaxSwapAmount btcutil.Amount
+
+	// MinCltvDelta is the minimum expiry delta for loop out swaps.
+	MinCltvDelta int32
+
+	// MaxCltvDelta is the maximum expiry delta for loop out swaps.
+	MaxCltvDelta int32
+
+	// AmountIncrement is the increment in which the server accepts swap
+	// amounts. Zero means that any amount is accepted.
+	AmountIncrement btcutil.Amount
+}
+
+// sameTerms returns true if both snapshots hold the same terms, regardless of
+// the time at which they were fetched.
+func (t *TermsSnapshot) sameTerms(other *TermsSnapshot) bool {
+	return t.MinSwapAmount == other.MinSwapAmount &&
+		t.MaxSwapAmount == other.MaxSwapAmount &&
+		t.MinCltvDelta == other.MinCltvDelta &&
+		t.MaxCltvDelta == other.MaxCltvDelta &&
+		t.AmountIncrement == other.AmountIncrement
+}
+
+// AddTermsSnapshot stores the snapshot if its terms differ from the most
+// recent snapshot, and drops the oldest snapshots so that at most retention
+// snapshots are kept. It returns true if the snapshot was added.
+func (s *BaseDB) AddTermsSnapshot(ctx context.Context, snapshot *TermsSnapshot,
+	retention int) (bool, error) {
+
+	var added bool
+
+	writeOpts := &SqliteTxOptions{}
+	err := s.ExecTx(ctx, writeOpts, func(tx *sqlc.Queries) error {
+		latest, err := tx.GetLatestTermsSnapshot(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+
+		case err != nil:
+			return err
+
+		case convertTermsSnapshot(latest).sameTerms(snapshot):
+			return nil
+		}
+
+		err = tx.InsertTermsSnapshot(ctx, sqlc.InsertTermsSnapshotParams{
+			FetchedAt:       snapshot.Time.UTC(),
+			MinSwapAmount:   int64(snapshot.MinSwapAmount),
+			MaxSwapAmount:   int64(snapshot.MaxSwapAmount),
+			MinCltvDelta:    snapshot.MinCltvDelta,
+			MaxCltvDelta:    snapshot.MaxCltvDelta,
+			AmountIncrement: int64(snapshot.AmountIncrement),
+		})
+		if err != nil {
+			return err
+		}
+
+		added = true
+
+		return tx.DeleteOldTermsSnapshots(ctx, int32(retention))
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return added, nil
+}
+
+// FetchTermsSnapshots returns the stored terms snapshots, oldest first.
+func (s *BaseDB) FetchTermsSnapshots(ctx context.Context) ([]TermsSnapshot,
+	error) {
+
+	rows, err := s.Queries.GetTermsSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]TermsSnapshot, len(rows))
+	for i, row := range rows {
+		snapshots[i] = *convertTermsSnapshot(row)
+	}
+
+	return snapshots, nil
+}
+
+// convertTermsSnapshot converts a stored terms snapshot.
+func convertTermsSnapshot(row sqlc.TermsSnapshot) *TermsSnapshot {
+	return &TermsSnapshot{
+		Time:            row.FetchedAt.UTC(),
+		MinSwapAmount:   btcutil.Amount(row.MinSwapAmount),
+		MaxSwapAmount:   btcutil.Amount(row.MaxSwapAmount),
+		MinCltvDelta:    row.MinCltvDelta,
+		MaxCltvDelta:    row.MaxCltvDelta,
+		AmountIncrement: btcutil.Amount(row.AmountIncrement),
+	}
+}
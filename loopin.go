@@ -2,7 +2,6 @@ package loop
 
 import (
 	"context"
-	"crypto/rand"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -53,6 +52,21 @@ var (
 	// ErrSwapFinalized is returned when a to be executed swap is already in
 	// a final state.
 	ErrSwapFinalized = errors.New("swap is in a final state")
+
+	// ErrInvoiceMemoTooLong is returned when a loop in is requested with
+	// an invoice memo that exceeds MaxInvoiceMemoSize.
+	ErrInvoiceMemoTooLong = errors.New("invoice memo too long")
+)
+
+const (
+	// defaultSwapInvoiceMemo is the memo of the loop in swap invoice if
+	// the user didn't provide one.
+	defaultSwapInvoiceMemo = "swap"
+
+	// MaxInvoiceMemoSize is the maximum size in bytes of the memo of a
+	// loop in swap invoice, which is the longest description that lnd
+	// encodes in an invoice.
+	MaxInvoiceMemoSize = 639
 )
 
 // loopInSwap contains all the in-memory state related to a pending loop in
@@ -148,12 +162,12 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	// successful swap.
 	swapInvoiceAmt := request.Amount - swapFee
 
-	// Generate random preimage.
-	var swapPreimage lntypes.Preimage
-	if _, err := rand.Read(swapPreimage[:]); err != nil {
-		log.Error("Cannot generate preimage")
+	swapPreimage, err := cfg.newPreimage()
+	if err != nil {
+		return nil, err
 	}
 	swapHash := lntypes.Hash(sha256.Sum256(swapPreimage[:]))
+	swapLog := newSwapLogger(swapHash)
 
 	// Derive a sender key for this swap.
 	keyDesc, err := cfg.lnd.WalletKit.DeriveNextKey(
@@ -166,11 +180,16 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	copy(senderKey[:], keyDesc.PubKey.SerializeCompressed())
 
 	// Create the swap invoice in lnd.
+	memo := request.InvoiceMemo
+	if memo == "" {
+		memo = defaultSwapInvoiceMemo
+	}
+
 	_, swapInvoice, err := cfg.lnd.Client.AddInvoice(
 		globalCtx, &invoicesrpc.AddInvoiceData{
 			Preimage:   &swapPreimage,
 			Value:      lnwire.NewMSatFromSatoshis(swapInvoiceAmt),
-			Memo:       "swap",
+			Memo:       memo,
 			Expiry:     3600 * 24 * 365,
 			RouteHints: request.RouteHints,
 		},
@@ -185,7 +204,7 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	probeHash := lntypes.Hash(sha256.Sum256(swapHash[:]))
 	probeHash[0] ^= 1
 
-	log.Infof("Creating probe invoice %v", probeHash)
+	swapLog.Infof("Creating probe invoice %v", probeHash)
 	probeInvoice, err := cfg.lnd.Invoices.AddHoldInvoice(
 		globalCtx, &invoicesrpc.AddInvoiceData{
 			Hash:       &probeHash,
@@ -220,7 +239,9 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	probeWaitCtx, probeWaitCancel := context.WithCancel(globalCtx)
 
 	// Launch a goroutine to monitor the probe.
-	probeResult, err := awaitProbe(probeWaitCtx, *cfg.lnd, probeHash)
+	probeResult, err := awaitProbe(
+		probeWaitCtx, *cfg.lnd, probeHash, swapLog,
+	)
 	if err != nil {
 		probeWaitCancel()
 		return nil, fmt.Errorf("probe failed: %v", err)
@@ -229,7 +250,7 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	// Post the swap parameters to the swap server. The response contains
 	// the server success key and the expiry height of the on-chain swap
 	// htlc.
-	log.Infof("Initiating swap request at height %v", currentHeight)
+	swapLog.Infof("Initiating swap request at height %v", currentHeight)
 	swapResp, err := cfg.server.NewLoopInSwap(globalCtx, swapHash,
 		request.Amount, senderKey, senderInternalPubKey, swapInvoice,
 		probeInvoice, request.LastHop, request.Initiator,
@@ -277,10 +298,20 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 			MaxMinerFee:     request.MaxMinerFee,
 			MaxSwapFee:      request.MaxSwapFee,
 			Label:           request.Label,
+			Metadata:        request.Metadata,
 			ProtocolVersion: loopdb.CurrentProtocolVersion(),
 		},
 	}
 
+	// Store the quote that the swap is based on, so that its costs can
+	// later be compared with the quoted ones.
+	if request.Quote != nil {
+		contract.Quote = &loopdb.SwapQuote{
+			SwapFee:  request.Quote.SwapFee,
+			MinerFee: request.Quote.MinerFee,
+		}
+	}
+
 	// For MuSig2 swaps we store the proper internal keys that we generated
 	// and received from the server.
 	if loopdb.CurrentProtocolVersion() >= loopdb.ProtocolVersionMuSig2 {
@@ -324,9 +355,10 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 }
 
 // awaitProbe waits for a probe payment to arrive and cancels it. This is a
-// workaround for the current lack of multi-path probing.
+// workaround for the current lack of multi-path probing. The outcome is logged
+// with the logger of the swap that is probed for.
 func awaitProbe(ctx context.Context, lnd lndclient.LndServices,
-	probeHash lntypes.Hash) (chan error, error) {
+	probeHash lntypes.Hash, swapLog *swap.PrefixLog) (chan error, error) {
 
 	// Subscribe to the probe invoice.
 	updateChan, errChan, err := lnd.Invoices.SubscribeSingleInvoice(
@@ -345,7 +377,7 @@ func awaitProbe(ctx context.Context, lnd lndclient.LndServices,
 			case update := <-updateChan:
 				switch update.State {
 				case invpkg.ContractAccepted:
-					log.Infof("Server probe successful")
+					swapLog.Infof("Server probe successful")
 					probeResult <- nil
 
 					// Cancel probe invoice so that the
@@ -355,7 +387,7 @@ func awaitProbe(ctx context.Context, lnd lndclient.LndServices,
 						ctx, probeHash,
 					)
 					if err != nil {
-						log.Errorf("Cancel probe "+
+						swapLog.Errorf("Cancel probe "+
 							"invoice: %v", err)
 					}
 
@@ -396,13 +428,13 @@ func resumeLoopInSwap(_ context.Context, cfg *swapConfig,
 
 	hash := lntypes.Hash(sha256.Sum256(pend.Contract.Preimage[:]))
 
-	log.Infof("Resuming loop in swap %v", hash)
-
 	swapKit := newSwapKit(
 		hash, swap.TypeIn, cfg,
 		&pend.Contract.SwapContract,
 	)
 
+	swapKit.log.Infof("Resuming loop in swap")
+
 	swap := &loopInSwap{
 		LoopInContract: *pend.Contract,
 		swapKit:        *swapKit,
@@ -467,7 +499,9 @@ func (s *loopInSwap) initHtlcs() error {
 	return nil
 }
 
-// sendUpdate reports an update to the swap state.
+// sendUpdate reports an update to the swap state. It blocks until the update
+// has been received, which guarantees that the updates of this swap are
+// delivered in order as long as they are only sent from the swap's goroutine.
 func (s *loopInSwap) sendUpdate(ctx context.Context) error {
 	info := s.swapInfo()
 	s.log.Infof("Loop in swap state: %v", info.State)
@@ -497,6 +531,12 @@ func (s *loopInSwap) sendUpdate(ctx context.Context) error {
 	return nil
 }
 
+// affectedByClose returns whether the swap payment is restricted to arrive
+// through the peer of the closed channel.
+func (s *loopInSwap) affectedByClose(channel *lndclient.ClosedChannel) bool {
+	return s.LastHop != nil && *s.LastHop == channel.PubKeyBytes
+}
+
 // execute starts/resumes the swap. It is a thin wrapper around executeSwap to
 // conveniently handle the error case.
 func (s *loopInSwap) execute(mainCtx context.Context,
@@ -633,7 +673,7 @@ func (s *loopInSwap) executeSwap(globalCtx context.Context) error {
 		s.setState(loopdb.StateFailIncorrectHtlcAmt)
 		err = s.persistAndAnnounceState(globalCtx)
 		if err != nil {
-			log.Errorf("Error persisting state: %v", err)
+			s.log.Errorf("Error persisting state: %v", err)
 		}
 	}
 
@@ -765,8 +805,8 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 		return false, s.persistAndAnnounceState(ctx)
 	}
 
-	// Get fee estimate from lnd.
-	feeRate, err := s.lnd.WalletKit.EstimateFeeRate(
+	// Get fee estimate from lnd, unless a static fee rate is set.
+	feeRate, err := s.sweeper.EstimateFeeRate(
 		ctx, s.LoopInContract.HtlcConfTarget,
 	)
 	if err != nil {
@@ -999,7 +1039,7 @@ func (s *loopInSwap) tryPushHtlcKey(ctx context.Context) bool {
 		return false
 	}
 
-	log.Infof("Attempting to reveal internal HTLC key to the server")
+	s.log.Infof("Attempting to reveal internal HTLC key to the server")
 
 	internalPrivKey, err := sharedSecretFromHash(
 		ctx, s.swapConfig.lnd.Signer, s.hash,
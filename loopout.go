@@ -1,8 +1,8 @@
 package loop
 
 import (
+	"bytes"
 	"context"
-	"crypto/rand"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -12,7 +12,9 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
@@ -24,6 +26,8 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 )
 
 const (
@@ -59,6 +63,15 @@ var (
 	//
 	// TODO(wilmer): tune?
 	DefaultSweepConfTargetDelta = DefaultSweepConfTarget * 2
+
+	// sweepHoldMargin is the number of blocks before the last height at
+	// which the preimage may be revealed, from which on a sweep that is
+	// held back for lower fees is published regardless of the fee rate.
+	sweepHoldMargin = DefaultSweepConfTargetDelta
+
+	// errHtlcReorged is returned while waiting for the htlc spend if the
+	// htlc tx was reorged out.
+	errHtlcReorged = errors.New("htlc tx reorged out")
 )
 
 // loopOutSwap contains all the in-memory state related to a pending loop out
@@ -80,6 +93,14 @@ type loopOutSwap struct {
 	swapPaymentChan chan paymentResult
 	prePaymentChan  chan paymentResult
 
+	// abandonChan receives a signal if the client cancels the swap.
+	abandonChan chan struct{}
+
+	// sweepPublishErr is the last error that the batcher reported for
+	// publishing the sweep of the swap. It is cleared once the htlc is
+	// spent.
+	sweepPublishErr string
+
 	wg sync.WaitGroup
 }
 
@@ -93,6 +114,7 @@ type executeConfig struct {
 	loopOutMaxParts     uint32
 	totalPaymentTimeout time.Duration
 	maxPaymentRetries   int
+	repushDelay         time.Duration
 	cancelSwap          func(context.Context, *outCancelDetails) error
 	verifySchnorrSig    func(pubKey *btcec.PublicKey, hash, sig []byte) error
 }
@@ -108,12 +130,12 @@ type loopOutInitResult struct {
 func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 	currentHeight int32, request *OutRequest) (*loopOutInitResult, error) {
 
-	// Generate random preimage.
-	var swapPreimage [32]byte
-	if _, err := rand.Read(swapPreimage[:]); err != nil {
-		log.Error("Cannot generate preimage")
+	swapPreimage, err := cfg.newPreimage()
+	if err != nil {
+		return nil, err
 	}
 	swapHash := lntypes.Hash(sha256.Sum256(swapPreimage[:]))
+	swapLog := newSwapLogger(swapHash)
 
 	// Derive a receiver key for this swap.
 	keyDesc, err := cfg.lnd.WalletKit.DeriveNextKey(
@@ -127,7 +149,7 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 
 	// Post the swap parameters to the swap server. The response contains
 	// the server revocation key and the swap and prepay invoices.
-	log.Infof("Initiating swap request at height %v: amt=%v, expiry=%v",
+	swapLog.Infof("Initiating swap request at height %v: amt=%v, expiry=%v",
 		currentHeight, request.Amount, request.Expiry)
 
 	// The swap deadline will be given to the server for it to use as the
@@ -170,6 +192,10 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 		SwapInvoice:             swapResp.swapInvoice,
 		DestAddr:                request.DestAddr,
 		IsExternalAddr:          request.IsExternalAddr,
+		ClientID:                request.ClientID,
+		SweepWhenFeeBelow:       request.SweepWhenFeeBelow,
+		HtlcConfTimeout:         request.HtlcConfTimeout,
+		SweepFeeRate:            request.SweepFeeRate,
 		MaxSwapRoutingFee:       request.MaxSwapRoutingFee,
 		SweepConfTarget:         request.SweepConfTarget,
 		HtlcConfirmations:       confs,
@@ -192,11 +218,22 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 			MaxMinerFee:     request.MaxMinerFee,
 			MaxSwapFee:      request.MaxSwapFee,
 			Label:           request.Label,
+			Metadata:        request.Metadata,
 			ProtocolVersion: loopdb.CurrentProtocolVersion(),
 		},
 		OutgoingChanSet: chanSet,
 	}
 
+	// Store the quote that the swap is based on, so that its costs can
+	// later be compared with the quoted ones.
+	if request.Quote != nil {
+		contract.Quote = &loopdb.SwapQuote{
+			SwapFee:      request.Quote.SwapFee,
+			PrepayAmount: request.Quote.PrepayAmount,
+			MinerFee:     request.Quote.MinerFee,
+		}
+	}
+
 	swapKit := newSwapKit(
 		swapHash, swap.TypeOut, cfg, &contract.SwapContract,
 	)
@@ -229,6 +266,16 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 		swapKit:                *swapKit,
 		htlc:                   htlc,
 		swapInvoicePaymentAddr: *paymentAddr,
+		abandonChan:            make(chan struct{}, 1),
+	}
+
+	// As a last line of defense before the swap is persisted, recompute
+	// the hash from the preimage that we're going to store and check it
+	// against the hash that the invoices and the htlc are based on. If
+	// they ever diverged, we wouldn't be able to sweep the htlc.
+	err = verifySwapHash(swap.Preimage, swapHash, swap.SwapInvoice, cfg.lnd)
+	if err != nil {
+		return nil, err
 	}
 
 	// Persist the data before exiting this function, so that the caller
@@ -255,12 +302,12 @@ func resumeLoopOutSwap(cfg *swapConfig, pend *loopdb.LoopOut,
 
 	hash := lntypes.Hash(sha256.Sum256(pend.Contract.Preimage[:]))
 
-	log.Infof("Resuming loop out swap %v", hash)
-
 	swapKit := newSwapKit(
 		hash, swap.TypeOut, cfg, &pend.Contract.SwapContract,
 	)
 
+	swapKit.log.Infof("Resuming loop out swap")
+
 	// Create the htlc.
 	htlc, err := utils.GetHtlc(
 		swapKit.hash, swapKit.contract, swapKit.lnd.ChainParams,
@@ -287,6 +334,7 @@ func resumeLoopOutSwap(cfg *swapConfig, pend *loopdb.LoopOut,
 		swapKit:                *swapKit,
 		htlc:                   htlc,
 		swapInvoicePaymentAddr: *paymentAddr,
+		abandonChan:            make(chan struct{}, 1),
 	}
 
 	lastUpdate := pend.LastUpdate()
@@ -301,11 +349,26 @@ func resumeLoopOutSwap(cfg *swapConfig, pend *loopdb.LoopOut,
 	return swap, nil
 }
 
-// sendUpdate reports an update to the swap state.
+// sendUpdate reports an update to the swap state. It blocks until the update
+// has been received, which guarantees that the updates of this swap are
+// delivered in order as long as they are only sent from the swap's goroutine.
 func (s *loopOutSwap) sendUpdate(ctx context.Context) error {
 	info := s.swapInfo()
 	s.log.Infof("Loop out swap state: %v", info.State)
 
+	info.PrepaySettled = s.PrepaySettled
+	info.SwapPaymentSettled = s.SwapPaymentSettled
+	forfeited, err := forfeitedPrepay(
+		s.state, &s.LoopOutContract, s.lnd.ChainParams,
+	)
+	if err != nil {
+		return err
+	}
+	info.PrepayForfeited = forfeited
+	info.SweepVerified = s.SweepVerified
+	info.SweepDiscrepancy = s.SweepDiscrepancy
+	info.SweepPublishError = s.sweepPublishErr
+
 	if s.htlc.OutputType == swap.HtlcP2WSH {
 		info.HtlcAddressP2WSH = s.htlc.Address
 	} else {
@@ -330,6 +393,18 @@ func (s *loopOutSwap) sendUpdate(ctx context.Context) error {
 	return nil
 }
 
+// affectedByClose returns whether the swap payment is restricted to a set of
+// outgoing channels that includes the closed channel.
+func (s *loopOutSwap) affectedByClose(channel *lndclient.ClosedChannel) bool {
+	for _, chanID := range s.OutgoingChanSet {
+		if chanID == channel.ChannelID {
+			return true
+		}
+	}
+
+	return false
+}
+
 // execute starts/resumes the swap. It is a thin wrapper around
 // executeAndFinalize to conveniently handle the error case.
 func (s *loopOutSwap) execute(mainCtx context.Context,
@@ -402,7 +477,7 @@ func (s *loopOutSwap) executeAndFinalize(globalCtx context.Context) error {
 		case result := <-s.swapPaymentChan:
 			s.swapPaymentChan = nil
 
-			err := s.handlePaymentResult(result)
+			err := s.handlePaymentResult(result, true)
 			if err != nil {
 				return err
 			}
@@ -415,10 +490,15 @@ func (s *loopOutSwap) executeAndFinalize(globalCtx context.Context) error {
 				continue
 			}
 
+			err = s.setPaymentSettled(globalCtx, true)
+			if err != nil {
+				return err
+			}
+
 		case result := <-s.prePaymentChan:
 			s.prePaymentChan = nil
 
-			err := s.handlePaymentResult(result)
+			err := s.handlePaymentResult(result, false)
 			if err != nil {
 				return err
 			}
@@ -431,11 +511,28 @@ func (s *loopOutSwap) executeAndFinalize(globalCtx context.Context) error {
 				continue
 			}
 
+			err = s.setPaymentSettled(globalCtx, false)
+			if err != nil {
+				return err
+			}
+
 		case <-globalCtx.Done():
 			return globalCtx.Err()
 		}
 	}
 
+	// The server doesn't refund the prepayment of a failed swap, so we
+	// make the loss explicit.
+	forfeited, err := forfeitedPrepay(
+		s.state, &s.LoopOutContract, s.lnd.ChainParams,
+	)
+	if err != nil {
+		return err
+	}
+	if forfeited > 0 {
+		s.log.Warnf("Prepayment of %v forfeited", forfeited)
+	}
+
 	// Mark swap completed in store.
 	s.log.Infof("Swap completed: %v "+
 		"(final cost: server %v, onchain %v, offchain %v)",
@@ -448,7 +545,11 @@ func (s *loopOutSwap) executeAndFinalize(globalCtx context.Context) error {
 	return s.persistState(globalCtx)
 }
 
-func (s *loopOutSwap) handlePaymentResult(result paymentResult) error {
+// handlePaymentResult adds the costs of a settled swap payment or prepayment
+// to the swap costs.
+func (s *loopOutSwap) handlePaymentResult(result paymentResult,
+	swapPayment bool) error {
+
 	switch {
 	// If our result has a non-nil error, our status will be nil. In this
 	// case the payment failed so we do not need to take any action.
@@ -456,8 +557,12 @@ func (s *loopOutSwap) handlePaymentResult(result paymentResult) error {
 		return nil
 
 	case result.status.State == lnrpc.Payment_SUCCEEDED:
-		s.cost.Server += result.status.Value.ToSatoshis() -
-			s.AmountRequested
+		// Only the swap payment carries the swap amount, the whole
+		// prepayment counts towards the server fee.
+		s.cost.Server += result.status.Value.ToSatoshis()
+		if swapPayment {
+			s.cost.Server -= s.AmountRequested
+		}
 		s.cost.Offchain += result.status.Fee.ToSatoshis()
 
 		return nil
@@ -470,6 +575,52 @@ func (s *loopOutSwap) handlePaymentResult(result paymentResult) error {
 	}
 }
 
+// setPaymentSettled stores that the server settled the swap payment or the
+// prepayment, and sends a status update that shows the settlement.
+func (s *loopOutSwap) setPaymentSettled(ctx context.Context,
+	swapPayment bool) error {
+
+	if swapPayment {
+		s.SwapPaymentSettled = true
+		s.log.Infof("Swap payment settled")
+	} else {
+		s.PrepaySettled = true
+		s.log.Infof("Prepayment settled")
+	}
+
+	err := s.store.UpdateLoopOutSettlement(
+		ctx, s.hash, s.PrepaySettled, s.SwapPaymentSettled,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.sendUpdate(ctx)
+}
+
+// forfeitedPrepay returns the prepayment that was lost because a loop out
+// failed after the server settled the prepayment but not the swap payment. The
+// prepayment is the amount of the prepay invoice, so that it can be derived
+// from the stored contract of a swap.
+func forfeitedPrepay(state loopdb.SwapState, contract *loopdb.LoopOutContract,
+	chainParams *chaincfg.Params) (btcutil.Amount, error) {
+
+	if state.Type() != loopdb.StateTypeFail || !contract.PrepaySettled ||
+		contract.SwapPaymentSettled {
+
+		return 0, nil
+	}
+
+	_, _, _, prepay, err := swap.DecodeInvoice(
+		chainParams, contract.PrepayInvoice,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("prepay invoice: %v", err)
+	}
+
+	return prepay, nil
+}
+
 // executeSwap executes the swap, but returns as soon as the swap outcome is
 // final. At that point, there may still be pending off-chain payment(s).
 func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
@@ -481,17 +632,89 @@ func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
 	// payments in a previous run, we cannot just abandon here.
 	s.payInvoices(globalCtx)
 
+	var (
+		htlcOutpoint *wire.OutPoint
+		htlcValue    btcutil.Amount
+		spend        *sweepbatcher.SpendDetail
+		err          error
+	)
+	for {
+		htlcOutpoint, htlcValue, spend, err = s.waitForHtlcSpend(
+			globalCtx,
+		)
+		if !errors.Is(err, errHtlcReorged) {
+			break
+		}
+
+		// The htlc tx is no longer in the chain, so we wait for an
+		// htlc to confirm again. This may be a different tx.
+		s.log.Warnf("Htlc tx %v was reorged out, waiting for the htlc "+
+			"to confirm again", s.htlcTxHash)
+
+		s.htlcTxHash = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// If spend details are nil, we resolved the swap without waiting for
+	// its spend, so we can exit.
+	if spend == nil {
+		return nil
+	}
+
+	// Inspect witness stack to see if it is a success transaction. We
+	// don't just try to match with the hash of our sweep tx, because it
+	// may be swept by a different (fee) sweep tx from a previous run.
+	htlcInput, err := swap.GetTxInputByOutpoint(
+		spend.Tx, htlcOutpoint,
+	)
+	if err != nil {
+		return err
+	}
+
+	sweepSuccessful := s.htlc.IsSuccessWitness(htlcInput.Witness)
+	if sweepSuccessful {
+		s.cost.Onchain = spend.OnChainFeePortion
+		s.state = loopdb.StateSuccess
+
+		err := s.verifySweep(
+			globalCtx, spend.Tx, htlcValue-spend.OnChainFeePortion,
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		s.state = loopdb.StateFailSweepTimeout
+	}
+
+	return nil
+}
+
+// waitForHtlcSpend waits for the htlc to confirm and then for its spend to
+// confirm. It returns the htlc outpoint and value and the final spend, or
+// errHtlcReorged if the htlc tx was reorged out meanwhile. If the swap was
+// resolved without a spend, the returned spend is nil.
+func (s *loopOutSwap) waitForHtlcSpend(globalCtx context.Context) (
+	*wire.OutPoint, btcutil.Amount, *sweepbatcher.SpendDetail, error) {
+
+	// The htlc confirmation stays registered until the spend confirmed,
+	// so that we learn about a reorg of the htlc tx.
+	htlcCtx, cancel := context.WithCancel(globalCtx)
+	defer cancel()
+
 	// Wait for confirmation of the on-chain htlc by watching for a tx
 	// producing the swap script output.
-	txConf, err := s.waitForConfirmedHtlc(globalCtx)
+	htlcReorgChan := make(chan struct{}, 1)
+	txConf, err := s.waitForConfirmedHtlc(htlcCtx, htlcReorgChan)
 	if err != nil {
-		return err
+		return nil, 0, nil, err
 	}
 
 	// If no error and no confirmation, the swap is aborted without an
 	// error. The swap state has been updated to a final state.
 	if txConf == nil {
-		return nil
+		return nil, 0, nil, nil
 	}
 
 	// TODO: Off-chain payments can be canceled here. Most probably the HTLC
@@ -504,53 +727,116 @@ func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
 		txConf.Tx, s.htlc.PkScript,
 	)
 	if err != nil {
-		return err
+		return nil, 0, nil, err
 	}
 
 	s.log.Infof("Htlc value: %v", htlcValue)
 
 	// Verify amount if preimage hasn't been revealed yet.
 	if s.state != loopdb.StatePreimageRevealed && htlcValue < s.AmountRequested {
-		log.Warnf("Swap amount too low, expected %v but received %v",
+		s.log.Warnf("Swap amount too low, expected %v but received %v",
 			s.AmountRequested, htlcValue)
 
 		s.state = loopdb.StateFailInsufficientValue
-		return nil
+		return nil, 0, nil, nil
 	}
 
 	// Try to spend htlc and continue (rbf) until a spend has confirmed.
 	spend, err := s.waitForHtlcSpendConfirmedV2(
-		globalCtx, *htlcOutpoint, htlcValue,
+		globalCtx, *htlcOutpoint, htlcValue, htlcReorgChan,
 	)
 	if err != nil {
-		return err
+		return nil, 0, nil, err
 	}
 
-	// If spend details are nil, we resolved the swap without waiting for
-	// its spend, so we can exit.
-	if spend == nil {
-		return nil
+	return htlcOutpoint, htlcValue, spend, nil
+}
+
+// verifySweep checks that the confirmed sweep tx paid the swap's funds to its
+// destination and stores the result. Sweeps to an external address must pay
+// to the destination address. Sweeps to a wallet address are batched and pay
+// to a batch address that is generated by the batcher, so the wallet must
+// have been credited instead. A failed check doesn't change the outcome of
+// the swap, but is reported with the swap's status.
+func (s *loopOutSwap) verifySweep(ctx context.Context, sweepTx *wire.MsgTx,
+	expectedAmt btcutil.Amount) error {
+
+	var err error
+	if s.IsExternalAddr {
+		err = verifySweepOutput(sweepTx, s.DestAddr, expectedAmt)
+	} else {
+		err = s.verifyWalletSweep(ctx, sweepTx, expectedAmt)
 	}
 
-	// Inspect witness stack to see if it is a success transaction. We
-	// don't just try to match with the hash of our sweep tx, because it
-	// may be swept by a different (fee) sweep tx from a previous run.
-	htlcInput, err := swap.GetTxInputByOutpoint(
-		spend.Tx, htlcOutpoint,
+	s.SweepVerified = err == nil
+	s.SweepDiscrepancy = ""
+	if err != nil {
+		s.log.Errorf("Sweep tx %v discrepancy: %v", sweepTx.TxHash(),
+			err)
+
+		s.SweepDiscrepancy = err.Error()
+	}
+
+	return s.store.UpdateLoopOutSweepVerification(
+		ctx, s.hash, s.SweepVerified, s.SweepDiscrepancy,
+	)
+}
+
+// verifyWalletSweep returns an error if the sweep tx didn't credit the wallet
+// with at least the expected amount. A batched sweep credits the wallet with
+// the funds of all its swaps.
+func (s *loopOutSwap) verifyWalletSweep(ctx context.Context,
+	sweepTx *wire.MsgTx, expectedAmt btcutil.Amount) error {
+
+	txs, err := s.lnd.Client.ListTransactions(
+		ctx, s.InitiationHeight, -1,
 	)
+	if err != nil {
+		return fmt.Errorf("unable to list wallet txns: %w", err)
+	}
+
+	txHash := sweepTx.TxHash()
+	for _, tx := range txs {
+		if tx.Tx == nil || tx.Tx.TxHash() != txHash {
+			continue
+		}
+
+		if tx.Amount < expectedAmt {
+			return fmt.Errorf("sweep credits %v to the wallet, "+
+				"expected %v", tx.Amount, expectedAmt)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("sweep tx %v not found in the wallet", txHash)
+}
+
+// verifySweepOutput returns an error if the sweep tx doesn't have an output
+// that pays at least the expected amount to the destination address.
+func verifySweepOutput(sweepTx *wire.MsgTx, destAddr btcutil.Address,
+	expectedAmt btcutil.Amount) error {
+
+	pkScript, err := txscript.PayToAddrScript(destAddr)
 	if err != nil {
 		return err
 	}
 
-	sweepSuccessful := s.htlc.IsSuccessWitness(htlcInput.Witness)
-	if sweepSuccessful {
-		s.cost.Onchain = spend.OnChainFeePortion
-		s.state = loopdb.StateSuccess
-	} else {
-		s.state = loopdb.StateFailSweepTimeout
+	for _, txOut := range sweepTx.TxOut {
+		if !bytes.Equal(txOut.PkScript, pkScript) {
+			continue
+		}
+
+		if btcutil.Amount(txOut.Value) < expectedAmt {
+			return fmt.Errorf("sweep pays %v to %v, expected %v",
+				btcutil.Amount(txOut.Value), destAddr,
+				expectedAmt)
+		}
+
+		return nil
 	}
 
-	return nil
+	return fmt.Errorf("sweep has no output paying to %v", destAddr)
 }
 
 // persistState updates the swap state and sends out an update notification.
@@ -689,6 +975,11 @@ func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 		return nil, err
 	}
 
+	expiry, err := swap.InvoiceExpiry(chainParams, invoice)
+	if err != nil {
+		return nil, err
+	}
+
 	maxRetries := 1
 	paymentTimeout := s.executeConfig.totalPaymentTimeout
 
@@ -723,7 +1014,8 @@ func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 
 	start := time.Now()
 	paymentStatus, attempts, err := s.sendPaymentWithRetry(
-		payCtx, hash, &req, maxRetries, routingPlugin, pluginType,
+		payCtx, hash, &req, expiry, maxRetries, routingPlugin,
+		pluginType,
 	)
 
 	dt := time.Since(start)
@@ -751,11 +1043,12 @@ func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 }
 
 // sendPaymentWithRetry will send the payment, optionally with the passed
-// routing plugin retrying at most maxRetries times.
+// routing plugin retrying at most maxRetries times. The payment isn't retried
+// once the invoice has expired.
 func (s *loopOutSwap) sendPaymentWithRetry(ctx context.Context,
-	hash lntypes.Hash, req *lndclient.SendPaymentRequest, maxRetries int,
-	routingPlugin RoutingPlugin, pluginType RoutingPluginType) (
-	*lndclient.PaymentStatus, int, error) {
+	hash lntypes.Hash, req *lndclient.SendPaymentRequest,
+	expiry time.Time, maxRetries int, routingPlugin RoutingPlugin,
+	pluginType RoutingPluginType) (*lndclient.PaymentStatus, int, error) {
 
 	tryCount := 1
 	for {
@@ -789,6 +1082,13 @@ func (s *loopOutSwap) sendPaymentWithRetry(ctx context.Context,
 			return paymentStatus, tryCount, nil
 		}
 
+		if !time.Now().Before(expiry) {
+			s.log.Infof("Payment (%v) not retried, invoice expired "+
+				"at %v", hash.String(), expiry)
+
+			return paymentStatus, tryCount, nil
+		}
+
 		tryCount++
 	}
 }
@@ -843,9 +1143,10 @@ func (s *loopOutSwap) awaitSendPayment(ctx context.Context, hash lntypes.Hash,
 
 // waitForConfirmedHtlc waits for a confirmed htlc to appear on the chain. In
 // case we haven't revealed the preimage yet, it also monitors block height and
-// off-chain payment failure.
-func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
-	*chainntnfs.TxConfirmation, error) {
+// off-chain payment failure. The confirmation stays registered until ctx is
+// canceled, and reorgChan is signaled if the htlc tx is reorged out.
+func (s *loopOutSwap) waitForConfirmedHtlc(ctx context.Context,
+	reorgChan chan struct{}) (*chainntnfs.TxConfirmation, error) {
 
 	// Wait for confirmation of the on-chain htlc by watching for a tx
 	// producing the swap script output.
@@ -864,12 +1165,11 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 			"just the pkscript")
 	}
 
-	ctx, cancel := context.WithCancel(globalCtx)
-	defer cancel()
 	htlcConfChan, htlcErrChan, err :=
 		s.lnd.ChainNotifier.RegisterConfirmationsNtfn(
 			ctx, s.htlcTxHash, s.htlc.PkScript,
 			int32(s.HtlcConfirmations), s.InitiationHeight,
+			lndclient.WithReOrgChan(reorgChan),
 		)
 	if err != nil {
 		return nil, err
@@ -904,7 +1204,7 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 		// First check, because after resume we may otherwise reveal the
 		// preimage after the max height (depending on order in which
 		// events are received in the select loop below).
-		if checkMaxRevealHeightExceeded() {
+		if checkMaxRevealHeightExceeded() || s.htlcConfTimedOut() {
 			return nil, nil
 		}
 		s.log.Infof("Waiting for either htlc on-chain confirmation or " +
@@ -917,7 +1217,7 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 			case result := <-s.swapPaymentChan:
 				s.swapPaymentChan = nil
 
-				err := s.handlePaymentResult(result)
+				err := s.handlePaymentResult(result, true)
 				if err != nil {
 					return nil, err
 				}
@@ -928,18 +1228,23 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 
 					s.failOffChain(
 						ctx, paymentTypeInvoice,
-						result.status,
+						s.SwapInvoice, result.status,
 					)
 					return nil, nil
 				}
 
+				err = s.setPaymentSettled(ctx, true)
+				if err != nil {
+					return nil, err
+				}
+
 			// If the prepay fails, abandon the swap. Because we
 			// didn't reveal the preimage, the swap payment will be
 			// canceled or time out.
 			case result := <-s.prePaymentChan:
 				s.prePaymentChan = nil
 
-				err := s.handlePaymentResult(result)
+				err := s.handlePaymentResult(result, false)
 				if err != nil {
 					return nil, err
 				}
@@ -950,12 +1255,17 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 
 					s.failOffChain(
 						ctx, paymentTypeInvoice,
-						result.status,
+						s.PrepayInvoice, result.status,
 					)
 
 					return nil, nil
 				}
 
+				err = s.setPaymentSettled(ctx, false)
+				if err != nil {
+					return nil, err
+				}
+
 			// Unexpected error on the confirm channel happened,
 			// abandon the swap.
 			case err := <-htlcErrChan:
@@ -970,15 +1280,31 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 			case notification := <-s.blockEpochChan:
 				s.height = notification.(int32)
 
-				log.Infof("Received block %v", s.height)
+				s.log.Infof("Received block %v", s.height)
+
+				if checkMaxRevealHeightExceeded() ||
+					s.htlcConfTimedOut() {
 
-				if checkMaxRevealHeightExceeded() {
 					return nil, nil
 				}
 
+			// The client canceled the swap. As the preimage is
+			// never revealed, the server can't settle the swap
+			// payment, which fails once the server cancels it.
+			case <-s.abandonChan:
+				s.log.Infof("Swap canceled by client")
+
+				s.state = loopdb.StateFailAbandoned
+				err := s.persistState(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				return nil, nil
+
 			// Client quit.
-			case <-globalCtx.Done():
-				return nil, globalCtx.Err()
+			case <-ctx.Done():
+				return nil, ctx.Err()
 			}
 		}
 
@@ -990,8 +1316,8 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 			return nil, err
 		case htlcConfNtfn := <-htlcConfChan:
 			txConf = htlcConfNtfn
-		case <-globalCtx.Done():
-			return nil, globalCtx.Err()
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		}
 	}
 
@@ -1003,24 +1329,51 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 	return txConf, nil
 }
 
+// htlcConfTimedOut checks whether the htlc conf timeout of the swap has
+// passed without the htlc confirming. If so, the server didn't commit any
+// funds to the swap in time and the swap is failed. It must only be called
+// while the preimage hasn't been revealed yet.
+func (s *loopOutSwap) htlcConfTimedOut() bool {
+	if s.HtlcConfTimeout == 0 {
+		return false
+	}
+
+	timeoutHeight := s.InitiationHeight + s.HtlcConfTimeout
+	if s.height < timeoutHeight {
+		return false
+	}
+
+	s.log.Infof("Htlc not confirmed by timeout height %v (height %v), "+
+		"failing swap", timeoutHeight, s.height)
+
+	s.state = loopdb.StateFailTimeout
+
+	return true
+}
+
 // waitForHtlcSpendConfirmedV2 waits for the htlc to be spent either by our own
-// sweep or a server revocation tx.
+// sweep or a server revocation tx, and for the spend to be final. The spend is
+// final once the batcher notifies us of the confirmation of the spending tx.
+// If htlcReorgChan is signaled meanwhile, errHtlcReorged is returned.
 func (s *loopOutSwap) waitForHtlcSpendConfirmedV2(globalCtx context.Context,
-	htlcOutpoint wire.OutPoint, htlcValue btcutil.Amount) (
-	*sweepbatcher.SpendDetail, error) {
+	htlcOutpoint wire.OutPoint, htlcValue btcutil.Amount,
+	htlcReorgChan chan struct{}) (*sweepbatcher.SpendDetail, error) {
 
 	spendChan := make(chan *sweepbatcher.SpendDetail)
 	spendErrChan := make(chan error, 1)
-	quitChan := make(chan bool, 1)
+	publishErrChan := make(chan error)
+	confChan := make(chan *sweepbatcher.SpendDetail)
+	quitChan := make(chan bool)
 
-	defer func() {
-		quitChan <- true
-	}()
+	// Closing the quit channel stops all pending notifications.
+	defer close(quitChan)
 
 	notifier := sweepbatcher.SpendNotifier{
-		SpendChan:    spendChan,
-		SpendErrChan: spendErrChan,
-		QuitChan:     quitChan,
+		SpendChan:      spendChan,
+		SpendErrChan:   spendErrChan,
+		PublishErrChan: publishErrChan,
+		ConfChan:       confChan,
+		QuitChan:       quitChan,
 	}
 
 	sweepReq := sweepbatcher.SweepRequest{
@@ -1049,22 +1402,52 @@ func (s *loopOutSwap) waitForHtlcSpendConfirmedV2(globalCtx context.Context,
 		// is used to decide whether we need to push our preimage to
 		// the server.
 		paymentComplete bool
+
+		// spend is the last spend of the htlc that we were notified
+		// of. It is nil while the htlc is unspent.
+		spend *sweepbatcher.SpendDetail
 	)
 
-	timerChan := s.timerFactory(repushDelay)
+	timerChan := s.timerFactory(s.repushDelay)
 
 	for {
 		select {
-		// Htlc spend, break loop.
-		case spend := <-spendChan:
+		// Htlc spend. The batcher takes care of the spending tx from
+		// now on, so we stop adding the sweep and wait for the spend
+		// to be final. If the spending tx is reorged out, the batcher
+		// notifies us of the new spend.
+		case spend = <-spendChan:
 			s.log.Infof("Htlc spend by tx: %v", spend.Tx.TxHash())
+			s.sweepPublishErr = ""
+			timerChan = nil
+
+		// The spending tx confirmed, break loop.
+		case spend = <-confChan:
+			s.log.Infof("Htlc spend by tx %v confirmed",
+				spend.Tx.TxHash())
 
 			return spend, nil
 
+		// The htlc tx was reorged out, so the htlc needs to confirm
+		// again before it can be swept.
+		case <-htlcReorgChan:
+			return nil, errHtlcReorged
+
 		// Spend notification error.
 		case err := <-spendErrChan:
 			return nil, err
 
+		// The batcher keeps failing to publish the sweep. It keeps
+		// trying, but we report the error in the swap's status.
+		case err := <-publishErrChan:
+			s.log.Warnf("Sweep not published: %v", err)
+
+			s.sweepPublishErr = err.Error()
+			err = s.sendUpdate(ctx)
+			if err != nil {
+				return nil, err
+			}
+
 		// Receive status updates for our payment so that we can detect
 		// whether we've successfully pushed our preimage.
 		case status, ok := <-trackChan:
@@ -1107,15 +1490,19 @@ func (s *loopOutSwap) waitForHtlcSpendConfirmedV2(globalCtx context.Context,
 		// New block arrived, update height and try pushing preimage.
 		case notification := <-s.blockEpochChan:
 			s.height = notification.(int32)
-			timerChan = s.timerFactory(repushDelay)
+			if spend == nil {
+				timerChan = s.timerFactory(s.repushDelay)
+			}
 
 		case <-timerChan:
+			s.refreshSweepConfTarget(ctx)
+
 			// sweepConfTarget will return false if the preimage is
 			// not revealed yet but the conf target is closer than
 			// 20 blocks. In this case to be sure we won't attempt
 			// to sweep at all and we won't reveal the preimage
 			// either.
-			_, canSweep := s.sweepConfTarget()
+			confTarget, canSweep := s.sweepConfTarget()
 			if !canSweep {
 				s.log.Infof("Aborting swap, timed " +
 					"out on-chain")
@@ -1123,13 +1510,19 @@ func (s *loopOutSwap) waitForHtlcSpendConfirmedV2(globalCtx context.Context,
 				s.state = loopdb.StateFailTimeout
 				err := s.persistState(ctx)
 				if err != nil {
-					log.Warnf("unable to persist " +
+					s.log.Warnf("unable to persist " +
 						"state")
 				}
 
 				return nil, nil
 			}
 
+			// Wait for lower fees if the swap asks for it and has
+			// enough time left.
+			if s.holdSweep(ctx, confTarget) {
+				continue
+			}
+
 			// Send the sweep to the sweeper.
 			err := s.batcher.AddSweep(&sweepReq)
 			if err != nil {
@@ -1158,6 +1551,87 @@ func (s *loopOutSwap) waitForHtlcSpendConfirmedV2(globalCtx context.Context,
 	}
 }
 
+// refreshSweepConfTarget reads the swap's sweep confirmation target from the
+// store, as the client may change it while the swap waits for its htlc to be
+// swept. The batcher reads the sweep confirmation target from the store too.
+func (s *loopOutSwap) refreshSweepConfTarget(ctx context.Context) {
+	swp, err := s.store.FetchLoopOutSwap(ctx, s.hash)
+	if err != nil {
+		s.log.Warnf("Unable to fetch sweep conf target: %v", err)
+		return
+	}
+
+	confTarget := swp.Contract.SweepConfTarget
+	if confTarget == s.SweepConfTarget {
+		return
+	}
+
+	s.log.Infof("Sweep conf target changed from %v to %v",
+		s.SweepConfTarget, confTarget)
+
+	s.SweepConfTarget = confTarget
+}
+
+// holdSweep returns true if the sweep of the htlc should be held back, because
+// the fee rate is not below the swap's sweep fee threshold yet or the sweep
+// fee exceeds the swap's maximum miner fee. Once the preimage is revealed, the
+// sweep is never held back, as the sweep has been handed to the batcher
+// already and must confirm before the htlc times out. Close to the expiry of
+// the swap, the sweep is published regardless of the fee rate.
+func (s *loopOutSwap) holdSweep(ctx context.Context, confTarget int32) bool {
+	if s.state == loopdb.StatePreimageRevealed {
+		return false
+	}
+
+	if s.SweepWhenFeeBelow == 0 && s.MaxMinerFee == 0 {
+		return false
+	}
+
+	remainingBlocks := s.CltvExpiry - s.height
+	if remainingBlocks <= MinLoopOutPreimageRevealDelta+sweepHoldMargin {
+		s.log.Infof("Sweeping regardless of fee rate, %v blocks left "+
+			"until expiry", remainingBlocks)
+
+		return false
+	}
+
+	feeRate, err := s.sweeper.EstimateFeeRate(ctx, confTarget)
+	if err != nil {
+		s.log.Warnf("Unable to estimate fee rate, sweeping: %v", err)
+
+		return false
+	}
+
+	if s.SweepWhenFeeBelow != 0 && feeRate >= s.SweepWhenFeeBelow {
+		s.log.Infof("Holding sweep, fee rate %v not below %v", feeRate,
+			s.SweepWhenFeeBelow)
+
+		return true
+	}
+
+	if s.MaxMinerFee == 0 {
+		return false
+	}
+
+	fee, err := s.sweeper.GetSweepFeeForRate(
+		s.htlc.AddSuccessToEstimator, s.DestAddr, feeRate,
+	)
+	if err != nil {
+		s.log.Warnf("Unable to estimate sweep fee, sweeping: %v", err)
+
+		return false
+	}
+
+	if fee <= s.MaxMinerFee {
+		return false
+	}
+
+	s.log.Infof("Holding sweep, fee %v exceeds max miner fee %v", fee,
+		s.MaxMinerFee)
+
+	return true
+}
+
 // pushPreimage pushes our preimage to the server if we have already revealed
 // our preimage on chain with a sweep attempt.
 func (s *loopOutSwap) pushPreimage(ctx context.Context) {
@@ -1181,10 +1655,16 @@ func (s *loopOutSwap) pushPreimage(ctx context.Context) {
 // failOffChain updates a swap's state when it has failed due to a routing
 // failure and notifies the server of the failure.
 func (s *loopOutSwap) failOffChain(ctx context.Context, paymentType paymentType,
-	status lndclient.PaymentStatus) {
+	invoice string, status lndclient.PaymentStatus) {
 
-	// Set our state to failed off chain timeout.
+	// Set our state to failed off chain timeout. If the invoice expired,
+	// retrying the payment won't help, so we fail with a dedicated state.
 	s.state = loopdb.StateFailOffchainPayments
+	if s.invoiceExpired(invoice) {
+		s.log.Infof("Invoice expired unpaid")
+
+		s.state = loopdb.StateFailInvoiceExpired
+	}
 
 	details := &outCancelDetails{
 		hash:        s.hash,
@@ -1257,6 +1737,19 @@ func (s *loopOutSwap) failOffChain(ctx context.Context, paymentType paymentType,
 	}
 }
 
+// invoiceExpired returns whether the invoice has expired. If the invoice
+// can't be decoded, it is not considered expired.
+func (s *loopOutSwap) invoiceExpired(invoice string) bool {
+	expiry, err := swap.InvoiceExpiry(s.lnd.ChainParams, invoice)
+	if err != nil {
+		s.log.Warnf("Unable to decode invoice expiry: %v", err)
+
+		return false
+	}
+
+	return !time.Now().Before(expiry)
+}
+
 func (s *loopOutSwap) setStatePreimageRevealed(ctx context.Context) error {
 	if s.state != loopdb.StatePreimageRevealed {
 		s.state = loopdb.StatePreimageRevealed
@@ -1270,11 +1763,192 @@ func (s *loopOutSwap) setStatePreimageRevealed(ctx context.Context) error {
 	return nil
 }
 
+// probeLoopOut queries lnd for routes to the server that pay the swap and the
+// prepay invoice of the request within its routing fee limits. It is called
+// before the swap is registered with the server, so the server's node and the
+// invoice amounts are taken from a quote. If the request restricts the
+// outgoing channels, the routes must start with one of them. lnd only queries
+// single path routes, so if the payments may be split into more than one
+// part, the probe only checks that the outgoing channels can send the
+// invoice amounts in at most maxParts parts. It returns ErrNoRoute if the
+// swap can't be paid.
+func probeLoopOut(ctx context.Context, lnd *lndclient.LndServices,
+	server swapServerClient, request *OutRequest, maxParts uint32) error {
+
+	quote, err := server.GetLoopOutQuote(
+		ctx, request.Amount, request.Expiry,
+		request.SwapPublicationDeadline, request.Initiator,
+	)
+	if err != nil {
+		return wrapGrpcError("cannot get quote", err)
+	}
+
+	// The server's invoices pay the swap amount and the swap fee, of
+	// which the prepay invoice pays the prepay amount.
+	swapInvoiceAmt := request.Amount + quote.SwapFee - quote.PrepayAmount
+	totalAmt := swapInvoiceAmt + quote.PrepayAmount
+
+	if maxParts > 1 {
+		return probeSplitPayment(
+			ctx, lnd, request.OutgoingChanSet, totalAmt, maxParts,
+		)
+	}
+
+	dest, err := route.NewVertexFromBytes(quote.SwapPaymentDest[:])
+	if err != nil {
+		return err
+	}
+
+	sources, err := probeSources(
+		ctx, lnd, request.OutgoingChanSet, totalAmt,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = probeRoute(
+		ctx, lnd, sources, dest, swapInvoiceAmt,
+		request.MaxSwapRoutingFee,
+	)
+	if err != nil {
+		return fmt.Errorf("swap payment: %w", err)
+	}
+
+	err = probeRoute(
+		ctx, lnd, sources, dest, quote.PrepayAmount,
+		request.MaxPrepayRoutingFee,
+	)
+	if err != nil {
+		return fmt.Errorf("prepay: %w", err)
+	}
+
+	return nil
+}
+
+// probeSplitPayment checks that the outgoing channels can send the amount in
+// at most maxParts parts. Without an outgoing channel set, all active
+// channels may be used. A split payment isn't probed with a route query,
+// because a query for the full amount may fail for a payment that succeeds
+// in parts.
+func probeSplitPayment(ctx context.Context, lnd *lndclient.LndServices,
+	chanSet []uint64, amt btcutil.Amount, maxParts uint32) error {
+
+	channels, err := lnd.Client.ListChannels(ctx, true, false)
+	if err != nil {
+		return err
+	}
+
+	if len(chanSet) > 0 {
+		restricted := make(map[uint64]struct{}, len(chanSet))
+		for _, chanID := range chanSet {
+			restricted[chanID] = struct{}{}
+		}
+
+		var outgoing []lndclient.ChannelInfo
+		for _, channel := range channels {
+			if _, ok := restricted[channel.ChannelID]; ok {
+				outgoing = append(outgoing, channel)
+			}
+		}
+		channels = outgoing
+	}
+
+	routable := routableBalance(channels, int(maxParts))
+	if routable < amt {
+		return fmt.Errorf("%w: outgoing channels can send %v of %v "+
+			"in %v parts", ErrNoRoute, routable, amt, maxParts)
+	}
+
+	return nil
+}
+
+// probeSources returns the nodes that probe routes start from. Without an
+// outgoing channel set, routes start from our own node, which is represented
+// by a nil source. Otherwise they start from the peers of the active channels
+// in the set, which must be able to send the amount together.
+func probeSources(ctx context.Context, lnd *lndclient.LndServices,
+	chanSet []uint64, amt btcutil.Amount) ([]*route.Vertex, error) {
+
+	if len(chanSet) == 0 {
+		return []*route.Vertex{nil}, nil
+	}
+
+	channels, err := lnd.Client.ListChannels(ctx, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	restricted := make(map[uint64]struct{}, len(chanSet))
+	for _, chanID := range chanSet {
+		restricted[chanID] = struct{}{}
+	}
+
+	var (
+		sources []*route.Vertex
+		peers   = make(map[route.Vertex]struct{})
+		balance btcutil.Amount
+	)
+	for _, channel := range channels {
+		if _, ok := restricted[channel.ChannelID]; !ok {
+			continue
+		}
+
+		balance += channel.LocalBalance
+
+		peer := channel.PubKeyBytes
+		if _, ok := peers[peer]; ok {
+			continue
+		}
+
+		peers[peer] = struct{}{}
+		sources = append(sources, &peer)
+	}
+
+	if balance < amt {
+		return nil, fmt.Errorf("%w: outgoing channels can send %v of "+
+			"%v", ErrNoRoute, balance, amt)
+	}
+
+	return sources, nil
+}
+
+// probeRoute queries lnd for a route from one of the sources to the
+// destination that pays the amount within the routing fee limit. It returns
+// ErrNoRoute if there is no such route.
+func probeRoute(ctx context.Context, lnd *lndclient.LndServices,
+	sources []*route.Vertex, dest route.Vertex, amt,
+	maxRoutingFee btcutil.Amount) error {
+
+	var (
+		amtMsat      = lnwire.NewMSatFromSatoshis(amt)
+		feeLimitMsat = lnwire.NewMSatFromSatoshis(maxRoutingFee)
+		err          error
+	)
+	for _, source := range sources {
+		_, err = lnd.Client.QueryRoutes(
+			ctx, lndclient.QueryRoutesRequest{
+				Source:            source,
+				PubKey:            dest,
+				AmtMsat:           amtMsat,
+				FeeLimitMsat:      feeLimitMsat,
+				UseMissionControl: true,
+			},
+		)
+		if err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %v", ErrNoRoute, err)
+}
+
 // validateLoopOutContract validates the contract parameters against our
 // request.
 func validateLoopOutContract(lnd *lndclient.LndServices, request *OutRequest,
 	swapHash lntypes.Hash, response *newLoopOutResponse) error {
 
+	swapLog := newSwapLogger(swapHash)
+
 	// Check invoice amounts.
 	chainParams := lnd.ChainParams
 
@@ -1300,19 +1974,67 @@ func validateLoopOutContract(lnd *lndclient.LndServices, request *OutRequest,
 
 	swapFee := swapInvoiceAmt + prepayInvoiceAmt - request.Amount
 	if swapFee > request.MaxSwapFee {
-		log.Warnf("Swap fee %v exceeding maximum of %v",
+		swapLog.Warnf("Swap fee %v exceeding maximum of %v",
 			swapFee, request.MaxSwapFee)
 
 		return ErrSwapFeeTooHigh
 	}
 
 	if prepayInvoiceAmt > request.MaxPrepayAmount {
-		log.Warnf("Prepay amount %v exceeding maximum of %v",
+		swapLog.Warnf("Prepay amount %v exceeding maximum of %v",
 			prepayInvoiceAmt, request.MaxPrepayAmount)
 
 		return ErrPrepayAmountTooHigh
 	}
 
+	// If the swap is based on a quote, make sure that the server didn't
+	// raise its fees since.
+	if request.Quote == nil {
+		return nil
+	}
+
+	if swapFee > request.Quote.SwapFee {
+		swapLog.Warnf("Swap fee %v exceeding quoted fee of %v",
+			swapFee, request.Quote.SwapFee)
+
+		return ErrQuoteStale
+	}
+
+	if prepayInvoiceAmt > request.Quote.PrepayAmount {
+		swapLog.Warnf("Prepay amount %v exceeding quoted amount of %v",
+			prepayInvoiceAmt, request.Quote.PrepayAmount)
+
+		return ErrQuoteStale
+	}
+
+	return nil
+}
+
+// verifySwapHash independently recomputes the swap hash from the preimage and
+// checks that it matches both the given swap hash and the hash of the swap
+// invoice.
+func verifySwapHash(preimage lntypes.Preimage, swapHash lntypes.Hash,
+	swapInvoice string, lnd *lndclient.LndServices) error {
+
+	preimageHash := preimage.Hash()
+	if preimageHash != swapHash {
+		return fmt.Errorf("cannot initiate swap, preimage hash %v not "+
+			"equal to swap hash %v", preimageHash, swapHash)
+	}
+
+	_, _, invoiceHash, _, err := swap.DecodeInvoice(
+		lnd.ChainParams, swapInvoice,
+	)
+	if err != nil {
+		return err
+	}
+
+	if invoiceHash != preimageHash {
+		return fmt.Errorf("cannot initiate swap, swap invoice hash %v "+
+			"not equal to preimage hash %v", invoiceHash,
+			preimageHash)
+	}
+
 	return nil
 }
 
@@ -10,14 +10,21 @@ import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweep"
 	"github.com/lightninglabs/loop/sweepbatcher"
 	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"github.com/stretchr/testify/require"
 )
@@ -346,6 +353,9 @@ func testCustomSweepConfTarget(t *testing.T) {
 	// Notify the confirmation notification for the HTLC.
 	ctx.AssertRegisterConf(false, defaultConfirmations)
 
+	// The settlement of each payment is announced.
+	assertPaymentsSettled(t, statusChan)
+
 	blockEpochChan <- ctx.Lnd.Height + 1
 
 	htlcTx := wire.NewMsgTx(2)
@@ -458,6 +468,8 @@ func testCustomSweepConfTarget(t *testing.T) {
 	// After receiving the notification the batch will start monitoring the
 	// confirmations.
 	ctx.AssertRegisterConf(true, 3)
+	// The swap completes once the batcher notifies it of the confirmation.
+	ctx.NotifyConf(sweepTx)
 
 	cfg.store.(*loopdb.StoreMock).AssertLoopOutState(loopdb.StateSuccess)
 	status = <-statusChan
@@ -579,6 +591,9 @@ func testPreimagePush(t *testing.T) {
 	// Notify the confirmation notification for the HTLC.
 	ctx.AssertRegisterConf(false, defaultConfirmations)
 
+	// The settlement of each payment is announced.
+	assertPaymentsSettled(t, statusChan)
+
 	blockEpochChan <- ctx.Lnd.Height + 1
 
 	htlcTx := wire.NewMsgTx(2)
@@ -732,6 +747,8 @@ func testPreimagePush(t *testing.T) {
 	// After receiving the spend ntfn the batch will start monitoring for
 	// confs.
 	ctx.AssertRegisterConf(true, 3)
+	// The swap completes once the batcher notifies it of the confirmation.
+	ctx.NotifyConf(sweepTx)
 
 	cfg.store.(*loopdb.StoreMock).AssertLoopOutState(loopdb.StateSuccess)
 	status := <-statusChan
@@ -855,15 +872,20 @@ func testFailedOffChainCancelation(t *testing.T) {
 	}
 
 	// We want to fail our swap payment and succeed the prepush, so we send
-	// a failure update to the payment that has the larger amount.
-	if pmt1.Amount > pmt2.Amount {
-		pmt1.TrackPaymentMessage.Updates <- failUpdate
-		pmt2.TrackPaymentMessage.Updates <- successUpdate
-	} else {
-		pmt1.TrackPaymentMessage.Updates <- successUpdate
-		pmt2.TrackPaymentMessage.Updates <- failUpdate
+	// a failure update to the payment that has the larger amount. The
+	// prepayment settles first, which is announced.
+	swapPmt, prepayPmt := pmt1, pmt2
+	if pmt1.Amount < pmt2.Amount {
+		swapPmt, prepayPmt = pmt2, pmt1
 	}
 
+	prepayPmt.TrackPaymentMessage.Updates <- successUpdate
+	state = <-statusChan
+	require.Equal(t, loopdb.StateInitiated, state.State)
+	require.True(t, state.PrepaySettled)
+
+	swapPmt.TrackPaymentMessage.Updates <- failUpdate
+
 	invoice, err := zpay32.Decode(
 		swap.LoopOutContract.SwapInvoice, lnd.ChainParams,
 	)
@@ -890,6 +912,8 @@ func testFailedOffChainCancelation(t *testing.T) {
 	)
 	state = <-statusChan
 	require.Equal(t, state.State, loopdb.StateFailOffchainPayments)
+	require.True(t, state.PrepaySettled)
+	require.False(t, state.SwapPaymentSettled)
 	require.NoError(t, <-errChan)
 }
 
@@ -1000,6 +1024,9 @@ func TestLoopOutMuSig2Sweep(t *testing.T) {
 	// Notify the confirmation notification for the HTLC.
 	ctx.AssertRegisterConf(false, defaultConfirmations)
 
+	// The settlement of each payment is announced.
+	assertPaymentsSettled(t, statusChan)
+
 	blockEpochChan <- ctx.Lnd.Height + 1
 
 	htlcTx := wire.NewMsgTx(2)
@@ -1089,9 +1116,625 @@ func TestLoopOutMuSig2Sweep(t *testing.T) {
 	// After receiving the spend ntfn the batch will start monitoring for
 	// confs.
 	ctx.AssertRegisterConf(true, 3)
+	// The swap completes once the batcher notifies it of the confirmation.
+	ctx.NotifyConf(sweepTx)
 
 	cfg.store.(*loopdb.StoreMock).AssertLoopOutState(loopdb.StateSuccess)
 	status = <-statusChan
 	require.Equal(t, status.State, loopdb.StateSuccess)
 	require.NoError(t, <-errChan)
 }
+
+// TestVerifySweepOutput tests that a sweep is only accepted if it pays at
+// least the expected amount to the destination address.
+func TestVerifySweepOutput(t *testing.T) {
+	destAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	otherAddr, err := btcutil.NewAddressTaproot(
+		make([]byte, 32), &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	destPkScript, err := txscript.PayToAddrScript(destAddr)
+	require.NoError(t, err)
+
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: destPkScript,
+		Value:    9000,
+	})
+
+	require.NoError(t, verifySweepOutput(sweepTx, destAddr, 9000))
+	require.Error(t, verifySweepOutput(sweepTx, destAddr, 9001))
+	require.Error(t, verifySweepOutput(sweepTx, otherAddr, 9000))
+}
+
+// TestAffectedByClose tests that only swaps that rely on a closed channel are
+// reported as affected by its close.
+func TestAffectedByClose(t *testing.T) {
+	peer := route.Vertex{1}
+	closed := &lndclient.ClosedChannel{
+		ChannelID:   123,
+		PubKeyBytes: peer,
+	}
+
+	loopOut := &loopOutSwap{}
+	require.False(t, loopOut.affectedByClose(closed))
+
+	loopOut.OutgoingChanSet = loopdb.ChannelSet{122, 124}
+	require.False(t, loopOut.affectedByClose(closed))
+
+	loopOut.OutgoingChanSet = loopdb.ChannelSet{122, 123}
+	require.True(t, loopOut.affectedByClose(closed))
+
+	loopIn := &loopInSwap{}
+	require.False(t, loopIn.affectedByClose(closed))
+
+	otherPeer := route.Vertex{2}
+	loopIn.LastHop = &otherPeer
+	require.False(t, loopIn.affectedByClose(closed))
+
+	loopIn.LastHop = &peer
+	require.True(t, loopIn.affectedByClose(closed))
+}
+
+// TestVerifySwapHash tests that a swap is only accepted if the preimage, the
+// swap hash and the swap invoice hash all match.
+func TestVerifySwapHash(t *testing.T) {
+	lnd := test.NewMockLnd()
+
+	preimage := lntypes.Preimage{1, 2, 3}
+	hash := preimage.Hash()
+
+	invoice, err := getInvoice(hash, 1000, swapInvoiceDesc)
+	require.NoError(t, err)
+
+	otherPreimage := lntypes.Preimage{4, 5, 6}
+	otherHash := otherPreimage.Hash()
+	otherInvoice, err := getInvoice(otherHash, 1000, swapInvoiceDesc)
+	require.NoError(t, err)
+
+	require.NoError(t, verifySwapHash(
+		preimage, hash, invoice, &lnd.LndServices,
+	))
+
+	require.Error(t, verifySwapHash(
+		preimage, otherHash, invoice, &lnd.LndServices,
+	))
+
+	require.Error(t, verifySwapHash(
+		preimage, hash, otherInvoice, &lnd.LndServices,
+	))
+}
+
+// TestProbeLoopOut tests that the swap and prepay invoice amounts are probed
+// from the peers of the outgoing channels, that a failed route query or a
+// lack of outgoing balance is reported as ErrNoRoute, and that payments that
+// may be split are only checked against the outgoing balance.
+func TestProbeLoopOut(t *testing.T) {
+	lnd := test.NewMockLnd()
+	server := newServerMock(lnd)
+	ctx := context.Background()
+
+	request := &OutRequest{
+		Amount:              1000,
+		MaxSwapRoutingFee:   10,
+		MaxPrepayRoutingFee: 5,
+	}
+
+	probe := func(maxParts uint32) error {
+		return probeLoopOut(
+			ctx, &lnd.LndServices, server, request, maxParts,
+		)
+	}
+
+	// Without an outgoing channel set, routes start from our node. The
+	// swap invoice pays the amount and the swap fee without the prepay.
+	require.NoError(t, probe(1))
+	require.Len(t, lnd.QueryRoutesRequests, 2)
+	require.Nil(t, lnd.QueryRoutesRequests[0].Source)
+	require.Equal(t, route.Vertex{1, 2, 3},
+		lnd.QueryRoutesRequests[0].PubKey)
+	require.EqualValues(t,
+		(1000+testSwapFee-testFixedPrepayAmount)*1000,
+		lnd.QueryRoutesRequests[0].AmtMsat)
+	require.EqualValues(t, testFixedPrepayAmount*1000,
+		lnd.QueryRoutesRequests[1].AmtMsat)
+
+	// With an outgoing channel set, routes start from its peers.
+	peer := route.Vertex{4}
+	lnd.Channels = []lndclient.ChannelInfo{
+		{ChannelID: 1, PubKeyBytes: peer, LocalBalance: 5000},
+		{ChannelID: 2, PubKeyBytes: route.Vertex{5}, LocalBalance: 5000},
+	}
+	request.OutgoingChanSet = []uint64{1}
+	lnd.QueryRoutesRequests = nil
+
+	require.NoError(t, probe(1))
+	require.Len(t, lnd.QueryRoutesRequests, 2)
+	require.Equal(t, &peer, lnd.QueryRoutesRequests[0].Source)
+
+	// The outgoing channels must be able to send both invoice amounts.
+	lnd.Channels[0].LocalBalance = 1000 + testSwapFee - 1
+	err := probe(1)
+	require.ErrorIs(t, err, ErrNoRoute)
+
+	lnd.Channels[0].LocalBalance = 5000
+	lnd.QueryRoutesErr = errors.New("unable to find a path")
+	err = probe(1)
+	require.ErrorIs(t, err, ErrNoRoute)
+
+	// A payment that may be split isn't probed with a single path route
+	// query, but the outgoing channels must be able to send it.
+	lnd.QueryRoutesRequests = nil
+	require.NoError(t, probe(2))
+	require.Empty(t, lnd.QueryRoutesRequests)
+
+	lnd.Channels[0].LocalBalance = 1000
+	err = probe(2)
+	require.ErrorIs(t, err, ErrNoRoute)
+
+	// Without an outgoing channel set, all channels may send parts.
+	request.OutgoingChanSet = nil
+	require.NoError(t, probe(2))
+}
+
+// TestForfeitedPrepay tests that the prepayment is only reported as forfeited
+// if a swap failed after the server settled the prepayment but not the swap
+// payment, and that its amount is taken from the prepay invoice.
+func TestForfeitedPrepay(t *testing.T) {
+	tests := []struct {
+		name               string
+		state              loopdb.SwapState
+		prepaySettled      bool
+		swapPaymentSettled bool
+		expected           btcutil.Amount
+	}{
+		{
+			name:          "failed after prepay",
+			state:         loopdb.StateFailOffchainPayments,
+			prepaySettled: true,
+			expected:      1000,
+		},
+		{
+			name:  "failed without prepay",
+			state: loopdb.StateFailOffchainPayments,
+		},
+		{
+			name:               "failed after both payments",
+			state:              loopdb.StateFailSweepTimeout,
+			prepaySettled:      true,
+			swapPaymentSettled: true,
+		},
+		{
+			name:          "pending",
+			state:         loopdb.StateInitiated,
+			prepaySettled: true,
+		},
+		{
+			name:          "success",
+			state:         loopdb.StateSuccess,
+			prepaySettled: true,
+		},
+	}
+
+	prepayInvoice, err := getInvoice(
+		lntypes.Hash{1}, 1000, prepayInvoiceDesc,
+	)
+	require.NoError(t, err)
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			contract := &loopdb.LoopOutContract{
+				PrepayInvoice:      prepayInvoice,
+				PrepaySettled:      testCase.prepaySettled,
+				SwapPaymentSettled: testCase.swapPaymentSettled,
+			}
+
+			forfeited, err := forfeitedPrepay(
+				testCase.state, contract,
+				&chaincfg.TestNet3Params,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, forfeited)
+		})
+	}
+}
+
+// TestHandlePaymentResult tests that a settled swap payment adds its amount
+// above the swap amount to the server cost, while a settled prepayment counts
+// towards the server cost in full. Failed payments don't add any cost.
+func TestHandlePaymentResult(t *testing.T) {
+	succeeded := func(value, fee btcutil.Amount) paymentResult {
+		return paymentResult{
+			status: lndclient.PaymentStatus{
+				State: lnrpc.Payment_SUCCEEDED,
+				Value: lnwire.NewMSatFromSatoshis(value),
+				Fee:   lnwire.NewMSatFromSatoshis(fee),
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		result      paymentResult
+		swapPayment bool
+		expected    loopdb.SwapCost
+		expectErr   bool
+	}{
+		{
+			name:        "swap payment settled",
+			result:      succeeded(10_100, 20),
+			swapPayment: true,
+			expected: loopdb.SwapCost{
+				Server:   100,
+				Offchain: 20,
+			},
+		},
+		{
+			name:   "prepay settled",
+			result: succeeded(300, 5),
+			expected: loopdb.SwapCost{
+				Server:   300,
+				Offchain: 5,
+			},
+		},
+		{
+			name: "payment failed",
+			result: paymentResult{
+				status: lndclient.PaymentStatus{
+					State: lnrpc.Payment_FAILED,
+				},
+			},
+			swapPayment: true,
+		},
+		{
+			name: "payment error",
+			result: paymentResult{
+				err: errors.New("payment error"),
+			},
+		},
+		{
+			name: "unexpected state",
+			result: paymentResult{
+				status: lndclient.PaymentStatus{
+					State: lnrpc.Payment_IN_FLIGHT,
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &loopOutSwap{}
+			s.AmountRequested = 10_000
+
+			err := s.handlePaymentResult(
+				testCase.result, testCase.swapPayment,
+			)
+			if testCase.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, s.cost)
+		})
+	}
+}
+
+// assertPaymentsSettled asserts the status updates that announce the
+// settlement of the swap payment and the prepayment, in any order.
+func assertPaymentsSettled(t *testing.T, statusChan <-chan SwapInfo) {
+	t.Helper()
+
+	var status SwapInfo
+	for i := 0; i < 2; i++ {
+		status = <-statusChan
+		require.Equal(t, loopdb.StateInitiated, status.State)
+	}
+
+	require.True(t, status.PrepaySettled)
+	require.True(t, status.SwapPaymentSettled)
+}
+
+// TestHoldSweep tests that a sweep is only held back while the fee rate isn't
+// below the swap's threshold or the sweep fee exceeds the swap's max miner
+// fee, and the swap isn't close to its expiry.
+func TestHoldSweep(t *testing.T) {
+	lnd := test.NewMockLnd()
+	lnd.SetFeeEstimate(6, 2000)
+
+	sweeper := &sweep.Sweeper{Lnd: &lnd.LndServices}
+	destAddr := test.GetDestAddr(t, 0)
+	htlc := quoteHtlc()
+
+	newSwap := func(threshold chainfee.SatPerKWeight, height int32,
+		state loopdb.SwapState) *loopOutSwap {
+
+		return &loopOutSwap{
+			swapKit: swapKit{
+				height: height,
+				log:    newSwapLogger(lntypes.Hash{1}),
+				state:  state,
+				swapConfig: swapConfig{
+					lnd: &lnd.LndServices,
+				},
+			},
+			LoopOutContract: loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					CltvExpiry: 200,
+				},
+				DestAddr:          destAddr,
+				SweepWhenFeeBelow: threshold,
+			},
+			executeConfig: executeConfig{
+				sweeper: sweeper,
+			},
+			htlc: htlc,
+		}
+	}
+
+	ctx := context.Background()
+
+	// Without a threshold, the sweep is never held back.
+	require.False(t, newSwap(0, 100, loopdb.StateInitiated).holdSweep(
+		ctx, 6,
+	))
+
+	// The fee rate isn't below the threshold, so we hold the sweep.
+	require.True(t, newSwap(2000, 100, loopdb.StateInitiated).holdSweep(
+		ctx, 6,
+	))
+
+	// Once the fee rate is below the threshold, we sweep.
+	require.False(t, newSwap(2001, 100, loopdb.StateInitiated).holdSweep(
+		ctx, 6,
+	))
+
+	// Close to the expiry, we sweep regardless of the fee rate.
+	closeHeight := 200 - MinLoopOutPreimageRevealDelta - sweepHoldMargin
+	require.False(t, newSwap(
+		2000, closeHeight, loopdb.StateInitiated,
+	).holdSweep(ctx, 6))
+
+	// Once the preimage is revealed, the sweep isn't held back anymore.
+	require.False(t, newSwap(
+		2000, 100, loopdb.StatePreimageRevealed,
+	).holdSweep(ctx, 6))
+
+	// The sweep is held back while its fee exceeds the max miner fee.
+	fee, err := sweeper.GetSweepFeeForRate(
+		htlc.AddSuccessToEstimator, destAddr, 2000,
+	)
+	require.NoError(t, err)
+
+	s := newSwap(0, 100, loopdb.StateInitiated)
+	s.MaxMinerFee = fee - 1
+	require.True(t, s.holdSweep(ctx, 6))
+
+	s.MaxMinerFee = fee
+	require.False(t, s.holdSweep(ctx, 6))
+
+	// The max miner fee doesn't apply close to the expiry or once the
+	// preimage is revealed, as the htlc could time out otherwise.
+	s.MaxMinerFee = fee - 1
+	s.height = closeHeight
+	require.False(t, s.holdSweep(ctx, 6))
+
+	s.height = 100
+	s.state = loopdb.StatePreimageRevealed
+	require.False(t, s.holdSweep(ctx, 6))
+}
+
+// TestHtlcConfTimedOut tests that a swap is failed once its htlc conf timeout
+// has passed.
+func TestHtlcConfTimedOut(t *testing.T) {
+	newSwap := func(timeout, height int32) *loopOutSwap {
+		return &loopOutSwap{
+			swapKit: swapKit{
+				height: height,
+				log:    newSwapLogger(lntypes.Hash{1}),
+				state:  loopdb.StateInitiated,
+			},
+			LoopOutContract: loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					InitiationHeight: 100,
+				},
+				HtlcConfTimeout: timeout,
+			},
+		}
+	}
+
+	// Without a timeout, the swap keeps waiting for the htlc.
+	s := newSwap(0, 1000)
+	require.False(t, s.htlcConfTimedOut())
+	require.Equal(t, loopdb.StateInitiated, s.state)
+
+	// Before the timeout height, the swap keeps waiting.
+	s = newSwap(10, 109)
+	require.False(t, s.htlcConfTimedOut())
+	require.Equal(t, loopdb.StateInitiated, s.state)
+
+	// At the timeout height, the swap is failed.
+	s = newSwap(10, 110)
+	require.True(t, s.htlcConfTimedOut())
+	require.Equal(t, loopdb.StateFailTimeout, s.state)
+}
+
+// TestInvoiceExpired tests the detection of expired invoices.
+func TestInvoiceExpired(t *testing.T) {
+	lnd := test.NewMockLnd()
+
+	newInvoice := func(timestamp time.Time) string {
+		req, err := zpay32.NewInvoice(
+			lnd.ChainParams, lntypes.Hash{1}, timestamp,
+			zpay32.Description(swapInvoiceDesc),
+			zpay32.Expiry(time.Hour),
+		)
+		require.NoError(t, err)
+
+		invoice, err := test.EncodePayReq(req)
+		require.NoError(t, err)
+
+		return invoice
+	}
+
+	s := &loopOutSwap{
+		swapKit: swapKit{
+			log: newSwapLogger(lntypes.Hash{1}),
+			swapConfig: swapConfig{
+				lnd: &lnd.LndServices,
+			},
+		},
+	}
+
+	require.True(t, s.invoiceExpired(newInvoice(
+		time.Now().Add(-2*time.Hour),
+	)))
+	require.False(t, s.invoiceExpired(newInvoice(time.Now())))
+
+	// Invoices that can't be decoded aren't considered expired.
+	require.False(t, s.invoiceExpired("invalid"))
+}
+
+// TestVerifySweep tests that the confirmed sweep is checked against the
+// destination address for external addresses and against the wallet's
+// transactions for wallet addresses, and that the result is stored.
+func TestVerifySweep(t *testing.T) {
+	ctx := context.Background()
+
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+	destAddr := test.GetDestAddr(t, 0)
+
+	destPkScript, err := txscript.PayToAddrScript(destAddr)
+	require.NoError(t, err)
+
+	hash := lntypes.Hash{1}
+	newSwap := func(external bool) *loopOutSwap {
+		store.LoopOutSwaps[hash] = &loopdb.LoopOutContract{}
+
+		return &loopOutSwap{
+			swapKit: swapKit{
+				hash: hash,
+				log:  newSwapLogger(hash),
+				swapConfig: swapConfig{
+					lnd:   &lnd.LndServices,
+					store: store,
+				},
+			},
+			LoopOutContract: loopdb.LoopOutContract{
+				DestAddr:       destAddr,
+				IsExternalAddr: external,
+			},
+		}
+	}
+
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: destPkScript,
+		Value:    9000,
+	})
+
+	// A sweep to an external address is checked against its output.
+	s := newSwap(true)
+	require.NoError(t, s.verifySweep(ctx, sweepTx, 9000))
+	require.True(t, s.SweepVerified)
+	require.True(t, store.LoopOutSwaps[hash].SweepVerified)
+
+	// A sweep to a wallet address that the wallet doesn't know about is
+	// reported as a discrepancy.
+	s = newSwap(false)
+	require.NoError(t, s.verifySweep(ctx, sweepTx, 9000))
+	require.False(t, s.SweepVerified)
+	require.NotEmpty(t, s.SweepDiscrepancy)
+	require.Equal(
+		t, s.SweepDiscrepancy, store.LoopOutSwaps[hash].SweepDiscrepancy,
+	)
+
+	// A batched sweep that credited the wallet with the funds of several
+	// swaps passes the check.
+	lnd.Transactions = []lndclient.Transaction{{
+		Tx:     sweepTx,
+		Amount: 20000,
+	}}
+
+	s = newSwap(false)
+	require.NoError(t, s.verifySweep(ctx, sweepTx, 9000))
+	require.True(t, s.SweepVerified)
+	require.Empty(t, s.SweepDiscrepancy)
+	require.True(t, store.LoopOutSwaps[hash].SweepVerified)
+
+	// The wallet must be credited with at least the swap's funds.
+	s = newSwap(false)
+	require.NoError(t, s.verifySweep(ctx, sweepTx, 20001))
+	require.False(t, s.SweepVerified)
+	require.False(t, store.LoopOutSwaps[hash].SweepVerified)
+}
+
+// TestWaitForHtlcSpendReorg tests that a reorg of the htlc tx while waiting
+// for the htlc spend is reported, so that the swap waits for the htlc to
+// confirm again.
+func TestWaitForHtlcSpendReorg(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := context.Background()
+	lnd := test.NewMockLnd()
+
+	hash := lntypes.Hash{1}
+	s := &loopOutSwap{
+		swapKit: swapKit{
+			hash:  hash,
+			log:   newSwapLogger(hash),
+			state: loopdb.StatePreimageRevealed,
+			swapConfig: swapConfig{
+				lnd: &lnd.LndServices,
+			},
+			contract: &loopdb.SwapContract{},
+		},
+		executeConfig: executeConfig{
+			timerFactory: func(time.Duration) <-chan time.Time {
+				return nil
+			},
+		},
+		htlc: &swap.Htlc{
+			PkScript: []byte{1, 2, 3},
+		},
+	}
+
+	htlcTx := wire.NewMsgTx(2)
+	htlcTx.AddTxOut(&wire.TxOut{
+		PkScript: s.htlc.PkScript,
+		Value:    9000,
+	})
+
+	errChan := make(chan error, 1)
+	go func() {
+		_, _, _, err := s.waitForHtlcSpend(ctx)
+		errChan <- err
+	}()
+
+	// The htlc confirmation is registered with a reorg channel.
+	reg := <-lnd.RegisterConfChannel
+	require.NotNil(t, reg.ReOrgChan)
+
+	lnd.ConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: htlcTx,
+	}
+
+	// Once the htlc confirmed, the swap waits for its spend. A reorg of
+	// the htlc tx ends the wait.
+	<-lnd.TrackPaymentChannel
+	reg.ReOrgChan <- struct{}{}
+
+	require.ErrorIs(t, <-errChan, errHtlcReorged)
+}
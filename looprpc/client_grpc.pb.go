@@ -40,9 +40,27 @@ type SwapClientClient interface {
 	// loop: `swapinfo`
 	//SwapInfo returns all known details about a single swap.
 	SwapInfo(ctx context.Context, in *SwapInfoRequest, opts ...grpc.CallOption) (*SwapStatus, error)
+	// loop: `swaphistory`
+	//GetSwapHistory returns the state transitions of a single swap, starting
+	//with its initiation, together with the time that the swap spent in each
+	//state.
+	GetSwapHistory(ctx context.Context, in *SwapHistoryRequest, opts ...grpc.CallOption) (*SwapHistoryResponse, error)
+	// loop: `accounting`
+	//GetAccountingReport aggregates the volume and the swap, miner and routing
+	//fees of completed swaps by day, week or month.
+	GetAccountingReport(ctx context.Context, in *AccountingReportRequest, opts ...grpc.CallOption) (*AccountingReportResponse, error)
 	// loop: `abandonswap`
-	//AbandonSwap allows the client to abandon a swap.
+	//AbandonSwap allows the client to abandon a pending swap. Loop outs can
+	//only be abandoned while they wait for the server's htlc.
 	AbandonSwap(ctx context.Context, in *AbandonSwapRequest, opts ...grpc.CallOption) (*AbandonSwapResponse, error)
+	// loop: `bumpsweepfee`
+	//BumpSweepFee raises the fee rate of the pending sweep of a loop out and
+	//republishes it right away. Other sweeps in the same batch are bumped along.
+	BumpSweepFee(ctx context.Context, in *BumpSweepFeeRequest, opts ...grpc.CallOption) (*BumpSweepFeeResponse, error)
+	// loop: `updateswap`
+	//UpdateSwap changes the sweep confirmation target of a pending loop out,
+	//and with it the fee rate of its sweep.
+	UpdateSwap(ctx context.Context, in *UpdateSwapRequest, opts ...grpc.CallOption) (*UpdateSwapResponse, error)
 	// loop: `terms`
 	//LoopOutTerms returns the terms that the server enforces for a loop out swap.
 	LoopOutTerms(ctx context.Context, in *TermsRequest, opts ...grpc.CallOption) (*OutTermsResponse, error)
@@ -66,6 +84,10 @@ type SwapClientClient interface {
 	// loop: `getinfo`
 	//GetInfo gets basic information about the loop daemon.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// loop: `health`
+	//Health checks that lnd and the swap server are reachable, that the swap
+	//store accepts writes and that the swap executor is alive.
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
 	// loop: `getparams`
 	//GetLiquidityParams gets the parameters that the daemon's liquidity manager
 	//is currently configured with. This may be nil if nothing is configured.
@@ -83,6 +105,11 @@ type SwapClientClient interface {
 	//Note that only loop out suggestions are currently supported.
 	//[EXPERIMENTAL]: endpoint is subject to change.
 	SuggestSwaps(ctx context.Context, in *SuggestSwapsRequest, opts ...grpc.CallOption) (*SuggestSwapsResponse, error)
+	// loop: `budget`
+	//GetBudgetStatus returns how much of the autoloop fee budget is spent or
+	//reserved for in flight swaps in the current budget period.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error)
 	// loop: `listreservations`
 	//ListReservations returns a list of all reservations the server opened to us.
 	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
@@ -175,6 +202,24 @@ func (c *swapClientClient) SwapInfo(ctx context.Context, in *SwapInfoRequest, op
 	return out, nil
 }
 
+func (c *swapClientClient) GetSwapHistory(ctx context.Context, in *SwapHistoryRequest, opts ...grpc.CallOption) (*SwapHistoryResponse, error) {
+	out := new(SwapHistoryResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetSwapHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetAccountingReport(ctx context.Context, in *AccountingReportRequest, opts ...grpc.CallOption) (*AccountingReportResponse, error) {
+	out := new(AccountingReportResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetAccountingReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) AbandonSwap(ctx context.Context, in *AbandonSwapRequest, opts ...grpc.CallOption) (*AbandonSwapResponse, error) {
 	out := new(AbandonSwapResponse)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/AbandonSwap", in, out, opts...)
@@ -184,6 +229,24 @@ func (c *swapClientClient) AbandonSwap(ctx context.Context, in *AbandonSwapReque
 	return out, nil
 }
 
+func (c *swapClientClient) BumpSweepFee(ctx context.Context, in *BumpSweepFeeRequest, opts ...grpc.CallOption) (*BumpSweepFeeResponse, error) {
+	out := new(BumpSweepFeeResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/BumpSweepFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) UpdateSwap(ctx context.Context, in *UpdateSwapRequest, opts ...grpc.CallOption) (*UpdateSwapResponse, error) {
+	out := new(UpdateSwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/UpdateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) LoopOutTerms(ctx context.Context, in *TermsRequest, opts ...grpc.CallOption) (*OutTermsResponse, error) {
 	out := new(OutTermsResponse)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/LoopOutTerms", in, out, opts...)
@@ -247,6 +310,15 @@ func (c *swapClientClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts
 	return out, nil
 }
 
+func (c *swapClientClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) GetLiquidityParams(ctx context.Context, in *GetLiquidityParamsRequest, opts ...grpc.CallOption) (*LiquidityParameters, error) {
 	out := new(LiquidityParameters)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetLiquidityParams", in, out, opts...)
@@ -274,6 +346,15 @@ func (c *swapClientClient) SuggestSwaps(ctx context.Context, in *SuggestSwapsReq
 	return out, nil
 }
 
+func (c *swapClientClient) GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error) {
+	out := new(GetBudgetStatusResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetBudgetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
 	out := new(ListReservationsResponse)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListReservations", in, out, opts...)
@@ -336,9 +417,27 @@ type SwapClientServer interface {
 	// loop: `swapinfo`
 	//SwapInfo returns all known details about a single swap.
 	SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error)
+	// loop: `swaphistory`
+	//GetSwapHistory returns the state transitions of a single swap, starting
+	//with its initiation, together with the time that the swap spent in each
+	//state.
+	GetSwapHistory(context.Context, *SwapHistoryRequest) (*SwapHistoryResponse, error)
+	// loop: `accounting`
+	//GetAccountingReport aggregates the volume and the swap, miner and routing
+	//fees of completed swaps by day, week or month.
+	GetAccountingReport(context.Context, *AccountingReportRequest) (*AccountingReportResponse, error)
 	// loop: `abandonswap`
-	//AbandonSwap allows the client to abandon a swap.
+	//AbandonSwap allows the client to abandon a pending swap. Loop outs can
+	//only be abandoned while they wait for the server's htlc.
 	AbandonSwap(context.Context, *AbandonSwapRequest) (*AbandonSwapResponse, error)
+	// loop: `bumpsweepfee`
+	//BumpSweepFee raises the fee rate of the pending sweep of a loop out and
+	//republishes it right away. Other sweeps in the same batch are bumped along.
+	BumpSweepFee(context.Context, *BumpSweepFeeRequest) (*BumpSweepFeeResponse, error)
+	// loop: `updateswap`
+	//UpdateSwap changes the sweep confirmation target of a pending loop out,
+	//and with it the fee rate of its sweep.
+	UpdateSwap(context.Context, *UpdateSwapRequest) (*UpdateSwapResponse, error)
 	// loop: `terms`
 	//LoopOutTerms returns the terms that the server enforces for a loop out swap.
 	LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error)
@@ -362,6 +461,10 @@ type SwapClientServer interface {
 	// loop: `getinfo`
 	//GetInfo gets basic information about the loop daemon.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// loop: `health`
+	//Health checks that lnd and the swap server are reachable, that the swap
+	//store accepts writes and that the swap executor is alive.
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
 	// loop: `getparams`
 	//GetLiquidityParams gets the parameters that the daemon's liquidity manager
 	//is currently configured with. This may be nil if nothing is configured.
@@ -379,6 +482,11 @@ type SwapClientServer interface {
 	//Note that only loop out suggestions are currently supported.
 	//[EXPERIMENTAL]: endpoint is subject to change.
 	SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error)
+	// loop: `budget`
+	//GetBudgetStatus returns how much of the autoloop fee budget is spent or
+	//reserved for in flight swaps in the current budget period.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error)
 	// loop: `listreservations`
 	//ListReservations returns a list of all reservations the server opened to us.
 	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
@@ -415,9 +523,21 @@ func (UnimplementedSwapClientServer) ListSwaps(context.Context, *ListSwapsReques
 func (UnimplementedSwapClientServer) SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapInfo not implemented")
 }
+func (UnimplementedSwapClientServer) GetSwapHistory(context.Context, *SwapHistoryRequest) (*SwapHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapHistory not implemented")
+}
+func (UnimplementedSwapClientServer) GetAccountingReport(context.Context, *AccountingReportRequest) (*AccountingReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountingReport not implemented")
+}
 func (UnimplementedSwapClientServer) AbandonSwap(context.Context, *AbandonSwapRequest) (*AbandonSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AbandonSwap not implemented")
 }
+func (UnimplementedSwapClientServer) BumpSweepFee(context.Context, *BumpSweepFeeRequest) (*BumpSweepFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpSweepFee not implemented")
+}
+func (UnimplementedSwapClientServer) UpdateSwap(context.Context, *UpdateSwapRequest) (*UpdateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSwap not implemented")
+}
 func (UnimplementedSwapClientServer) LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoopOutTerms not implemented")
 }
@@ -439,6 +559,9 @@ func (UnimplementedSwapClientServer) GetLsatTokens(context.Context, *TokensReque
 func (UnimplementedSwapClientServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
+func (UnimplementedSwapClientServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
 func (UnimplementedSwapClientServer) GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityParams not implemented")
 }
@@ -448,6 +571,9 @@ func (UnimplementedSwapClientServer) SetLiquidityParams(context.Context, *SetLiq
 func (UnimplementedSwapClientServer) SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuggestSwaps not implemented")
 }
+func (UnimplementedSwapClientServer) GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetStatus not implemented")
+}
 func (UnimplementedSwapClientServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
 }
@@ -566,6 +692,42 @@ func _SwapClient_SwapInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwapClient_GetSwapHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetSwapHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetSwapHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetSwapHistory(ctx, req.(*SwapHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetAccountingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetAccountingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetAccountingReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetAccountingReport(ctx, req.(*AccountingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SwapClient_AbandonSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AbandonSwapRequest)
 	if err := dec(in); err != nil {
@@ -584,6 +746,42 @@ func _SwapClient_AbandonSwap_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwapClient_BumpSweepFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpSweepFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).BumpSweepFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/BumpSweepFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).BumpSweepFee(ctx, req.(*BumpSweepFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_UpdateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).UpdateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/UpdateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).UpdateSwap(ctx, req.(*UpdateSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SwapClient_LoopOutTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TermsRequest)
 	if err := dec(in); err != nil {
@@ -710,6 +908,24 @@ func _SwapClient_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwapClient_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SwapClient_GetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLiquidityParamsRequest)
 	if err := dec(in); err != nil {
@@ -764,6 +980,24 @@ func _SwapClient_SuggestSwaps_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwapClient_GetBudgetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetBudgetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetBudgetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetBudgetStatus(ctx, req.(*GetBudgetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SwapClient_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListReservationsRequest)
 	if err := dec(in); err != nil {
@@ -859,10 +1093,26 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwapInfo",
 			Handler:    _SwapClient_SwapInfo_Handler,
 		},
+		{
+			MethodName: "GetSwapHistory",
+			Handler:    _SwapClient_GetSwapHistory_Handler,
+		},
+		{
+			MethodName: "GetAccountingReport",
+			Handler:    _SwapClient_GetAccountingReport_Handler,
+		},
 		{
 			MethodName: "AbandonSwap",
 			Handler:    _SwapClient_AbandonSwap_Handler,
 		},
+		{
+			MethodName: "BumpSweepFee",
+			Handler:    _SwapClient_BumpSweepFee_Handler,
+		},
+		{
+			MethodName: "UpdateSwap",
+			Handler:    _SwapClient_UpdateSwap_Handler,
+		},
 		{
 			MethodName: "LoopOutTerms",
 			Handler:    _SwapClient_LoopOutTerms_Handler,
@@ -891,6 +1141,10 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _SwapClient_GetInfo_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _SwapClient_Health_Handler,
+		},
 		{
 			MethodName: "GetLiquidityParams",
 			Handler:    _SwapClient_GetLiquidityParams_Handler,
@@ -903,6 +1157,10 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SuggestSwaps",
 			Handler:    _SwapClient_SuggestSwaps_Handler,
 		},
+		{
+			MethodName: "GetBudgetStatus",
+			Handler:    _SwapClient_GetBudgetStatus_Handler,
+		},
 		{
 			MethodName: "ListReservations",
 			Handler:    _SwapClient_ListReservations_Handler,
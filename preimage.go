@@ -0,0 +1,49 @@
+package loop
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// PreimageGenerator generates the preimages of new swaps.
+type PreimageGenerator interface {
+	// NewPreimage returns the preimage for a new swap.
+	NewPreimage() (lntypes.Preimage, error)
+}
+
+// randomPreimageGenerator generates preimages from a cryptographically secure
+// source of randomness. It is the generator that swaps use by default.
+type randomPreimageGenerator struct{}
+
+// NewPreimage returns a random preimage.
+func (randomPreimageGenerator) NewPreimage() (lntypes.Preimage, error) {
+	var preimage lntypes.Preimage
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return preimage, fmt.Errorf("cannot generate preimage: %v", err)
+	}
+
+	return preimage, nil
+}
+
+// validatePreimageGenerator returns an error if a custom preimage generator
+// is set for a network other than regtest or simnet. A predictable preimage
+// allows anyone who can predict it to claim the swap's htlc.
+func validatePreimageGenerator(generator PreimageGenerator,
+	params *chaincfg.Params) error {
+
+	if generator == nil {
+		return nil
+	}
+
+	switch params.Name {
+	case chaincfg.RegressionNetParams.Name, chaincfg.SimNetParams.Name:
+		return nil
+
+	default:
+		return fmt.Errorf("custom preimage generator not allowed on %v",
+			params.Name)
+	}
+}
@@ -0,0 +1,58 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedPreimageGenerator always returns the same preimage.
+type fixedPreimageGenerator lntypes.Preimage
+
+// NewPreimage returns the fixed preimage.
+func (f fixedPreimageGenerator) NewPreimage() (lntypes.Preimage, error) {
+	return lntypes.Preimage(f), nil
+}
+
+// TestSwapPreimage tests that swaps use a custom preimage generator if one is
+// configured, and random preimages otherwise.
+func TestSwapPreimage(t *testing.T) {
+	cfg := &swapConfig{}
+
+	first, err := cfg.newPreimage()
+	require.NoError(t, err)
+	second, err := cfg.newPreimage()
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+
+	preimage := lntypes.Preimage{1, 2, 3}
+	cfg.preimages = fixedPreimageGenerator(preimage)
+
+	generated, err := cfg.newPreimage()
+	require.NoError(t, err)
+	require.Equal(t, preimage, generated)
+}
+
+// TestValidatePreimageGenerator tests that custom preimage generators are
+// only allowed on test networks.
+func TestValidatePreimageGenerator(t *testing.T) {
+	generator := fixedPreimageGenerator{}
+
+	require.NoError(t, validatePreimageGenerator(
+		nil, &chaincfg.MainNetParams,
+	))
+	require.NoError(t, validatePreimageGenerator(
+		generator, &chaincfg.RegressionNetParams,
+	))
+	require.NoError(t, validatePreimageGenerator(
+		generator, &chaincfg.SimNetParams,
+	))
+	require.Error(t, validatePreimageGenerator(
+		generator, &chaincfg.MainNetParams,
+	))
+	require.Error(t, validatePreimageGenerator(
+		generator, &chaincfg.TestNet3Params,
+	))
+}
@@ -0,0 +1,141 @@
+package loop
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// DefaultPriceSourceURL is the base url of the api that HTTPPriceSource
+// queries by default.
+const DefaultPriceSourceURL = "https://api.coingecko.com/api/v3"
+
+// priceRequestTimeout is the timeout of a single price request.
+const priceRequestTimeout = 10 * time.Second
+
+// PriceSource provides the fiat price of bitcoin, which is used to annotate
+// swap costs with their fiat value for accounting.
+type PriceSource interface {
+	// BTCPrice returns the price of one bitcoin in the given currency at
+	// the given time.
+	BTCPrice(ctx context.Context, currency string, t time.Time) (float64,
+		error)
+}
+
+// HTTPPriceSource is a price source that queries the historical daily prices
+// of a CoinGecko compatible api.
+type HTTPPriceSource struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewHTTPPriceSource returns a price source that queries the api at the given
+// base url. If the url is empty, DefaultPriceSourceURL is used.
+func NewHTTPPriceSource(baseURL string) *HTTPPriceSource {
+	if baseURL == "" {
+		baseURL = DefaultPriceSourceURL
+	}
+
+	return &HTTPPriceSource{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: priceRequestTimeout},
+	}
+}
+
+// coinHistoryResponse is the part of the api's coin history response that
+// holds the price.
+type coinHistoryResponse struct {
+	MarketData struct {
+		CurrentPrice map[string]float64 `json:"current_price"`
+	} `json:"market_data"`
+}
+
+// BTCPrice returns the price of one bitcoin in the given currency on the UTC
+// day of the given time.
+func (h *HTTPPriceSource) BTCPrice(ctx context.Context, currency string,
+	t time.Time) (float64, error) {
+
+	query := url.Values{}
+	query.Set("date", t.UTC().Format("02-01-2006"))
+	query.Set("localization", "false")
+
+	reqURL := fmt.Sprintf("%v/coins/bitcoin/history?%v", h.baseURL,
+		query.Encode())
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, reqURL, nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status %v", resp.Status)
+	}
+
+	var history coinHistoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&history); err != nil {
+		return 0, fmt.Errorf("invalid price response: %v", err)
+	}
+
+	price, ok := history.MarketData.CurrentPrice[strings.ToLower(currency)]
+	if !ok {
+		return 0, fmt.Errorf("no %v price on %v", currency,
+			t.UTC().Format("2006-01-02"))
+	}
+
+	return price, nil
+}
+
+// FiatCost is the fiat value of the costs of a swap at the time of its last
+// update.
+type FiatCost struct {
+	// Currency is the fiat currency of the values.
+	Currency string
+
+	// Price is the price of one bitcoin that the values are based on.
+	Price float64
+
+	// PriceTime is the time that the price was requested for.
+	PriceTime time.Time
+
+	// SwapFee is the fiat value of the fee paid to the server.
+	SwapFee float64
+
+	// RoutingFee is the fiat value of the off-chain routing fee.
+	RoutingFee float64
+
+	// MinerFee is the fiat value of the on-chain fee.
+	MinerFee float64
+}
+
+// newFiatCost converts the realized costs of a cost detail into fiat values
+// at the given price.
+func newFiatCost(detail *CostDetail, currency string, price float64,
+	priceTime time.Time) *FiatCost {
+
+	toFiat := func(amt btcutil.Amount) float64 {
+		return amt.ToBTC() * price
+	}
+
+	return &FiatCost{
+		Currency:   currency,
+		Price:      price,
+		PriceTime:  priceTime,
+		SwapFee:    toFiat(detail.SwapFee.Realized),
+		RoutingFee: toFiat(detail.RoutingFee.Realized),
+		MinerFee:   toFiat(detail.MinerFee.Realized),
+	}
+}
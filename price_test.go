@@ -0,0 +1,42 @@
+package loop
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHTTPPriceSource tests that the price source requests the price of the
+// day of the given time and picks the requested currency.
+func TestHTTPPriceSource(t *testing.T) {
+	queries := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/coins/bitcoin/history" {
+				http.NotFound(w, r)
+				return
+			}
+
+			queries <- r.URL.RawQuery
+			_, _ = w.Write([]byte(`{"market_data": ` +
+				`{"current_price": {"usd": 25000.5}}}`))
+		},
+	))
+	defer server.Close()
+
+	source := NewHTTPPriceSource(server.URL + "/")
+	ctx := context.Background()
+	swapTime := time.Date(2023, 3, 1, 23, 0, 0, 0, time.UTC)
+
+	price, err := source.BTCPrice(ctx, "USD", swapTime)
+	require.NoError(t, err)
+	require.Equal(t, 25000.5, price)
+	require.Equal(t, "date=01-03-2023&localization=false", <-queries)
+
+	_, err = source.BTCPrice(ctx, "chf", swapTime)
+	require.Error(t, err)
+}
@@ -0,0 +1,182 @@
+package loop
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// SwapRecoveryData contains everything that is needed to reconstruct the
+// on-chain htlc of a swap and to spend it without the swap client. For a loop
+// out, the htlc is swept with the preimage and the client's receiver script
+// key. For a loop in, the htlc is reclaimed after the expiry with the client's
+// sender script key. The client's private key can be derived from lnd with the
+// script key locator.
+//
+// The data is meant to be stored outside of the swap client, so its JSON
+// encoding is explicit: hashes, keys and scripts are hex encoded and addresses
+// are encoded as strings. The preimage is exported in plain text, even if the
+// database encrypts it, so the data must be kept secret.
+type SwapRecoveryData struct {
+	// SwapType is the type of the swap, "Out" or "In".
+	SwapType string `json:"swap_type"`
+
+	// Hash is the hash of the swap.
+	Hash string `json:"swap_hash"`
+
+	// Preimage is the preimage of the swap.
+	Preimage string `json:"preimage"`
+
+	// State is the last stored state of the swap.
+	State string `json:"state"`
+
+	// AmountRequested is the amount of the swap in satoshis.
+	AmountRequested int64 `json:"amount_requested_sat"`
+
+	// CltvExpiry is the absolute block height at which the htlc expires.
+	CltvExpiry int32 `json:"cltv_expiry"`
+
+	// SenderScriptKey is the sender's key in the htlc scripts.
+	SenderScriptKey string `json:"sender_script_key"`
+
+	// SenderInternalPubKey is the sender's part of the taproot internal
+	// key.
+	SenderInternalPubKey string `json:"sender_internal_pubkey"`
+
+	// ReceiverScriptKey is the receiver's key in the htlc scripts.
+	ReceiverScriptKey string `json:"receiver_script_key"`
+
+	// ReceiverInternalPubKey is the receiver's part of the taproot
+	// internal key.
+	ReceiverInternalPubKey string `json:"receiver_internal_pubkey"`
+
+	// ClientKeyFamily and ClientKeyIndex locate the client's script key in
+	// lnd's wallet.
+	ClientKeyFamily uint32 `json:"client_key_family"`
+	ClientKeyIndex  uint32 `json:"client_key_index"`
+
+	// ProtocolVersion is the protocol version of the swap, which
+	// determines the htlc script version.
+	ProtocolVersion uint32 `json:"protocol_version"`
+
+	// HtlcScriptVersion is the version of the htlc script, 0 for the
+	// P2WSH htlc and 1 for the P2TR htlc.
+	HtlcScriptVersion uint8 `json:"htlc_script_version"`
+
+	// HtlcAddress is the address of the on-chain htlc.
+	HtlcAddress string `json:"htlc_address"`
+
+	// HtlcPkScript is the output script of the on-chain htlc.
+	HtlcPkScript string `json:"htlc_pk_script"`
+
+	// HtlcTxHash is the hash of the htlc tx, if it is known.
+	HtlcTxHash string `json:"htlc_tx_hash,omitempty"`
+
+	// DestAddr is the address that a loop out htlc is swept to. It is
+	// empty for loop ins.
+	DestAddr string `json:"dest_addr,omitempty"`
+}
+
+// ExportSwapRecovery returns the data that is needed to recover the funds of
+// the swap with the given hash independently of the swap client.
+func (s *Client) ExportSwapRecovery(ctx context.Context,
+	hash lntypes.Hash) (*SwapRecoveryData, error) {
+
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swp := range loopOutSwaps {
+		if swp.Hash != hash {
+			continue
+		}
+
+		data, err := s.swapRecoveryData(
+			swap.TypeOut, &swp.Loop, &swp.Contract.SwapContract,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if swp.Contract.DestAddr != nil {
+			data.DestAddr = swp.Contract.DestAddr.String()
+		}
+
+		return data, nil
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swp := range loopInSwaps {
+		if swp.Hash != hash {
+			continue
+		}
+
+		return s.swapRecoveryData(
+			swap.TypeIn, &swp.Loop, &swp.Contract.SwapContract,
+		)
+	}
+
+	return nil, loopdb.ErrSwapNotFound
+}
+
+// swapRecoveryData assembles the recovery data that loop outs and loop ins
+// have in common.
+func (s *Client) swapRecoveryData(swapType swap.Type, swp *loopdb.Loop,
+	contract *loopdb.SwapContract) (*SwapRecoveryData, error) {
+
+	// The store returns the decrypted preimage. Check it against the hash
+	// so that a preimage that wasn't decrypted correctly isn't exported as
+	// the means to recover the funds.
+	if contract.Preimage.Hash() != swp.Hash {
+		return nil, fmt.Errorf("preimage of swap %v doesn't match its "+
+			"hash", swp.Hash)
+	}
+
+	htlc, err := utils.GetHtlc(
+		swp.Hash, contract, s.lndServices.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	state := swp.State()
+	keys := contract.HtlcKeys
+	locator := keys.ClientScriptKeyLocator
+	hexKey := func(key [33]byte) string {
+		return hex.EncodeToString(key[:])
+	}
+
+	data := &SwapRecoveryData{
+		SwapType:               swapType.String(),
+		Hash:                   swp.Hash.String(),
+		Preimage:               contract.Preimage.String(),
+		State:                  state.State.String(),
+		AmountRequested:        int64(contract.AmountRequested),
+		CltvExpiry:             contract.CltvExpiry,
+		SenderScriptKey:        hexKey(keys.SenderScriptKey),
+		SenderInternalPubKey:   hexKey(keys.SenderInternalPubKey),
+		ReceiverScriptKey:      hexKey(keys.ReceiverScriptKey),
+		ReceiverInternalPubKey: hexKey(keys.ReceiverInternalPubKey),
+		ClientKeyFamily:        uint32(locator.Family),
+		ClientKeyIndex:         locator.Index,
+		ProtocolVersion:        uint32(contract.ProtocolVersion),
+		HtlcScriptVersion:      uint8(htlc.Version),
+		HtlcAddress:            htlc.Address.String(),
+		HtlcPkScript:           hex.EncodeToString(htlc.PkScript),
+	}
+
+	if state.HtlcTxHash != nil {
+		data.HtlcTxHash = state.HtlcTxHash.String()
+	}
+
+	return data, nil
+}
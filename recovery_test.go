@@ -0,0 +1,161 @@
+package loop
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExportSwapRecovery tests that the recovery data of a swap matches its
+// stored contract and htlc, and that the preimage is exported in plain text
+// from a database that encrypts it.
+func TestExportSwapRecovery(t *testing.T) {
+	lnd := test.NewMockLnd()
+	store := loopdb.NewTestDB(t)
+
+	secrets, err := loopdb.NewSecretCipher(
+		[loopdb.SecretKeySize]byte{1, 2, 3},
+	)
+	require.NoError(t, err)
+	store.EnableSecretEncryption(secrets)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		clientConfig: clientConfig{
+			Store: store,
+		},
+	}
+
+	_, senderPubKey := test.CreateKey(1)
+	var senderKey [33]byte
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+
+	_, receiverPubKey := test.CreateKey(2)
+	var receiverKey [33]byte
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	preimage := lntypes.Preimage{1}
+	hash := preimage.Hash()
+	destAddr := test.GetDestAddr(t, 0)
+
+	contract := &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			Preimage:        preimage,
+			AmountRequested: 50000,
+			CltvExpiry:      744,
+			HtlcKeys: loopdb.HtlcKeys{
+				SenderScriptKey:        senderKey,
+				SenderInternalPubKey:   senderKey,
+				ReceiverScriptKey:      receiverKey,
+				ReceiverInternalPubKey: receiverKey,
+				ClientScriptKeyLocator: keychain.KeyLocator{
+					Family: 42,
+					Index:  7,
+				},
+			},
+			InitiationTime:  time.Unix(1000, 0),
+			ProtocolVersion: loopdb.CurrentProtocolVersion(),
+		},
+		DestAddr:          destAddr,
+		SwapInvoice:       "swapinvoice",
+		PrepayInvoice:     "prepayinvoice",
+		SweepConfTarget:   2,
+		HtlcConfirmations: 2,
+	}
+
+	ctx := context.Background()
+	require.NoError(t, store.CreateLoopOut(ctx, hash, contract))
+
+	htlc, err := utils.GetHtlc(
+		hash, &contract.SwapContract, lnd.LndServices.ChainParams,
+	)
+	require.NoError(t, err)
+
+	data, err := client.ExportSwapRecovery(ctx, hash)
+	require.NoError(t, err)
+	require.Equal(t, &SwapRecoveryData{
+		SwapType:               "Out",
+		Hash:                   hash.String(),
+		Preimage:               preimage.String(),
+		State:                  loopdb.StateInitiated.String(),
+		AmountRequested:        50000,
+		CltvExpiry:             744,
+		SenderScriptKey:        hex.EncodeToString(senderKey[:]),
+		SenderInternalPubKey:   hex.EncodeToString(senderKey[:]),
+		ReceiverScriptKey:      hex.EncodeToString(receiverKey[:]),
+		ReceiverInternalPubKey: hex.EncodeToString(receiverKey[:]),
+		ClientKeyFamily:        42,
+		ClientKeyIndex:         7,
+		ProtocolVersion:        uint32(contract.ProtocolVersion),
+		HtlcScriptVersion:      uint8(htlc.Version),
+		HtlcAddress:            htlc.Address.String(),
+		HtlcPkScript:           hex.EncodeToString(htlc.PkScript),
+		DestAddr:               destAddr.String(),
+	}, data)
+
+	// The data is encoded with the documented field names, and decodes to
+	// the same data.
+	encoded, err := json.Marshal(data)
+	require.NoError(t, err)
+	require.Contains(t, string(encoded),
+		`"htlc_address":"`+htlc.Address.String()+`"`)
+	require.Contains(t, string(encoded),
+		`"dest_addr":"`+destAddr.String()+`"`)
+	require.NotContains(t, string(encoded), "htlc_tx_hash")
+
+	var decoded SwapRecoveryData
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, data, &decoded)
+
+	// Without the right key, the preimage can't be exported.
+	wrongSecrets, err := loopdb.NewSecretCipher(
+		[loopdb.SecretKeySize]byte{4, 5, 6},
+	)
+	require.NoError(t, err)
+	store.EnableSecretEncryption(wrongSecrets)
+
+	_, err = client.ExportSwapRecovery(ctx, hash)
+	require.ErrorIs(t, err, loopdb.ErrWrongSecretKey)
+
+	store.EnableSecretEncryption(secrets)
+
+	_, err = client.ExportSwapRecovery(ctx, lntypes.Hash{2})
+	require.ErrorIs(t, err, loopdb.ErrSwapNotFound)
+}
+
+// TestExportSwapRecoveryPreimageMismatch tests that a preimage that doesn't
+// match the swap hash isn't exported.
+func TestExportSwapRecoveryPreimageMismatch(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		clientConfig: clientConfig{
+			Store: store,
+		},
+	}
+
+	hash := lntypes.Hash{1}
+	store.LoopInSwaps[hash] = &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			Preimage:        lntypes.Preimage{2},
+			ProtocolVersion: loopdb.CurrentProtocolVersion(),
+		},
+	}
+	store.LoopInUpdates[hash] = []loopdb.SwapStateData{}
+
+	_, err := client.ExportSwapRecovery(context.Background(), hash)
+	require.ErrorContains(t, err, "doesn't match its hash")
+}
@@ -0,0 +1,203 @@
+package loop
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// ErrClientRunning is returned when the store is repaired while the
+	// client is executing swaps.
+	ErrClientRunning = errors.New("store can't be repaired while the " +
+		"client is running")
+)
+
+// RepairAction describes an inconsistency in the persisted state of a swap.
+type RepairAction struct {
+	// SwapHash is the hash of the swap.
+	SwapHash lntypes.Hash
+
+	// SwapType is the type of the swap.
+	SwapType swap.Type
+
+	// Issue describes the inconsistency.
+	Issue string
+
+	// ResolvedOnResume indicates whether the inconsistency is resolved by
+	// the swap's executor once the swap is resumed. Otherwise, it is only
+	// reported.
+	ResolvedOnResume bool
+}
+
+// RepairReport lists the inconsistencies that were found in the store.
+type RepairReport struct {
+	// Actions are the inconsistencies that were found, in the order in
+	// which they were found.
+	Actions []RepairAction
+}
+
+// add adds an inconsistency to the report.
+func (r *RepairReport) add(hash lntypes.Hash, swapType swap.Type,
+	resolved bool, format string, args ...interface{}) {
+
+	r.Actions = append(r.Actions, RepairAction{
+		SwapHash:         hash,
+		SwapType:         swapType,
+		Issue:            fmt.Sprintf(format, args...),
+		ResolvedOnResume: resolved,
+	})
+}
+
+// RepairStore checks the persisted swaps for inconsistencies. The store isn't
+// written to, state changes are left to the executor, which drives pending
+// swaps through their regular state transitions once they are resumed. The
+// report tells which inconsistencies are resolved that way. As the swaps are
+// only resumed when the client is started, the store can only be checked
+// before.
+func (s *Client) RepairStore(ctx context.Context) (*RepairReport, error) {
+	if atomic.LoadUint32(&s.started) != 0 {
+		return nil, ErrClientRunning
+	}
+
+	info, err := s.lndServices.Client.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	height := int32(info.BlockHeight)
+
+	report := &RepairReport{}
+
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swp := range loopOutSwaps {
+		checkLoopOut(swp, height, report)
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swp := range loopInSwaps {
+		checkLoopIn(swp, height, report)
+	}
+
+	return report, nil
+}
+
+// checkLoopOut checks a loop out swap for inconsistencies.
+func checkLoopOut(swp *loopdb.LoopOut, height int32, report *RepairReport) {
+	contract := &swp.Contract.SwapContract
+	state := swp.State()
+
+	if !preimageMatches(swp.Hash, contract.Preimage) {
+		report.add(
+			swp.Hash, swap.TypeOut, false, "preimage doesn't match "+
+				"swap hash",
+		)
+	}
+
+	switch state.State {
+	// A loop out that didn't reveal its preimage before the reveal
+	// deadline can't complete anymore. The executor fails it on resume,
+	// after it stopped waiting for its payments.
+	case loopdb.StateInitiated:
+		maxRevealHeight := contract.CltvExpiry -
+			MinLoopOutPreimageRevealDelta
+		if height <= maxRevealHeight {
+			return
+		}
+
+		report.add(
+			swp.Hash, swap.TypeOut, true, "initiated past preimage "+
+				"reveal height %v (height %v), times out on "+
+				"resume", maxRevealHeight, height,
+		)
+
+	// The server's htlc isn't a wallet transaction, so a missing htlc tx
+	// can't be recovered from the store. A pending swap still resumes by
+	// watching the htlc script.
+	case loopdb.StatePreimageRevealed, loopdb.StateSuccess:
+		if recordedHtlcTxHash(swp.Events) != nil {
+			return
+		}
+
+		report.add(
+			swp.Hash, swap.TypeOut, state.State.Type() ==
+				loopdb.StateTypePending, "no htlc tx recorded "+
+				"in state %v", state.State,
+		)
+	}
+}
+
+// checkLoopIn checks a loop in swap for inconsistencies.
+func checkLoopIn(swp *loopdb.LoopIn, height int32, report *RepairReport) {
+	contract := &swp.Contract.SwapContract
+	state := swp.State()
+
+	if !preimageMatches(swp.Hash, contract.Preimage) {
+		report.add(
+			swp.Hash, swap.TypeIn, false, "preimage doesn't match "+
+				"swap hash",
+		)
+	}
+
+	switch state.State {
+	// The htlc is only published after the swap moved to the published
+	// state, so an initiated loop in past its expiry never published its
+	// htlc. The executor fails it on resume and cancels its invoice.
+	case loopdb.StateInitiated:
+		if height < contract.CltvExpiry {
+			return
+		}
+
+		report.add(
+			swp.Hash, swap.TypeIn, true, "initiated past expiry "+
+				"height %v (height %v), times out on resume",
+			contract.CltvExpiry, height,
+		)
+
+	// The published state is recorded before the htlc is published and
+	// the htlc tx afterwards. If the client stopped in between, the
+	// executor finds the htlc by its script on resume.
+	case loopdb.StateHtlcPublished:
+		if swp.Contract.ExternalHtlc ||
+			recordedHtlcTxHash(swp.Events) != nil {
+
+			return
+		}
+
+		report.add(
+			swp.Hash, swap.TypeIn, true, "no htlc tx recorded, "+
+				"looked up by htlc script on resume",
+		)
+	}
+}
+
+// preimageMatches returns whether the preimage hashes to the swap hash.
+func preimageMatches(hash lntypes.Hash, preimage lntypes.Preimage) bool {
+	return lntypes.Hash(sha256.Sum256(preimage[:])) == hash
+}
+
+// recordedHtlcTxHash returns the last htlc tx hash that was recorded in the
+// swap's events, or nil if none was recorded.
+func recordedHtlcTxHash(events []*loopdb.LoopEvent) *chainhash.Hash {
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].HtlcTxHash != nil {
+			return events[i].HtlcTxHash
+		}
+	}
+
+	return nil
+}
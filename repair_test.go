@@ -0,0 +1,133 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRepairStore tests that inconsistent swaps are reported, and that the
+// store is left to the executor to update.
+func TestRepairStore(t *testing.T) {
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		clientConfig: clientConfig{
+			Store: store,
+		},
+	}
+
+	_, senderPubKey := test.CreateKey(1)
+	var senderKey [33]byte
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+
+	_, receiverPubKey := test.CreateKey(2)
+	var receiverKey [33]byte
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	newContract := func(preimage lntypes.Preimage,
+		expiry int32) loopdb.SwapContract {
+
+		return loopdb.SwapContract{
+			Preimage:        preimage,
+			AmountRequested: 50000,
+			CltvExpiry:      expiry,
+			HtlcKeys: loopdb.HtlcKeys{
+				SenderScriptKey:        senderKey,
+				SenderInternalPubKey:   senderKey,
+				ReceiverScriptKey:      receiverKey,
+				ReceiverInternalPubKey: receiverKey,
+			},
+			ProtocolVersion: loopdb.CurrentProtocolVersion(),
+		}
+	}
+
+	// The mock lnd reports a height of 600, so this loop out is past its
+	// preimage reveal height.
+	expiredPreimage := lntypes.Preimage{1}
+	expiredHash := expiredPreimage.Hash()
+	store.LoopOutSwaps[expiredHash] = &loopdb.LoopOutContract{
+		SwapContract: newContract(expiredPreimage, 610),
+	}
+	store.LoopOutUpdates[expiredHash] = []loopdb.SwapStateData{}
+
+	// This loop out has a preimage that doesn't match its hash and no
+	// recorded htlc tx, which can only be reported.
+	brokenHash := lntypes.Hash{2}
+	store.LoopOutSwaps[brokenHash] = &loopdb.LoopOutContract{
+		SwapContract: newContract(lntypes.Preimage{3}, 1000),
+	}
+	store.LoopOutUpdates[brokenHash] = []loopdb.SwapStateData{
+		{State: loopdb.StatePreimageRevealed},
+	}
+
+	// The htlc of this loop in was published, but not recorded.
+	loopInPreimage := lntypes.Preimage{4}
+	loopInHash := loopInPreimage.Hash()
+	store.LoopInSwaps[loopInHash] = &loopdb.LoopInContract{
+		SwapContract: newContract(loopInPreimage, 1000),
+	}
+	store.LoopInUpdates[loopInHash] = []loopdb.SwapStateData{
+		{State: loopdb.StateHtlcPublished},
+	}
+
+	// This loop out succeeded without recording its htlc tx, which can't
+	// be resolved anymore.
+	successPreimage := lntypes.Preimage{5}
+	successHash := successPreimage.Hash()
+	store.LoopOutSwaps[successHash] = &loopdb.LoopOutContract{
+		SwapContract: newContract(successPreimage, 1000),
+	}
+	store.LoopOutUpdates[successHash] = []loopdb.SwapStateData{
+		{State: loopdb.StateSuccess},
+	}
+
+	report, err := client.RepairStore(context.Background())
+	require.NoError(t, err)
+
+	// No state was written, the swaps are resolved when they are resumed.
+	require.NoError(t, store.IsDone())
+	require.Empty(t, store.LoopOutUpdates[expiredHash])
+	require.Len(t, store.LoopInUpdates[loopInHash], 1)
+
+	resolved := make(map[lntypes.Hash]int)
+	reported := make(map[lntypes.Hash]int)
+	for _, action := range report.Actions {
+		if action.ResolvedOnResume {
+			resolved[action.SwapHash]++
+		} else {
+			reported[action.SwapHash]++
+		}
+	}
+
+	require.Equal(t, map[lntypes.Hash]int{
+		expiredHash: 1,
+		brokenHash:  1,
+		loopInHash:  1,
+	}, resolved)
+	require.Equal(t, map[lntypes.Hash]int{
+		brokenHash:  1,
+		successHash: 1,
+	}, reported)
+
+	for _, action := range report.Actions {
+		expectedType := swap.TypeOut
+		if action.SwapHash == loopInHash {
+			expectedType = swap.TypeIn
+		}
+
+		require.Equal(t, expectedType, action.SwapType)
+	}
+
+	// The store can't be repaired while the client is running.
+	client.started = 1
+	_, err = client.RepairStore(context.Background())
+	require.ErrorIs(t, err, ErrClientRunning)
+}
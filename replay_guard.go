@@ -0,0 +1,291 @@
+package loop
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// paymentsPageSize is the number of payments that we query from lnd at once
+// when looking for settled swap payments.
+const paymentsPageSize = 500
+
+// reconcileResumedSwaps checks the stored state of the pending swaps that are
+// about to be resumed against what lnd knows about them. If the store was
+// restored from an old backup, a swap may be stored in a state that it has
+// already advanced past. Re-driving such a swap from its stored state could
+// cause us to pay or publish twice, or to give up on a swap whose htlc still
+// needs to be swept. Swaps that are found to be stale are moved forward to the
+// state that matches what already happened on-chain or off-chain. Failing to
+// query lnd is not fatal, the affected swaps are resumed from their stored
+// state as before.
+func (s *Client) reconcileResumedSwaps(ctx context.Context,
+	loopOutSwaps []*loopdb.LoopOut, loopInSwaps []*loopdb.LoopIn) error {
+
+	err := s.reconcileStaleLoopOuts(ctx, loopOutSwaps)
+	if err != nil {
+		return err
+	}
+
+	return s.reconcileStaleLoopIns(ctx, loopInSwaps)
+}
+
+// reconcileStaleLoopOuts looks up the swap payments of all loop outs that have
+// not revealed their preimage according to the store. The server can only
+// settle the swap payment once it learned the preimage, so if the payment has
+// already succeeded, we move the swap to the preimage revealed state. This
+// makes sure that the swap will be swept rather than abandoned.
+func (s *Client) reconcileStaleLoopOuts(ctx context.Context,
+	swaps []*loopdb.LoopOut) error {
+
+	var (
+		initiated []*loopdb.LoopOut
+		hashes    = make(map[lntypes.Hash]struct{})
+		since     time.Time
+	)
+	for _, pend := range swaps {
+		if pend.State().State != loopdb.StateInitiated {
+			continue
+		}
+
+		initiationTime := pend.Contract.InitiationTime
+		if len(initiated) == 0 || initiationTime.Before(since) {
+			since = initiationTime
+		}
+
+		initiated = append(initiated, pend)
+		hashes[pend.Hash] = struct{}{}
+	}
+
+	if len(initiated) == 0 {
+		return nil
+	}
+
+	settled, err := fetchSettledPayments(
+		ctx, s.lndServices.Client, hashes, since,
+	)
+	if err != nil {
+		log.Warnf("Unable to look up the swap payments of %v pending "+
+			"loop outs, resuming them from their stored state: %v",
+			len(initiated), err)
+
+		return nil
+	}
+
+	for _, pend := range initiated {
+		if _, ok := settled[pend.Hash]; !ok {
+			continue
+		}
+
+		log.Warnf("Loop out %v is stored as %v, but its swap payment "+
+			"already succeeded. The swap store may have been "+
+			"restored from an old backup, resuming swap with "+
+			"preimage revealed", pend.Hash, loopdb.StateInitiated)
+
+		state := pend.State()
+		state.State = loopdb.StatePreimageRevealed
+
+		updateTime := time.Now()
+		err := s.Store.UpdateLoopOut(ctx, pend.Hash, updateTime, state)
+		if err != nil {
+			return err
+		}
+
+		pend.Events = append(pend.Events, &loopdb.LoopEvent{
+			SwapStateData: state,
+			Time:          updateTime,
+		})
+	}
+
+	return nil
+}
+
+// reconcileStaleLoopIns looks for the htlc transactions of all loop ins that
+// have not published their htlc according to the store. If our wallet already
+// knows a transaction paying to the swap's htlc, we move the swap to the htlc
+// published state so that we don't publish the htlc a second time.
+func (s *Client) reconcileStaleLoopIns(ctx context.Context,
+	swaps []*loopdb.LoopIn) error {
+
+	var (
+		initiated   []*loopdb.LoopIn
+		startHeight int32
+	)
+	for _, pend := range swaps {
+		if pend.State().State != loopdb.StateInitiated ||
+			pend.Contract.ExternalHtlc {
+
+			continue
+		}
+
+		initiationHeight := pend.Contract.InitiationHeight
+		if len(initiated) == 0 || initiationHeight < startHeight {
+			startHeight = initiationHeight
+		}
+
+		initiated = append(initiated, pend)
+	}
+
+	if len(initiated) == 0 {
+		return nil
+	}
+
+	// Include unconfirmed transactions, since the htlc may have been
+	// published just before the backup was restored.
+	txs, err := s.lndServices.Client.ListTransactions(
+		ctx, startHeight, -1,
+	)
+	if err != nil {
+		log.Warnf("Unable to look up the htlc txns of %v pending loop "+
+			"ins, resuming them from their stored state: %v",
+			len(initiated), err)
+
+		return nil
+	}
+
+	for _, pend := range initiated {
+		htlc, err := utils.GetHtlc(
+			pend.Hash, &pend.Contract.SwapContract,
+			s.lndServices.ChainParams,
+		)
+		if err != nil {
+			return err
+		}
+
+		htlcTx := findHtlcTx(txs, htlc.PkScript)
+		if htlcTx == nil {
+			continue
+		}
+
+		htlcTxHash := htlcTx.Tx.TxHash()
+
+		log.Warnf("Loop in %v is stored as %v, but htlc tx %v was "+
+			"already published. The swap store may have been "+
+			"restored from an old backup, resuming swap with "+
+			"htlc published", pend.Hash, loopdb.StateInitiated,
+			htlcTxHash)
+
+		state := pend.State()
+		state.State = loopdb.StateHtlcPublished
+		state.HtlcTxHash = &htlcTxHash
+
+		updateTime := time.Now()
+		err = s.Store.UpdateLoopIn(ctx, pend.Hash, updateTime, state)
+		if err != nil {
+			return err
+		}
+
+		pend.Events = append(pend.Events, &loopdb.LoopEvent{
+			SwapStateData: state,
+			Time:          updateTime,
+		})
+	}
+
+	return nil
+}
+
+// fetchSettledPayments returns the hashes of the given set that belong to
+// payments of the lnd node that have succeeded. Payments are queried from the
+// newest to the oldest, and the query stops once all hashes were found or the
+// payments were made before the given time.
+func fetchSettledPayments(ctx context.Context,
+	client lndclient.LightningClient, hashes map[lntypes.Hash]struct{},
+	since time.Time) (map[lntypes.Hash]struct{}, error) {
+
+	settled := make(map[lntypes.Hash]struct{})
+
+	req := lndclient.ListPaymentsRequest{
+		MaxPayments: paymentsPageSize,
+		Reversed:    true,
+	}
+
+	for {
+		resp, err := client.ListPayments(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		// lnd returns each page of a reversed query in ascending
+		// order, so the page is scanned from its end to visit the
+		// payments from the newest to the oldest.
+		for i := len(resp.Payments) - 1; i >= 0; i-- {
+			payment := resp.Payments[i]
+
+			created, ok := paymentTime(payment)
+			if ok && created.Before(since) {
+				return settled, nil
+			}
+
+			if _, ok := hashes[payment.Hash]; !ok {
+				continue
+			}
+
+			if payment.Status == nil ||
+				payment.Status.State != lnrpc.Payment_SUCCEEDED {
+
+				continue
+			}
+
+			settled[payment.Hash] = struct{}{}
+			if len(settled) == len(hashes) {
+				return settled, nil
+			}
+		}
+
+		if uint64(len(resp.Payments)) < req.MaxPayments {
+			return settled, nil
+		}
+
+		// Reversed queries page backwards from the first index of the
+		// previous response.
+		req.Offset = resp.FirstIndexOffset
+	}
+}
+
+// paymentTime returns the time of the first htlc attempt of a payment, which
+// is the closest to its creation time that lnd reports. False is returned if
+// the payment has no attempts.
+func paymentTime(payment lndclient.Payment) (time.Time, bool) {
+	var first int64
+	for _, htlc := range payment.Htlcs {
+		if htlc == nil || htlc.AttemptTimeNs == 0 {
+			continue
+		}
+
+		if first == 0 || htlc.AttemptTimeNs < first {
+			first = htlc.AttemptTimeNs
+		}
+	}
+
+	if first == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, first), true
+}
+
+// findHtlcTx returns the first transaction in the set that has an output
+// paying to the given htlc pkscript, or nil if there is none.
+func findHtlcTx(txs []lndclient.Transaction,
+	pkScript []byte) *lndclient.Transaction {
+
+	for i := range txs {
+		if txs[i].Tx == nil {
+			continue
+		}
+
+		for _, txOut := range txs[i].Tx.TxOut {
+			if bytes.Equal(txOut.PkScript, pkScript) {
+				return &txs[i]
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReconcileResumedSwaps tests that swaps with a stale stored state are
+// moved forward before they are resumed, while all other swaps are left
+// untouched.
+func TestReconcileResumedSwaps(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		clientConfig: clientConfig{
+			Store: store,
+		},
+	}
+
+	// Add two initiated loop outs, the payment of only one of them has
+	// already succeeded.
+	settledHash := lntypes.Hash{1}
+	pendingHash := lntypes.Hash{2}
+	for _, hash := range []lntypes.Hash{settledHash, pendingHash} {
+		store.LoopOutSwaps[hash] = &loopdb.LoopOutContract{}
+		store.LoopOutUpdates[hash] = []loopdb.SwapStateData{}
+	}
+
+	lnd.Payments = []lndclient.Payment{
+		{
+			Hash: settledHash,
+			Status: &lndclient.PaymentStatus{
+				State: lnrpc.Payment_SUCCEEDED,
+			},
+		},
+		{
+			Hash: pendingHash,
+			Status: &lndclient.PaymentStatus{
+				State: lnrpc.Payment_IN_FLIGHT,
+			},
+		},
+	}
+
+	// Add an initiated loop in whose htlc has already been published.
+	_, senderPubKey := test.CreateKey(1)
+	var senderKey [33]byte
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+
+	_, receiverPubKey := test.CreateKey(2)
+	var receiverKey [33]byte
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	loopInHash := lntypes.Hash{3}
+	loopInContract := &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			AmountRequested: 50000,
+			CltvExpiry:      744,
+			HtlcKeys: loopdb.HtlcKeys{
+				SenderScriptKey:        senderKey,
+				SenderInternalPubKey:   senderKey,
+				ReceiverScriptKey:      receiverKey,
+				ReceiverInternalPubKey: receiverKey,
+			},
+			ProtocolVersion: loopdb.CurrentProtocolVersion(),
+		},
+	}
+	store.LoopInSwaps[loopInHash] = loopInContract
+	store.LoopInUpdates[loopInHash] = []loopdb.SwapStateData{}
+
+	htlc, err := utils.GetHtlc(
+		loopInHash, &loopInContract.SwapContract,
+		lnd.LndServices.ChainParams,
+	)
+	require.NoError(t, err)
+
+	htlcTx := wire.NewMsgTx(2)
+	htlcTx.AddTxOut(&wire.TxOut{
+		PkScript: htlc.PkScript,
+		Value:    int64(loopInContract.AmountRequested),
+	})
+	lnd.AddTx(htlcTx)
+
+	ctx := context.Background()
+
+	loopOuts, err := store.FetchLoopOutSwaps(ctx)
+	require.NoError(t, err)
+
+	loopIns, err := store.FetchLoopInSwaps(ctx)
+	require.NoError(t, err)
+
+	err = client.reconcileResumedSwaps(ctx, loopOuts, loopIns)
+	require.NoError(t, err)
+
+	store.AssertLoopOutState(loopdb.StatePreimageRevealed)
+	state := store.AssertLoopInState(loopdb.StateHtlcPublished)
+
+	htlcTxHash := htlcTx.TxHash()
+	require.Equal(t, &htlcTxHash, state.HtlcTxHash)
+
+	require.NoError(t, store.IsDone())
+
+	// The swaps that are passed on for resumption should reflect the
+	// reconciled state.
+	for _, loopOut := range loopOuts {
+		expected := loopdb.StateInitiated
+		if loopOut.Hash == settledHash {
+			expected = loopdb.StatePreimageRevealed
+		}
+
+		require.Equal(t, expected, loopOut.State().State)
+	}
+
+	require.Len(t, loopIns, 1)
+	require.Equal(t, loopdb.StateHtlcPublished, loopIns[0].State().State)
+	require.Equal(t, &htlcTxHash, loopIns[0].State().HtlcTxHash)
+}
+
+// paymentsClient is a lightning client that serves payments from a list, the
+// oldest payment first, or fails all queries with an error. Like lnd, it
+// returns the pages of reversed queries in ascending order.
+type paymentsClient struct {
+	lndclient.LightningClient
+
+	payments []lndclient.Payment
+	err      error
+	queries  int
+}
+
+// ListPayments returns a page of payments, querying backwards from the offset.
+// The index of a payment is its position in the list plus one, and the offset
+// is exclusive.
+func (c *paymentsClient) ListPayments(_ context.Context,
+	req lndclient.ListPaymentsRequest) (*lndclient.ListPaymentsResponse,
+	error) {
+
+	c.queries++
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	end := len(c.payments)
+	if req.Offset != 0 {
+		end = int(req.Offset) - 1
+	}
+
+	start := end - int(req.MaxPayments)
+	if start < 0 {
+		start = 0
+	}
+
+	return &lndclient.ListPaymentsResponse{
+		FirstIndexOffset: uint64(start + 1),
+		LastIndexOffset:  uint64(end),
+		Payments:         c.payments[start:end],
+	}, nil
+}
+
+// ListTransactions fails if the client was set up with an error.
+func (c *paymentsClient) ListTransactions(context.Context, int32, int32,
+	...lndclient.ListTransactionsOption) ([]lndclient.Transaction, error) {
+
+	return nil, c.err
+}
+
+// TestFetchSettledPayments tests that only the payments of the requested
+// hashes are returned, and that the query stops at payments that were made
+// before the given time.
+func TestFetchSettledPayments(t *testing.T) {
+	now := time.Now()
+
+	payment := func(hash lntypes.Hash, age time.Duration,
+		state lnrpc.Payment_PaymentStatus) lndclient.Payment {
+
+		return lndclient.Payment{
+			Hash: hash,
+			Status: &lndclient.PaymentStatus{
+				State: state,
+			},
+			Htlcs: []*lnrpc.HTLCAttempt{{
+				AttemptTimeNs: now.Add(-age).UnixNano(),
+			}},
+		}
+	}
+
+	// Fill a few pages with payments, the swap payment being the oldest
+	// one that was made after the swap was initiated.
+	swapHash := lntypes.Hash{1}
+	payments := []lndclient.Payment{
+		payment(lntypes.Hash{3}, 2*time.Hour, lnrpc.Payment_SUCCEEDED),
+		payment(swapHash, time.Hour, lnrpc.Payment_SUCCEEDED),
+	}
+	for i := 0; i < 2*paymentsPageSize; i++ {
+		payments = append(payments, payment(
+			lntypes.Hash{2}, time.Minute,
+			lnrpc.Payment_SUCCEEDED,
+		))
+	}
+
+	client := &paymentsClient{payments: payments}
+	hashes := map[lntypes.Hash]struct{}{
+		swapHash: {},
+	}
+
+	settled, err := fetchSettledPayments(
+		context.Background(), client, hashes, now.Add(-time.Hour),
+	)
+	require.NoError(t, err)
+	require.Equal(t, hashes, settled)
+	require.Equal(t, 3, client.queries)
+
+	// Payments that were made before the swap was initiated are not
+	// looked at.
+	client = &paymentsClient{payments: payments}
+	settled, err = fetchSettledPayments(
+		context.Background(), client, hashes, now.Add(-30*time.Minute),
+	)
+	require.NoError(t, err)
+	require.Empty(t, settled)
+
+	// A swap payment that follows an older payment on the same page is
+	// found.
+	client = &paymentsClient{payments: []lndclient.Payment{
+		payment(lntypes.Hash{3}, 2*time.Hour, lnrpc.Payment_SUCCEEDED),
+		payment(swapHash, 10*time.Minute, lnrpc.Payment_SUCCEEDED),
+	}}
+	settled, err = fetchSettledPayments(
+		context.Background(), client, hashes, now.Add(-time.Hour),
+	)
+	require.NoError(t, err)
+	require.Equal(t, hashes, settled)
+	require.Equal(t, 1, client.queries)
+}
+
+// TestReconcileResumedSwapsLndError tests that swaps are resumed from their
+// stored state if lnd can't be queried.
+func TestReconcileResumedSwapsLndError(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	lnd.Client = &paymentsClient{err: errors.New("lnd unavailable")}
+	store := loopdb.NewStoreMock(t)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		clientConfig: clientConfig{
+			Store: store,
+		},
+	}
+
+	loopOuts := []*loopdb.LoopOut{{
+		Loop: loopdb.Loop{
+			Hash: lntypes.Hash{1},
+		},
+		Contract: &loopdb.LoopOutContract{},
+	}}
+	loopIns := []*loopdb.LoopIn{{
+		Loop: loopdb.Loop{
+			Hash: lntypes.Hash{2},
+		},
+		Contract: &loopdb.LoopInContract{},
+	}}
+
+	err := client.reconcileResumedSwaps(
+		context.Background(), loopOuts, loopIns,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, loopdb.StateInitiated, loopOuts[0].State().State)
+	require.Equal(t, loopdb.StateInitiated, loopIns[0].State().State)
+	require.NoError(t, store.IsDone())
+}
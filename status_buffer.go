@@ -0,0 +1,91 @@
+package loop
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// statusBuffer sits between the swaps and the consumer of their status
+// updates. It queues up to a fixed number of updates so that a slow consumer
+// doesn't block the execution of the swaps. While an update is queued, a newer
+// update of the same swap replaces it, so a consumer that falls behind only
+// misses intermediate states. Updates with a final state are never replaced.
+// The updates of a single swap are delivered in order.
+type statusBuffer struct {
+	// size is the maximum number of queued updates. Once the queue is
+	// full, the swaps block until the consumer catches up.
+	size int
+
+	// in receives the updates from the swaps.
+	in chan SwapInfo
+
+	// out delivers the updates to the consumer.
+	out chan<- SwapInfo
+
+	queue []SwapInfo
+}
+
+// newStatusBuffer returns a status buffer that queues up to size updates
+// before delivering them to the out channel.
+func newStatusBuffer(size int, out chan<- SwapInfo) *statusBuffer {
+	return &statusBuffer{
+		size: size,
+		in:   make(chan SwapInfo),
+		out:  out,
+	}
+}
+
+// run delivers the queued updates to the consumer until the context is
+// canceled.
+func (b *statusBuffer) run(ctx context.Context) {
+	for {
+		var (
+			in   <-chan SwapInfo
+			out  chan<- SwapInfo
+			next SwapInfo
+		)
+
+		// Stop accepting updates while the queue is full, which makes
+		// the swaps wait for the consumer.
+		if len(b.queue) < b.size {
+			in = b.in
+		}
+
+		if len(b.queue) > 0 {
+			out = b.out
+			next = b.queue[0]
+		}
+
+		select {
+		case update := <-in:
+			b.add(update)
+
+		case out <- next:
+			b.queue = b.queue[1:]
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// add queues the update. If the last queued update of the same swap doesn't
+// have a final state yet, it is replaced by the new update instead.
+func (b *statusBuffer) add(update SwapInfo) {
+	for i := len(b.queue) - 1; i >= 0; i-- {
+		if b.queue[i].SwapHash != update.SwapHash {
+			continue
+		}
+
+		if b.queue[i].State.Type() == loopdb.StateTypePending {
+			b.queue[i] = update
+
+			return
+		}
+
+		break
+	}
+
+	b.queue = append(b.queue, update)
+}
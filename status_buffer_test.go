@@ -0,0 +1,67 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStatusBuffer tests that queued intermediate updates of a swap are
+// replaced by newer ones, while final states and the order of the updates of
+// each swap are preserved.
+func TestStatusBuffer(t *testing.T) {
+	defer test.Guard(t)()
+
+	newUpdate := func(hash lntypes.Hash,
+		state loopdb.SwapState) SwapInfo {
+
+		return SwapInfo{
+			SwapHash: hash,
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+			},
+		}
+	}
+
+	hash1 := lntypes.Hash{1}
+	hash2 := lntypes.Hash{2}
+
+	out := make(chan SwapInfo)
+	buffer := newStatusBuffer(10, out)
+
+	// Queue the updates before anything is read by the consumer.
+	buffer.add(newUpdate(hash1, loopdb.StateInitiated))
+	buffer.add(newUpdate(hash2, loopdb.StateInitiated))
+	buffer.add(newUpdate(hash1, loopdb.StatePreimageRevealed))
+	buffer.add(newUpdate(hash1, loopdb.StateSuccess))
+	buffer.add(newUpdate(hash1, loopdb.StateSuccess))
+	buffer.add(newUpdate(hash2, loopdb.StateHtlcPublished))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		buffer.run(ctx)
+	}()
+
+	expected := []SwapInfo{
+		newUpdate(hash1, loopdb.StateSuccess),
+		newUpdate(hash2, loopdb.StateHtlcPublished),
+		newUpdate(hash1, loopdb.StateSuccess),
+	}
+	for _, update := range expected {
+		require.Equal(t, update, <-out)
+	}
+
+	// New updates are delivered once the queue is empty.
+	buffer.in <- newUpdate(hash2, loopdb.StateSuccess)
+	require.Equal(t, newUpdate(hash2, loopdb.StateSuccess), <-out)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,39 @@
+package loop
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// forwardQueue delivers the items of a subscriber's queue to out until the
+// context is canceled or quit is closed, and then calls done. The queue
+// buffers the items for the subscriber, so that a slow subscriber doesn't
+// hold back the publisher or the other subscribers. A nil quit channel is
+// never closed.
+func forwardQueue[T any](ctx context.Context, q *queue.ConcurrentQueue,
+	out chan<- T, quit <-chan struct{}, done func()) {
+
+	go func() {
+		defer done()
+
+		for {
+			select {
+			case item := <-q.ChanOut():
+				select {
+				case out <- item.(T):
+				case <-ctx.Done():
+					return
+				case <-quit:
+					return
+				}
+
+			case <-ctx.Done():
+				return
+
+			case <-quit:
+				return
+			}
+		}
+	}()
+}
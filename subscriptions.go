@@ -0,0 +1,186 @@
+package loop
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// SwapFilter selects the swap updates that a subscriber receives.
+type SwapFilter struct {
+	// SwapHashes are the swaps to receive updates of. If empty, the
+	// updates of all swaps are received.
+	SwapHashes []lntypes.Hash
+
+	// StateTypes are the state types to receive updates of. If empty, the
+	// updates of all state types are received.
+	StateTypes []loopdb.SwapStateType
+}
+
+// matches returns whether the update passes the filter.
+func (f *SwapFilter) matches(info *SwapInfo) bool {
+	if len(f.SwapHashes) > 0 {
+		found := false
+		for _, hash := range f.SwapHashes {
+			if hash == info.SwapHash {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.StateTypes) > 0 {
+		stateType := info.State.Type()
+		for _, filterType := range f.StateTypes {
+			if filterType == stateType {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// swapSubscriber is a subscriber to the swap updates.
+type swapSubscriber struct {
+	filter SwapFilter
+
+	// queue buffers the updates for the subscriber, so that a slow
+	// subscriber doesn't hold back the swaps or the other subscribers.
+	queue *queue.ConcurrentQueue
+}
+
+// swapSubscriptions delivers the swap updates to any number of subscribers,
+// each with its own stream.
+type swapSubscriptions struct {
+	// subscribers are the subscribers by subscriber id.
+	subscribers map[int]*swapSubscriber
+
+	// nextID is the id of the next subscriber.
+	nextID int
+
+	// swaps is the last update of every swap that was updated since the
+	// client started. New subscribers receive these first.
+	swaps map[lntypes.Hash]SwapInfo
+
+	mu sync.Mutex
+}
+
+// newSwapSubscriptions returns a swap subscription manager without
+// subscribers.
+func newSwapSubscriptions() *swapSubscriptions {
+	return &swapSubscriptions{
+		subscribers: make(map[int]*swapSubscriber),
+		swaps:       make(map[lntypes.Hash]SwapInfo),
+	}
+}
+
+// subscribe registers a subscriber for the updates that pass the filter. The
+// last update of every matching swap is delivered first, ordered by update
+// time. The updates of a single swap are delivered in order. The subscription
+// ends when the context is canceled, which closes the returned channel.
+func (s *swapSubscriptions) subscribe(ctx context.Context,
+	filter SwapFilter) <-chan SwapInfo {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subscriber := &swapSubscriber{
+		filter: filter,
+		queue:  queue.NewConcurrentQueue(20),
+	}
+	subscriber.queue.Start()
+
+	// The snapshot is queued within the lock, so that the subscriber
+	// receives no update twice and misses none.
+	snapshot := make([]SwapInfo, 0, len(s.swaps))
+	for _, info := range s.swaps {
+		info := info
+		if filter.matches(&info) {
+			snapshot = append(snapshot, info)
+		}
+	}
+
+	sort.Slice(snapshot, func(i, j int) bool {
+		return snapshot[i].LastUpdate.Before(snapshot[j].LastUpdate)
+	})
+
+	for _, info := range snapshot {
+		subscriber.queue.ChanIn() <- info
+	}
+
+	id := s.nextID
+	s.nextID++
+	s.subscribers[id] = subscriber
+
+	updateChan := make(chan SwapInfo)
+	forwardQueue(ctx, subscriber.queue, updateChan, nil, func() {
+		s.unsubscribe(id, subscriber)
+		close(updateChan)
+	})
+
+	return updateChan
+}
+
+// publish records the update and delivers it to the subscribers that it
+// matches.
+func (s *swapSubscriptions) publish(info SwapInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.swaps[info.SwapHash] = info
+
+	for _, subscriber := range s.subscribers {
+		if subscriber.filter.matches(&info) {
+			subscriber.queue.ChanIn() <- info
+		}
+	}
+}
+
+// forward publishes the updates from the in channel and then delivers them to
+// the out channel, until the context is canceled. If out is nil, the updates
+// are only published.
+func (s *swapSubscriptions) forward(ctx context.Context, in <-chan SwapInfo,
+	out chan<- SwapInfo) {
+
+	for {
+		select {
+		case info := <-in:
+			s.publish(info)
+
+			if out == nil {
+				continue
+			}
+
+			select {
+			case out <- info:
+			case <-ctx.Done():
+				return
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// unsubscribe removes a subscriber.
+func (s *swapSubscriptions) unsubscribe(id int, subscriber *swapSubscriber) {
+	s.mu.Lock()
+	delete(s.subscribers, id)
+	s.mu.Unlock()
+
+	// The subscriber is removed, so no updates are added to its queue
+	// anymore and it's safe to stop it.
+	subscriber.queue.Stop()
+}
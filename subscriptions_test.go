@@ -0,0 +1,107 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// receiveUpdate asserts that an update of the swap with the given state is
+// received.
+func receiveUpdate(t *testing.T, updates <-chan SwapInfo, hash lntypes.Hash,
+	state loopdb.SwapState) {
+
+	t.Helper()
+
+	select {
+	case info := <-updates:
+		require.Equal(t, hash, info.SwapHash)
+		require.Equal(t, state, info.State)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("no update received")
+	}
+}
+
+// TestSwapSubscriptions tests that subscribers receive the updates that pass
+// their filter, with late subscribers receiving the last state of each swap
+// first.
+func TestSwapSubscriptions(t *testing.T) {
+	subscriptions := newSwapSubscriptions()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hash1 := lntypes.Hash{1}
+	hash2 := lntypes.Hash{2}
+
+	now := time.Now()
+	newUpdate := func(hash lntypes.Hash, state loopdb.SwapState,
+		age time.Duration) SwapInfo {
+
+		return SwapInfo{
+			SwapHash:   hash,
+			LastUpdate: now.Add(-age),
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+			},
+		}
+	}
+
+	all := subscriptions.subscribe(ctx, SwapFilter{})
+
+	subscriptions.publish(newUpdate(hash1, loopdb.StateInitiated, 3))
+	subscriptions.publish(newUpdate(hash2, loopdb.StateInitiated, 2))
+	subscriptions.publish(newUpdate(hash1, loopdb.StateSuccess, 1))
+
+	receiveUpdate(t, all, hash1, loopdb.StateInitiated)
+	receiveUpdate(t, all, hash2, loopdb.StateInitiated)
+	receiveUpdate(t, all, hash1, loopdb.StateSuccess)
+
+	// A late subscriber receives the last state of each swap first,
+	// oldest first.
+	late := subscriptions.subscribe(ctx, SwapFilter{})
+	receiveUpdate(t, late, hash2, loopdb.StateInitiated)
+	receiveUpdate(t, late, hash1, loopdb.StateSuccess)
+
+	// Filtered subscribers only receive matching updates, including
+	// in their snapshot.
+	byHash := subscriptions.subscribe(ctx, SwapFilter{
+		SwapHashes: []lntypes.Hash{hash2},
+	})
+	receiveUpdate(t, byHash, hash2, loopdb.StateInitiated)
+
+	byType := subscriptions.subscribe(ctx, SwapFilter{
+		StateTypes: []loopdb.SwapStateType{loopdb.StateTypeFail},
+	})
+
+	subscriptions.publish(newUpdate(hash2, loopdb.StateFailTimeout, 0))
+
+	receiveUpdate(t, all, hash2, loopdb.StateFailTimeout)
+	receiveUpdate(t, late, hash2, loopdb.StateFailTimeout)
+	receiveUpdate(t, byHash, hash2, loopdb.StateFailTimeout)
+	receiveUpdate(t, byType, hash2, loopdb.StateFailTimeout)
+
+	// The success of the first swap didn't pass the state type filter.
+	select {
+	case info := <-byType:
+		t.Fatalf("unexpected update: %v", info.State)
+	default:
+	}
+
+	// Canceling the subscription closes its stream.
+	cancel()
+
+	select {
+	case _, ok := <-all:
+		require.False(t, ok)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("stream not closed")
+	}
+}
@@ -34,15 +34,10 @@ type swapKit struct {
 func newSwapKit(hash lntypes.Hash, swapType swap.Type, cfg *swapConfig,
 	contract *loopdb.SwapContract) *swapKit {
 
-	log := &swap.PrefixLog{
-		Hash:   hash,
-		Logger: log,
-	}
-
 	return &swapKit{
 		swapConfig: *cfg,
 		hash:       hash,
-		log:        log,
+		log:        newSwapLogger(hash),
 		state:      loopdb.StateInitiated,
 		contract:   contract,
 		swapType:   swapType,
@@ -70,15 +65,44 @@ func (s *swapKit) swapInfo() *SwapInfo {
 	}
 }
 
+// swapLog returns the logger of the swap.
+func (s *swapKit) swapLog() *swap.PrefixLog {
+	return s.log
+}
+
 type genericSwap interface {
 	execute(mainCtx context.Context, cfg *executeConfig,
 		height int32) error
+
+	// swapLog returns a logger that prefixes all lines with the swap hash.
+	swapLog() *swap.PrefixLog
+
+	// swapInfo returns the current state of the swap.
+	swapInfo() *SwapInfo
+
+	// affectedByClose returns whether the swap relies on the closed
+	// channel to route its off-chain payment. It only reads the fixed
+	// terms of the swap, so it may be called while the swap executes.
+	affectedByClose(channel *lndclient.ClosedChannel) bool
 }
 
 type swapConfig struct {
 	lnd    *lndclient.LndServices
 	store  loopdb.SwapStore
 	server swapServerClient
+
+	// preimages generates the preimages of new swaps. If it is nil,
+	// random preimages are used.
+	preimages PreimageGenerator
+}
+
+// newPreimage returns the preimage for a new swap.
+func (c *swapConfig) newPreimage() (lntypes.Preimage, error) {
+	if c.preimages == nil {
+		return randomPreimageGenerator{}.NewPreimage()
+	}
+
+	return c.preimages.NewPreimage()
 }
 
 func newSwapConfig(lnd *lndclient.LndServices, store loopdb.SwapStore,
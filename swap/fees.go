@@ -2,6 +2,7 @@ package swap
 
 import (
 	"errors"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -55,3 +56,15 @@ func DecodeInvoice(params *chaincfg.Params, payReq string) (route.Vertex,
 	return destination, swapPayReq.RouteHints, hash,
 		swapPayReq.MilliSat.ToSatoshis(), nil
 }
+
+// InvoiceExpiry returns the time at which the invoice expires.
+func InvoiceExpiry(params *chaincfg.Params, payReq string) (time.Time,
+	error) {
+
+	invoice, err := zpay32.Decode(payReq, params)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return invoice.Timestamp.Add(invoice.Expiry()), nil
+}
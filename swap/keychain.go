@@ -8,4 +8,9 @@ var (
 	// StaticAddressKeyFamily is the key family used to generate static
 	// address keys.
 	StaticAddressKeyFamily = int32(42060)
+
+	// DBEncryptionKeyFamily is the key family of the key that the
+	// database encryption key is derived from. Its keys must not be used
+	// for anything else, so that they never leave lnd.
+	DBEncryptionKeyFamily = int32(42070)
 )
@@ -0,0 +1,100 @@
+package loop
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+)
+
+// ListSwapsFilter selects the swaps that ListSwaps returns. Unset fields don't
+// restrict the result.
+type ListSwapsFilter struct {
+	// SwapType restricts the result to loop ins or loop outs.
+	SwapType *swap.Type
+
+	// StateType restricts the result to pending, successful or failed
+	// swaps.
+	StateType *loopdb.SwapStateType
+
+	// InitiatedAfter restricts the result to swaps that were initiated at
+	// or after this time.
+	InitiatedAfter time.Time
+
+	// InitiatedBefore restricts the result to swaps that were initiated
+	// before this time.
+	InitiatedBefore time.Time
+
+	// Label restricts the result to swaps with this label.
+	Label string
+
+	// Offset is the number of matching swaps that are skipped.
+	Offset int
+
+	// Limit is the maximum number of swaps that are returned. Zero means
+	// no limit.
+	Limit int
+}
+
+// ListSwaps returns a page of the swaps that pass the filter. The filter and
+// the paging are applied by the store. The swaps are ordered by their
+// initiation time, so that consecutive pages can be fetched by increasing the
+// offset.
+func (s *Client) ListSwaps(ctx context.Context, filter *ListSwapsFilter) (
+	[]*SwapInfo, error) {
+
+	if filter == nil {
+		filter = &ListSwapsFilter{}
+	}
+
+	filtered, err := s.Store.FilterSwaps(ctx, &loopdb.SwapFilter{
+		SwapType:        filter.SwapType,
+		StateType:       filter.StateType,
+		InitiatedAfter:  filter.InitiatedAfter,
+		InitiatedBefore: filter.InitiatedBefore,
+		Label:           filter.Label,
+		Offset:          filter.Offset,
+		Limit:           filter.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	swaps := make([]*SwapInfo, 0, len(filtered))
+	for _, filteredSwap := range filtered {
+		var swapInfo *SwapInfo
+
+		switch filteredSwap.Type {
+		case swap.TypeOut:
+			loopOut, err := s.Store.FetchLoopOutSwap(
+				ctx, filteredSwap.Hash,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			swapInfo, err = s.loopOutSwapInfo(loopOut)
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			loopIn, err := s.Store.FetchLoopInSwap(
+				ctx, filteredSwap.Hash,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			swapInfo, err = s.loopInSwapInfo(loopIn)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		swaps = append(swaps, swapInfo)
+	}
+
+	return swaps, nil
+}
@@ -0,0 +1,146 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListSwaps tests filtering and paginating swaps.
+func TestListSwaps(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+	client := &Client{
+		clientConfig: clientConfig{
+			Store: store,
+		},
+		lndServices: &lnd.LndServices,
+	}
+
+	_, senderPubKey := test.CreateKey(1)
+	var senderKey [33]byte
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+
+	_, receiverPubKey := test.CreateKey(2)
+	var receiverKey [33]byte
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	start := time.Unix(1_000_000, 0)
+	contract := func(minutes int, label string) loopdb.SwapContract {
+		return loopdb.SwapContract{
+			AmountRequested: 50000,
+			CltvExpiry:      744,
+			HtlcKeys: loopdb.HtlcKeys{
+				SenderScriptKey:        senderKey,
+				SenderInternalPubKey:   senderKey,
+				ReceiverScriptKey:      receiverKey,
+				ReceiverInternalPubKey: receiverKey,
+			},
+			InitiationTime: start.Add(
+				time.Duration(minutes) * time.Minute,
+			),
+			Label: label,
+		}
+	}
+
+	// Add three loop outs and one loop in, initiated one minute apart.
+	outStates := []loopdb.SwapState{
+		loopdb.StateSuccess, loopdb.StateInitiated,
+		loopdb.StateFailTimeout,
+	}
+	for i, state := range outStates {
+		hash := lntypes.Hash{byte(i + 1)}
+		store.LoopOutSwaps[hash] = &loopdb.LoopOutContract{
+			SwapContract: contract(i, "out"),
+		}
+		store.LoopOutUpdates[hash] = []loopdb.SwapStateData{
+			{State: state},
+		}
+	}
+
+	inHash := lntypes.Hash{4}
+	store.LoopInSwaps[inHash] = &loopdb.LoopInContract{
+		SwapContract: contract(3, "in"),
+	}
+	store.LoopInUpdates[inHash] = []loopdb.SwapStateData{
+		{State: loopdb.StateSuccess},
+	}
+
+	loopOut := swap.TypeOut
+	success := loopdb.SwapStateType(loopdb.StateTypeSuccess)
+
+	tests := []struct {
+		name   string
+		filter *ListSwapsFilter
+		hashes []lntypes.Hash
+	}{
+		{
+			name:   "no filter",
+			filter: nil,
+			hashes: []lntypes.Hash{{1}, {2}, {3}, {4}},
+		},
+		{
+			name:   "swap type",
+			filter: &ListSwapsFilter{SwapType: &loopOut},
+			hashes: []lntypes.Hash{{1}, {2}, {3}},
+		},
+		{
+			name:   "state type",
+			filter: &ListSwapsFilter{StateType: &success},
+			hashes: []lntypes.Hash{{1}, {4}},
+		},
+		{
+			name: "time range",
+			filter: &ListSwapsFilter{
+				InitiatedAfter:  start.Add(time.Minute),
+				InitiatedBefore: start.Add(3 * time.Minute),
+			},
+			hashes: []lntypes.Hash{{2}, {3}},
+		},
+		{
+			name:   "label",
+			filter: &ListSwapsFilter{Label: "in"},
+			hashes: []lntypes.Hash{{4}},
+		},
+		{
+			name:   "page",
+			filter: &ListSwapsFilter{Offset: 1, Limit: 2},
+			hashes: []lntypes.Hash{{2}, {3}},
+		},
+		{
+			name:   "offset past end",
+			filter: &ListSwapsFilter{Offset: 4},
+			hashes: []lntypes.Hash{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			swaps, err := client.ListSwaps(
+				context.Background(), test.filter,
+			)
+			require.NoError(t, err)
+
+			hashes := make([]lntypes.Hash, 0, len(swaps))
+			for _, swapInfo := range swaps {
+				hashes = append(hashes, swapInfo.SwapHash)
+			}
+			require.Equal(t, test.hashes, hashes)
+		})
+	}
+
+	_, err := client.ListSwaps(
+		context.Background(), &ListSwapsFilter{Offset: -1},
+	)
+	require.Error(t, err)
+}
@@ -1,8 +1,11 @@
 package loop
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -26,6 +29,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
 	"google.golang.org/grpc/status"
 )
 
@@ -42,8 +47,17 @@ var (
 	// we will not resume our subscription once this error occurs.
 	errSubscriptionFailed = errors.New("failed, no further updates will " +
 		"be provided")
+
+	// errServerCertMismatch is returned when the certificate presented by
+	// the server doesn't match the pinned certificate fingerprint.
+	errServerCertMismatch = errors.New("server certificate does not " +
+		"match pinned fingerprint")
 )
 
+// serverResolverScheme is the scheme of the resolver that resolves the swap
+// server target to the primary and fallback server addresses.
+const serverResolverScheme = "loopserver"
+
 // RoutingPluginType represents the routing plugin type directly.
 type RoutingPluginType uint8
 
@@ -171,8 +185,9 @@ func newSwapServerClient(cfg *ClientConfig, lsatStore lsat.Store) (
 		cfg.MaxLsatFee, false,
 	)
 	serverConn, err := getSwapServerConn(
-		cfg.ServerAddress, cfg.ProxyAddress, cfg.SwapServerNoTLS,
-		cfg.TLSPathServer, clientInterceptor,
+		cfg.ServerAddress, cfg.FallbackServerAddresses,
+		cfg.ProxyAddress, cfg.SwapServerNoTLS, cfg.TLSPathServer,
+		cfg.TLSFingerprintServer, clientInterceptor,
 	)
 	if err != nil {
 		return nil, err
@@ -202,10 +217,11 @@ func (s *grpcSwapServerClient) GetLoopOutTerms(ctx context.Context,
 	}
 
 	return &LoopOutTerms{
-		MinSwapAmount: btcutil.Amount(terms.MinSwapAmount),
-		MaxSwapAmount: btcutil.Amount(terms.MaxSwapAmount),
-		MinCltvDelta:  terms.MinCltvDelta,
-		MaxCltvDelta:  terms.MaxCltvDelta,
+		MinSwapAmount:   btcutil.Amount(terms.MinSwapAmount),
+		MaxSwapAmount:   btcutil.Amount(terms.MaxSwapAmount),
+		MinCltvDelta:    terms.MinCltvDelta,
+		MaxCltvDelta:    terms.MaxCltvDelta,
+		AmountIncrement: btcutil.Amount(terms.SwapAmountIncrement),
 	}, nil
 }
 
@@ -893,10 +909,13 @@ func rpcRouteCancel(details *outCancelDetails) (
 
 // getSwapServerConn returns a connection to the swap server. A non-empty
 // proxyAddr indicates that a SOCKS proxy found at the address should be used to
-// establish the connection.
-func getSwapServerConn(address, proxyAddress string, insecure bool,
-	tlsPath string, interceptor *lsat.ClientInterceptor) (*grpc.ClientConn,
-	error) {
+// establish the connection. If fallback addresses are given, the connection
+// fails over to them in order if the server can't be reached at the address.
+// The calls of a swap go to whichever address is reachable, so all addresses
+// must front the same server state.
+func getSwapServerConn(address string, fallbackAddresses []string,
+	proxyAddress string, insecure bool, tlsPath, tlsFingerprint string,
+	interceptor *lsat.ClientInterceptor) (*grpc.ClientConn, error) {
 
 	// Create a dial options array.
 	opts := []grpc.DialOption{
@@ -908,13 +927,38 @@ func getSwapServerConn(address, proxyAddress string, insecure bool,
 		),
 	}
 
-	// There are three options to connect to a swap server, either insecure,
-	// using a self-signed certificate or with a certificate signed by a
-	// public CA.
+	// There are four options to connect to a swap server, either insecure,
+	// using a pinned certificate fingerprint, using a self-signed
+	// certificate or with a certificate signed by a public CA.
 	switch {
+	case insecure && tlsFingerprint != "":
+		return nil, errors.New("cannot pin the server certificate " +
+			"when TLS is disabled")
+
 	case insecure:
 		opts = append(opts, grpc.WithInsecure())
 
+	case tlsFingerprint != "":
+		fingerprint, err := hex.DecodeString(tlsFingerprint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid server certificate "+
+				"fingerprint: %v", err)
+		}
+
+		if len(fingerprint) != sha256.Size {
+			return nil, fmt.Errorf("invalid server certificate "+
+				"fingerprint length %v, expected %v",
+				len(fingerprint), sha256.Size)
+		}
+
+		// The default chain verification is skipped, the certificate
+		// is instead verified against the pinned fingerprint.
+		creds := credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify:    true, //nolint:gosec
+			VerifyPeerCertificate: verifyPinnedCert(fingerprint),
+		})
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+
 	case tlsPath != "":
 		// Load the specified TLS certificate and build
 		// transport credentials
@@ -943,7 +987,31 @@ func getSwapServerConn(address, proxyAddress string, insecure bool,
 		opts = append(opts, grpc.WithContextDialer(torDialer))
 	}
 
-	conn, err := grpc.Dial(address, opts...)
+	// With fallback addresses, the target is resolved to all addresses.
+	// grpc's default pick first balancer then connects to the first
+	// address that can be reached, and tries the addresses in order again
+	// whenever the connection is lost.
+	target := address
+	if len(fallbackAddresses) > 0 {
+		addresses, err := serverAddresses(
+			append([]string{address}, fallbackAddresses...),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		serverResolver := manual.NewBuilderWithScheme(
+			serverResolverScheme,
+		)
+		serverResolver.InitialState(resolver.State{
+			Addresses: addresses,
+		})
+
+		opts = append(opts, grpc.WithResolvers(serverResolver))
+		target = fmt.Sprintf("%v:///%v", serverResolverScheme, address)
+	}
+
+	conn, err := grpc.Dial(target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to RPC server: %v",
 			err)
@@ -952,6 +1020,47 @@ func getSwapServerConn(address, proxyAddress string, insecure bool,
 	return conn, nil
 }
 
+// serverAddresses converts host:port swap server addresses to resolver
+// addresses. The host of each address is used as the server name that its
+// TLS certificate is verified against.
+func serverAddresses(addresses []string) ([]resolver.Address, error) {
+	resolved := make([]resolver.Address, 0, len(addresses))
+	for _, address := range addresses {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid server address %v: %v",
+				address, err)
+		}
+
+		resolved = append(resolved, resolver.Address{
+			Addr:       address,
+			ServerName: host,
+		})
+	}
+
+	return resolved, nil
+}
+
+// verifyPinnedCert returns a certificate verification function that accepts
+// the peer's certificate only if the sha256 hash of its DER encoding matches
+// the given fingerprint.
+func verifyPinnedCert(fingerprint []byte) func([][]byte,
+	[][]*x509.Certificate) error {
+
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errServerCertMismatch
+		}
+
+		certHash := sha256.Sum256(rawCerts[0])
+		if !bytes.Equal(certHash[:], fingerprint) {
+			return errServerCertMismatch
+		}
+
+		return nil
+	}
+}
+
 // isErrConClosing identifies whether we have received a "transport is closing"
 // error from a grpc stream, indicating that the server has shutdown. We need
 // to string match this error because ErrConnClosing is part of an internal
@@ -0,0 +1,47 @@
+package loop
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/resolver"
+)
+
+// TestVerifyPinnedCert tests that only certificates matching the pinned
+// fingerprint are accepted.
+func TestVerifyPinnedCert(t *testing.T) {
+	cert := []byte("server certificate")
+	otherCert := []byte("other certificate")
+
+	fingerprint := sha256.Sum256(cert)
+	verify := verifyPinnedCert(fingerprint[:])
+
+	require.NoError(t, verify([][]byte{cert}, nil))
+	require.ErrorIs(
+		t, verify([][]byte{otherCert}, nil), errServerCertMismatch,
+	)
+	require.ErrorIs(t, verify(nil, nil), errServerCertMismatch)
+}
+
+// TestServerAddresses tests the conversion of server addresses to resolver
+// addresses.
+func TestServerAddresses(t *testing.T) {
+	addresses, err := serverAddresses([]string{
+		"swap.lightning.today:11010", "127.0.0.1:11009",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []resolver.Address{
+		{
+			Addr:       "swap.lightning.today:11010",
+			ServerName: "swap.lightning.today",
+		},
+		{
+			Addr:       "127.0.0.1:11009",
+			ServerName: "127.0.0.1",
+		},
+	}, addresses)
+
+	_, err = serverAddresses([]string{"swap.lightning.today"})
+	require.Error(t, err)
+}
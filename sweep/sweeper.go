@@ -8,17 +8,64 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // Sweeper creates htlc sweep txes.
 type Sweeper struct {
 	Lnd *lndclient.LndServices
+
+	// StaticFeeRate is a fixed fee rate that is used for all sweeps
+	// instead of lnd's fee estimate. This gives deterministic fees in
+	// integration tests and may only be used on regtest and simnet.
+	StaticFeeRate chainfee.SatPerKWeight
+}
+
+// ValidateStaticFeeRate returns an error if a static fee rate is set for a
+// network other than regtest or simnet.
+func ValidateStaticFeeRate(feeRate chainfee.SatPerKWeight,
+	params *chaincfg.Params) error {
+
+	if feeRate == 0 {
+		return nil
+	}
+
+	switch params.Name {
+	case chaincfg.RegressionNetParams.Name, chaincfg.SimNetParams.Name:
+		return nil
+
+	default:
+		return fmt.Errorf("static sweep fee rate not allowed on %v",
+			params.Name)
+	}
+}
+
+// EstimateFeeRate returns the fee rate to use for a transaction with the given
+// confirmation target. If a static fee rate is configured, it is returned
+// instead of querying lnd.
+func (s *Sweeper) EstimateFeeRate(ctx context.Context,
+	confTarget int32) (chainfee.SatPerKWeight, error) {
+
+	if s.StaticFeeRate == 0 {
+		return s.Lnd.WalletKit.EstimateFeeRate(ctx, confTarget)
+	}
+
+	// Check the network again to make sure that a static fee rate never
+	// ends up being used on mainnet, even if the sweeper was created
+	// without validating the config.
+	err := ValidateStaticFeeRate(s.StaticFeeRate, s.Lnd.ChainParams)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.StaticFeeRate, nil
 }
 
 // CreateUnsignedTaprootKeySpendSweepTx creates a taproot htlc sweep tx using
@@ -181,12 +228,112 @@ func (s *Sweeper) GetSweepFee(ctx context.Context,
 	destAddr btcutil.Address, sweepConfTarget int32) (
 	btcutil.Amount, error) {
 
+	fees, err := s.GetSweepFees(
+		ctx, addInputEstimate, []btcutil.Address{destAddr},
+		sweepConfTarget,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return fees[0], nil
+}
+
+// GetSweepFeeForRate calculates the tx fee to sweep to the destination
+// address with the given fee rate.
+func (s *Sweeper) GetSweepFeeForRate(
+	addInputEstimate func(*input.TxWeightEstimator) error,
+	destAddr btcutil.Address, feeRate chainfee.SatPerKWeight) (
+	btcutil.Amount, error) {
+
+	weight, err := sweepWeight(addInputEstimate, destAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	return feeRate.FeeForWeight(weight), nil
+}
+
+// GetSweepFees calculates the required tx fee to sweep to each of the given
+// destination addresses. The fee rate is only queried from lnd once and then
+// applied to the weight of each of the sweep txes, so that the fees for
+// different destination address types can be compared cheaply. The returned
+// fees are in the same order as the passed addresses.
+func (s *Sweeper) GetSweepFees(ctx context.Context,
+	addInputEstimate func(*input.TxWeightEstimator) error,
+	destAddrs []btcutil.Address, sweepConfTarget int32) (
+	[]btcutil.Amount, error) {
+
+	// Get fee estimate from lnd.
+	feeRate, err := s.EstimateFeeRate(ctx, sweepConfTarget)
+	if err != nil {
+		return nil, fmt.Errorf("estimate fee: %v", err)
+	}
+
+	fees := make([]btcutil.Amount, len(destAddrs))
+	for i, destAddr := range destAddrs {
+		weight, err := sweepWeight(addInputEstimate, destAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		fees[i] = feeRate.FeeForWeight(weight)
+	}
+
+	return fees, nil
+}
+
+// GetBatchSweepFee calculates the required tx fee to sweep the given number of
+// inputs to the destination address in a single tx. It takes a function that
+// is expected to add the weight of one input to the weight estimator.
+func (s *Sweeper) GetBatchSweepFee(ctx context.Context,
+	addInputEstimate func(*input.TxWeightEstimator) error, numInputs int,
+	destAddr btcutil.Address, sweepConfTarget int32) (btcutil.Amount,
+	error) {
+
 	// Get fee estimate from lnd.
-	feeRate, err := s.Lnd.WalletKit.EstimateFeeRate(ctx, sweepConfTarget)
+	feeRate, err := s.EstimateFeeRate(ctx, sweepConfTarget)
 	if err != nil {
 		return 0, fmt.Errorf("estimate fee: %v", err)
 	}
 
+	return s.GetBatchSweepFeeForRate(
+		addInputEstimate, numInputs, destAddr, feeRate,
+	)
+}
+
+// GetBatchSweepFeeForRate calculates the tx fee to sweep the given number of
+// inputs to the destination address in a single tx with the given fee rate.
+func (s *Sweeper) GetBatchSweepFeeForRate(
+	addInputEstimate func(*input.TxWeightEstimator) error, numInputs int,
+	destAddr btcutil.Address, feeRate chainfee.SatPerKWeight) (
+	btcutil.Amount, error) {
+
+	addInputsEstimate := func(estimator *input.TxWeightEstimator) error {
+		for i := 0; i < numInputs; i++ {
+			err := addInputEstimate(estimator)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	weight, err := sweepWeight(addInputsEstimate, destAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	return feeRate.FeeForWeight(weight), nil
+}
+
+// sweepWeight returns the estimated weight of a sweep tx to the given
+// destination address, using the passed function to add the weight of the
+// input.
+func sweepWeight(addInputEstimate func(*input.TxWeightEstimator) error,
+	destAddr btcutil.Address) (int64, error) {
+
 	// Calculate weight for this tx.
 	var weightEstimate input.TxWeightEstimator
 	switch destAddr.(type) {
@@ -210,12 +357,10 @@ func (s *Sweeper) GetSweepFee(ctx context.Context,
 			destAddr)
 	}
 
-	err = addInputEstimate(&weightEstimate)
+	err := addInputEstimate(&weightEstimate)
 	if err != nil {
 		return 0, err
 	}
 
-	weight := weightEstimate.Weight()
-
-	return feeRate.FeeForWeight(int64(weight)), nil
+	return int64(weightEstimate.Weight()), nil
 }
@@ -0,0 +1,126 @@
+package sweepbatcher
+
+import (
+	"math"
+
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// FeeParams describes the state of a batch that a fee function computes the
+// next fee rate from.
+type FeeParams struct {
+	// StartFeeRate is the fee rate that the batch's fee escalation started
+	// with. For a new batch this is the wallet's fee estimate, for a
+	// batch that was restored from the database it is the last persisted
+	// fee rate.
+	StartFeeRate chainfee.SatPerKWeight
+
+	// StartHeight is the height at which the fee escalation started.
+	StartHeight int32
+
+	// LastFeeRate is the fee rate of the last batch transaction.
+	LastFeeRate chainfee.SatPerKWeight
+
+	// Height is the current height.
+	Height int32
+
+	// Deadline is the earliest timeout of the batch's sweeps, by which the
+	// batch transaction must confirm.
+	Deadline int32
+
+	// MaxFeeRate is the highest fee rate that the batch transaction may
+	// be published with. A zero value doesn't limit the fee rate.
+	MaxFeeRate chainfee.SatPerKWeight
+}
+
+// FeeFunction computes the fee rate of the next batch transaction when the
+// last one didn't confirm. The batch never lowers its fee rate, so a lower
+// result keeps the last fee rate.
+type FeeFunction interface {
+	// FeeRate returns the fee rate of the next batch transaction.
+	FeeRate(params FeeParams) chainfee.SatPerKWeight
+}
+
+// StepFeeFunction bumps the fee rate by a fixed step per block, regardless of
+// the deadline. This is the batcher's default fee function.
+type StepFeeFunction struct {
+	// Step is the amount by which the fee rate is bumped.
+	Step chainfee.SatPerKWeight
+}
+
+// FeeRate returns the last fee rate plus the step.
+//
+// NOTE: Part of the FeeFunction interface.
+func (f StepFeeFunction) FeeRate(params FeeParams) chainfee.SatPerKWeight {
+	return params.LastFeeRate + f.Step
+}
+
+// LinearFeeFunction raises the fee rate linearly from the start fee rate to
+// the max fee rate, which it reaches at the deadline. Without a max fee rate,
+// it falls back to bumping by the default step.
+type LinearFeeFunction struct{}
+
+// FeeRate returns the fee rate at the current height on the line from the
+// start fee rate to the max fee rate.
+//
+// NOTE: Part of the FeeFunction interface.
+func (f LinearFeeFunction) FeeRate(params FeeParams) chainfee.SatPerKWeight {
+	progress, ok := deadlineProgress(params)
+	if !ok {
+		return params.LastFeeRate + defaultFeeRateStep
+	}
+
+	delta := float64(params.MaxFeeRate - params.StartFeeRate)
+
+	return params.StartFeeRate + chainfee.SatPerKWeight(delta*progress)
+}
+
+// ExponentialFeeFunction raises the fee rate exponentially from the start fee
+// rate to the max fee rate, which it reaches at the deadline. The fee rate
+// stays close to the start fee rate for most of the time and rises steeply
+// close to the deadline. Without a max fee rate, it falls back to bumping by
+// the default step.
+type ExponentialFeeFunction struct{}
+
+// FeeRate returns the fee rate at the current height on the exponential curve
+// from the start fee rate to the max fee rate.
+//
+// NOTE: Part of the FeeFunction interface.
+func (f ExponentialFeeFunction) FeeRate(
+	params FeeParams) chainfee.SatPerKWeight {
+
+	progress, ok := deadlineProgress(params)
+	if !ok || params.StartFeeRate <= 0 {
+		return params.LastFeeRate + defaultFeeRateStep
+	}
+
+	ratio := float64(params.MaxFeeRate) / float64(params.StartFeeRate)
+	rate := float64(params.StartFeeRate) * math.Pow(ratio, progress)
+
+	return chainfee.SatPerKWeight(rate)
+}
+
+// deadlineProgress returns how far the current height has progressed from the
+// start height to the deadline, between 0 and 1. It returns false if there is
+// no range to escalate the fee rate in.
+func deadlineProgress(params FeeParams) (float64, bool) {
+	if params.MaxFeeRate <= params.StartFeeRate {
+		return 0, false
+	}
+
+	if params.Height >= params.Deadline {
+		return 1, true
+	}
+
+	blocks := params.Deadline - params.StartHeight
+	if blocks <= 0 {
+		return 1, true
+	}
+
+	elapsed := params.Height - params.StartHeight
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return float64(elapsed) / float64(blocks), true
+}
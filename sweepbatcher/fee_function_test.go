@@ -0,0 +1,44 @@
+package sweepbatcher
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeFunctions tests that the fee functions escalate towards the max fee
+// rate by the deadline.
+func TestFeeFunctions(t *testing.T) {
+	params := FeeParams{
+		StartFeeRate: 1000,
+		StartHeight:  100,
+		LastFeeRate:  1000,
+		Height:       150,
+		Deadline:     200,
+		MaxFeeRate:   9000,
+	}
+
+	step := StepFeeFunction{Step: 250}
+	require.Equal(t, chainfee.SatPerKWeight(1250), step.FeeRate(params))
+
+	linear := LinearFeeFunction{}
+	require.Equal(t, chainfee.SatPerKWeight(5000), linear.FeeRate(params))
+
+	exponential := ExponentialFeeFunction{}
+	require.Equal(
+		t, chainfee.SatPerKWeight(3000), exponential.FeeRate(params),
+	)
+
+	// At and past the deadline, the max fee rate is used.
+	params.Height = 210
+	require.Equal(t, params.MaxFeeRate, linear.FeeRate(params))
+	require.Equal(t, params.MaxFeeRate, exponential.FeeRate(params))
+
+	// Without a max fee rate, the deadline-aware functions bump by the
+	// default step.
+	params.MaxFeeRate = 0
+	expected := params.LastFeeRate + defaultFeeRateStep
+	require.Equal(t, expected, linear.FeeRate(params))
+	require.Equal(t, expected, exponential.FeeRate(params))
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/lntypes"
 )
 
 // log is a logger that is initialized with no output filters. This
@@ -22,6 +24,18 @@ func batchPrefixLogger(batchID string) btclog.Logger {
 	return build.NewPrefixLog(fmt.Sprintf("[Batch %s]", batchID), log)
 }
 
+// sweepPrefixLogger returns a logger that prefixes all log messages with the
+// short hash of the swap of a sweep, like the log messages of the swap itself,
+// so that the sweep's messages can be found by the swap hash.
+func sweepPrefixLogger(swapHash lntypes.Hash,
+	logger btclog.Logger) *swap.PrefixLog {
+
+	return &swap.PrefixLog{
+		Hash:   swapHash,
+		Logger: logger,
+	}
+}
+
 // UseLogger uses a specified Logger to output package logging info.
 // This should be used in preference to SetLogWriter if the caller is also
 // using btclog.
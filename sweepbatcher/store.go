@@ -57,6 +57,11 @@ type BaseDB interface {
 	// transaction.
 	ExecTx(ctx context.Context, txOptions loopdb.TxOptions,
 		txBody func(*sqlc.Queries) error) error
+
+	// ConvertLoopOutRow converts a database row containing a loop out
+	// swap to a LoopOut struct, decrypting its preimage if necessary.
+	ConvertLoopOutRow(row sqlc.GetLoopOutSwapRow,
+		updates []sqlc.SwapUpdate) (*loopdb.LoopOut, error)
 }
 
 // SQLStore manages the reservations in the database.
@@ -218,6 +223,10 @@ type dbBatch struct {
 
 	// MaxTimeoutDistance is the maximum timeout distance of the batch.
 	MaxTimeoutDistance int32
+
+	// FeeBumps is the number of times that the fee rate of the batch
+	// transaction was bumped.
+	FeeBumps int32
 }
 
 type dbSweep struct {
@@ -271,6 +280,7 @@ func convertBatchRow(row sqlc.SweepBatch) *dbBatch {
 	}
 
 	batch.MaxTimeoutDistance = row.MaxTimeoutDistance
+	batch.FeeBumps = row.FeeBumps
 
 	return &batch
 }
@@ -294,6 +304,7 @@ func batchToInsertArgs(batch dbBatch) sqlc.InsertBatchParams {
 			Int32: batch.LastRbfSatPerKw,
 		},
 		MaxTimeoutDistance: batch.MaxTimeoutDistance,
+		FeeBumps:           batch.FeeBumps,
 	}
 
 	if batch.State == batchConfirmed {
@@ -322,6 +333,7 @@ func batchToUpdateArgs(batch dbBatch) sqlc.UpdateBatchParams {
 			Valid: true,
 			Int32: batch.LastRbfSatPerKw,
 		},
+		FeeBumps: batch.FeeBumps,
 	}
 
 	if batch.State == batchConfirmed {
@@ -358,8 +370,7 @@ func (s *SQLStore) convertSweepRow(row sqlc.GetBatchSweepsRow,
 		Index: uint32(row.OutpointIndex),
 	}
 
-	sweep.LoopOut, err = loopdb.ConvertLoopOutRow(
-		s.network,
+	sweep.LoopOut, err = s.baseDb.ConvertLoopOutRow(
 		sqlc.GetLoopOutSwapRow{
 			ID:                     row.ID,
 			SwapHash:               row.SwapHash,
@@ -382,6 +393,13 @@ func (s *SQLStore) convertSweepRow(row sqlc.GetBatchSweepsRow,
 			MaxPrepayRoutingFee:    row.MaxPrepayRoutingFee,
 			PublicationDeadline:    row.PublicationDeadline,
 			SingleSweep:            row.SingleSweep,
+			SweepWhenFeeBelow:      row.SweepWhenFeeBelow,
+			HtlcConfTimeout:        row.HtlcConfTimeout,
+			SweepFeeRate:           row.SweepFeeRate,
+			PrepaySettled:          row.PrepaySettled,
+			SwapPaymentSettled:     row.SwapPaymentSettled,
+			SweepVerified:          row.SweepVerified,
+			SweepDiscrepancy:       row.SweepDiscrepancy,
 			SenderScriptPubkey:     row.SenderScriptPubkey,
 			ReceiverScriptPubkey:   row.ReceiverScriptPubkey,
 			SenderInternalPubkey:   row.SenderInternalPubkey,
@@ -3,8 +3,10 @@ package sweepbatcher
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,7 +27,10 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -44,10 +49,27 @@ const (
 	// maxFeeToSwapAmtRatio is the maximum fee to swap amount ratio that
 	// we allow for a batch transaction.
 	maxFeeToSwapAmtRatio = 0.2
+
+	// maxFeeRejectionRetries is the maximum number of times that we bump
+	// the fee rate and immediately republish a batch transaction that was
+	// rejected from the mempool for paying too little fee.
+	maxFeeRejectionRetries = 3
+
+	// minRejectionFeeRateIncrement is the minimum amount by which the fee
+	// rate of a batch transaction that was rejected from the mempool is
+	// bumped before it is published again. It matches the default
+	// incremental relay fee of 1 sat/vbyte that a replacement has to pay
+	// on top of the transaction it replaces.
+	minRejectionFeeRateIncrement = chainfee.SatPerKWeight(250)
 )
 
 var (
 	ErrBatchShuttingDown = fmt.Errorf("batch shutting down")
+
+	// ErrFeeLimitReached is reported to the swaps of a batch once the fee
+	// rate of the batch transaction isn't bumped any further because the
+	// configured fee limits are reached.
+	ErrFeeLimitReached = errors.New("sweep fee limit reached")
 )
 
 // sweep stores any data related to sweeping a specific outpoint.
@@ -96,6 +118,11 @@ type sweep struct {
 	// destAddr is the destination address of the sweep.
 	destAddr btcutil.Address
 
+	// feeRate is the fee rate that the swap asked to be swept with
+	// instead of the estimated fee rate. Zero means that the fee rate is
+	// estimated.
+	feeRate chainfee.SatPerKWeight
+
 	// notifier is a collection of channels used to communicate the status
 	// of the sweep back to the swap that requested it.
 	notifier *SpendNotifier
@@ -129,6 +156,22 @@ type batchConfig struct {
 	// batchPublishDelay is the delay between receiving a new block and
 	// publishing the batch transaction.
 	batchPublishDelay time.Duration
+
+	// maxFeeRate is the highest fee rate that the batch transaction may
+	// be published with. A zero value doesn't limit the fee rate.
+	maxFeeRate chainfee.SatPerKWeight
+
+	// maxFeeBumps is the number of times that the fee rate of the batch
+	// transaction may be bumped. A zero value doesn't limit the bumps.
+	maxFeeBumps int
+
+	// feeFunction computes the fee rate of the next batch transaction. If
+	// nil, the fee rate is bumped by the default step.
+	feeFunction FeeFunction
+
+	// staticFeeRate is used as the initial fee rate instead of the
+	// wallet's estimate, unless the sweeps ask for a fee rate.
+	staticFeeRate chainfee.SatPerKWeight
 }
 
 // rbfCache stores data related to our last fee bump.
@@ -138,6 +181,11 @@ type rbfCache struct {
 
 	// FeeRate is the last used fee rate we used to publish a batch tx.
 	FeeRate chainfee.SatPerKWeight
+
+	// FeeBumps is the number of times that the fee rate was bumped. It is
+	// persisted, so that the limit of fee bumps also holds across
+	// restarts.
+	FeeBumps int
 }
 
 // batch is a collection of sweeps that are published together.
@@ -184,9 +232,23 @@ type batch struct {
 	// batchAddress is the address of the batch transaction's output.
 	batchAddress btcutil.Address
 
+	// spendDetails are the spend details that the sweeps were notified of
+	// when the batch transaction was spent. They are sent to the sweeps
+	// again once the batch transaction confirmed.
+	spendDetails map[lntypes.Hash]*SpendDetail
+
 	// rbfCache stores data related to the RBF fee bumping mechanism.
 	rbfCache rbfCache
 
+	// feeStart is the fee rate and height that the fee function escalates
+	// from. It is set by the first fee rate update since the batch was
+	// started.
+	feeStart rbfCache
+
+	// feeLimitNotified is true once the swaps of the batch were told that
+	// the fee limit of the batch is reached.
+	feeLimitNotified bool
+
 	// callEnter is used to sequentialize calls to the batch handler's
 	// main event loop.
 	callEnter chan struct{}
@@ -415,7 +477,7 @@ func (b *batch) addSweep(ctx context.Context, sweep *sweep) (bool, error) {
 	}
 
 	// Add the sweep to the batch's sweeps.
-	b.log.Infof("adding sweep %x", sweep.swapHash[:6])
+	b.sweepLog(sweep.swapHash).Infof("adding sweep")
 	b.sweeps[sweep.swapHash] = *sweep
 
 	return true, b.persistSweep(ctx, *sweep, false)
@@ -475,8 +537,8 @@ func (b *batch) Run(ctx context.Context) error {
 	// block.
 	var timerChan <-chan time.Time
 
-	b.log.Infof("started, primary %x, total sweeps %v",
-		b.primarySweepID[0:6], len(b.sweeps))
+	b.log.Infof("started, primary %v, total sweeps %v",
+		swap.ShortHash(&b.primarySweepID), len(b.sweeps))
 
 	for {
 		select {
@@ -531,41 +593,166 @@ func (b *batch) Run(ctx context.Context) error {
 }
 
 // publish creates and publishes the latest batch transaction to the network.
+// All sweeps of the batch are spent by the same transaction, so either all of
+// them are published or none is. If publishing fails, the failure is logged
+// for each of the sweeps and the batch is published again on the next block.
 func (b *batch) publish(ctx context.Context) error {
+	// Run the RBF rate update.
+	err := b.updateRbfRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	return b.publishWithRetries(ctx)
+}
+
+// publishWithRetries publishes the batch transaction with the current fee
+// rate. If the transaction is rejected for paying too little fee, the fee rate
+// is bumped and the transaction is published again right away.
+func (b *batch) publishWithRetries(ctx context.Context) error {
 	var (
 		err         error
 		fee         btcutil.Amount
 		coopSuccess bool
 	)
 
-	// Run the RBF rate update.
-	err = b.updateRbfRate(ctx)
-	if err != nil {
-		return err
-	}
+	for retry := 0; ; retry++ {
+		fee, err, coopSuccess = b.publishBatchCoop(ctx)
+		if err != nil {
+			b.log.Warnf("co-op publish error: %v", err)
+		}
 
-	fee, err, coopSuccess = b.publishBatchCoop(ctx)
-	if err != nil {
-		b.log.Warnf("co-op publish error: %v", err)
-	}
+		if !coopSuccess {
+			fee, err = b.publishBatch(ctx)
+		}
+		if err == nil {
+			break
+		}
 
-	if !coopSuccess {
-		fee, err = b.publishBatch(ctx)
-	}
-	if err != nil {
-		b.log.Warnf("publish error: %v", err)
-		return nil
+		// If the transaction was rejected because of its fee, there
+		// is no point in waiting for the next block to try again
+		// with a higher fee rate, so we bump it right away. A batch
+		// tx that was published before replaces its previous version.
+		if !isFeeRejection(err, b.batchTxid != nil) {
+			b.log.Warnf("publish error: %v", err)
+			b.logSweepsNotPublished(err)
+
+			return nil
+		}
+
+		if retry == maxFeeRejectionRetries {
+			b.log.Errorf("batch tx rejected from mempool after %v "+
+				"fee bumps, fee rate %v: %v", retry,
+				b.rbfCache.FeeRate, err)
+			b.logSweepsNotPublished(err)
+			b.notifySweepsPublishErr(ctx, fmt.Errorf("batch tx "+
+				"rejected from mempool after %v fee bumps: %w",
+				retry, err))
+
+			return nil
+		}
+
+		b.log.Infof("batch tx rejected from mempool, bumping fee "+
+			"rate %v: %v", b.rbfCache.FeeRate, err)
+
+		publishErr := err
+		feeRate := b.rbfCache.FeeRate
+
+		err = b.bumpRejectedFeeRate(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Publishing the same transaction again would be rejected
+		// again, so we wait for the next block if the fee rate could
+		// not be bumped.
+		if b.rbfCache.FeeRate <= feeRate {
+			b.log.Infof("fee rate %v not bumped, retrying on next "+
+				"block", feeRate)
+			b.logSweepsNotPublished(publishErr)
+
+			return nil
+		}
 	}
 
 	b.log.Infof("published, total sweeps: %v, fees: %v", len(b.sweeps), fee)
 	for _, sweep := range b.sweeps {
-		b.log.Infof("published sweep %x, value: %v",
-			sweep.swapHash[:6], sweep.value)
+		b.sweepLog(sweep.swapHash).Infof("published sweep, value: %v",
+			sweep.value)
 	}
 
 	return b.persist(ctx)
 }
 
+// sweepLog returns the logger of the batch for messages about the sweep of the
+// swap with the given hash.
+func (b *batch) sweepLog(swapHash lntypes.Hash) *swap.PrefixLog {
+	return sweepPrefixLogger(swapHash, b.log)
+}
+
+// logSweepsNotPublished logs the publish failure of the batch transaction for
+// each of its sweeps, so that the failure can be traced from every swap that
+// is part of the batch.
+func (b *batch) logSweepsNotPublished(err error) {
+	for _, sweep := range b.sweeps {
+		b.sweepLog(sweep.swapHash).Warnf("sweep not published, "+
+			"retrying on next block: %v", err)
+	}
+}
+
+// notifySweepsPublishErr reports an error that keeps the batch transaction
+// from being published to the swaps of all sweeps in the batch.
+func (b *batch) notifySweepsPublishErr(ctx context.Context, err error) {
+	for _, sweep := range b.sweeps {
+		notifier := sweep.notifier
+		if notifier == nil || notifier.PublishErrChan == nil {
+			continue
+		}
+
+		// We don't wait for the swap to read the error, so that the
+		// batch isn't blocked by it.
+		go sweep.notifyPublishErr(ctx, err)
+	}
+}
+
+// isFeeRejection returns true if the error returned when publishing a
+// transaction indicates that it was rejected from the mempool because its fee
+// is too low. If the transaction replaces a previously published version,
+// lnd reports a replacement that doesn't pay enough fee as a double spend.
+func isFeeRejection(err error, replacement bool) bool {
+	if errors.Is(err, lnwallet.ErrMempoolFee) {
+		return true
+	}
+
+	if replacement && errors.Is(err, lnwallet.ErrDoubleSpend) {
+		return true
+	}
+
+	// walletrpc doesn't define status codes or error details for publish
+	// errors. lnd returns them with the Unknown code and only the message
+	// of the wallet's error, so over RPC the typed errors can only be
+	// recognized by their message.
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unknown {
+		return false
+	}
+
+	msg := st.Message()
+	if strings.Contains(msg, lnwallet.ErrMempoolFee.Error()) {
+		return true
+	}
+
+	if !replacement {
+		return false
+	}
+
+	// Rejected replacements are only mapped to ErrDoubleSpend for some
+	// chain backends. Others pass on the backend's own message, which
+	// reports the replacement as paying an insufficient fee.
+	return strings.Contains(msg, lnwallet.ErrDoubleSpend.Error()) ||
+		strings.Contains(msg, "insufficient fee")
+}
+
 // publishBatch creates and publishes the batch transaction. It will consult the
 // RBFCache to determine the fee rate to use.
 func (b *batch) publishBatch(ctx context.Context) (btcutil.Amount, error) {
@@ -1002,34 +1189,259 @@ func (b *batch) coopSignBatchTx(ctx context.Context, packet *psbt.Packet,
 	return nil
 }
 
+// bumpFee raises the fee rate of the batch transaction to the given fee rate
+// and republishes it right away. The following blocks continue to bump the fee
+// rate from there.
+func (b *batch) bumpFee(ctx context.Context,
+	feeRate chainfee.SatPerKWeight) error {
+
+	done, err := b.scheduleNextCall()
+	defer done()
+	if err != nil {
+		return err
+	}
+
+	if b.state != Open {
+		return fmt.Errorf("batch %d is %v, its fee rate can't be bumped",
+			b.id, stateEnumToString(b.state))
+	}
+
+	// A replacement must pay a higher fee rate than the transaction it
+	// replaces.
+	if feeRate <= b.rbfCache.FeeRate {
+		return fmt.Errorf("fee rate %v must be higher than the current "+
+			"fee rate %v", feeRate, b.rbfCache.FeeRate)
+	}
+
+	if b.cfg.maxFeeRate != 0 && feeRate > b.cfg.maxFeeRate {
+		return fmt.Errorf("fee rate %v exceeds the max fee rate %v",
+			feeRate, b.cfg.maxFeeRate)
+	}
+
+	b.log.Infof("manually bumping fee rate from %v to %v",
+		b.rbfCache.FeeRate, feeRate)
+
+	published := b.rbfCache.FeeRate != 0
+	if b.feeStart.FeeRate == 0 {
+		b.feeStart = rbfCache{
+			LastHeight: b.currentHeight,
+			FeeRate:    feeRate,
+		}
+	}
+
+	b.rbfCache.FeeRate = feeRate
+	b.rbfCache.LastHeight = b.currentHeight
+
+	err = b.persist(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A batch that wasn't published yet is published on the next block,
+	// starting from the bumped fee rate.
+	if !published {
+		return nil
+	}
+
+	return b.publishWithRetries(ctx)
+}
+
 // updateRbfRate updates the fee rate we should use for the new batch
 // transaction. This fee rate does not guarantee RBF success, but the continuous
 // increase leads to an eventual successful RBF replacement.
 func (b *batch) updateRbfRate(ctx context.Context) error {
 	// If the feeRate is unset then we never published before, so we
-	// retrieve the fee estimate from our wallet.
+	// retrieve the fee estimate from our wallet, unless the sweeps asked
+	// for a fee rate.
+	sweepFeeRate := b.sweepFeeRate()
 	if b.rbfCache.FeeRate == 0 {
-		b.log.Infof("initializing rbf fee rate for conf target=%v",
-			b.cfg.batchConfTarget)
-		rate, err := b.wallet.EstimateFeeRate(
-			ctx, b.cfg.batchConfTarget,
-		)
-		if err != nil {
-			return err
+		rate := sweepFeeRate
+		switch {
+		case rate == 0 && b.cfg.staticFeeRate != 0:
+			rate = b.cfg.staticFeeRate
+			b.log.Infof("initializing rbf fee rate with the static "+
+				"fee rate %v", rate)
+
+		case rate == 0:
+			b.log.Infof("initializing rbf fee rate for conf "+
+				"target=%v", b.cfg.batchConfTarget)
+
+			var err error
+			rate, err = b.wallet.EstimateFeeRate(
+				ctx, b.cfg.batchConfTarget,
+			)
+			if err != nil {
+				return err
+			}
+
+		default:
+			b.log.Infof("initializing rbf fee rate with the "+
+				"requested sweep fee rate %v", rate)
 		}
 
 		// Set the initial value for our fee rate.
 		b.rbfCache.FeeRate = rate
-	} else {
-		// Bump the fee rate by the configured step.
-		b.rbfCache.FeeRate += defaultFeeRateStep
+		b.feeStart = rbfCache{
+			LastHeight: b.currentHeight,
+			FeeRate:    rate,
+		}
+	} else if b.feeLimitReached() {
+		// Keep publishing with the last fee rate. Giving up on the
+		// batch would put the funds of its swaps at risk.
+		b.log.Warnf("fee rate limit reached, not bumping fee rate %v "+
+			"after %v bumps", b.rbfCache.FeeRate,
+			b.rbfCache.FeeBumps)
+	} else if feeRate := b.nextFeeRate(); feeRate > b.rbfCache.FeeRate {
+		// Fee functions that depend on the height return the same
+		// rate until the next block, so only an actual increase counts
+		// as a bump.
+		b.rbfCache.FeeRate = feeRate
+		b.rbfCache.FeeBumps++
+
+		if b.feeLimitReached() {
+			for _, sweep := range b.sweeps {
+				b.sweepLog(sweep.swapHash).Errorf("sweep "+
+					"could not confirm within the fee "+
+					"limits, fee rate %v after %v bumps",
+					b.rbfCache.FeeRate, b.rbfCache.FeeBumps)
+			}
+		}
+	}
+
+	// A sweep that joined the batch later may have asked for a higher fee
+	// rate than the batch pays so far.
+	if b.rbfCache.FeeRate < sweepFeeRate {
+		b.rbfCache.FeeRate = sweepFeeRate
+	}
+
+	if b.cfg.maxFeeRate != 0 && b.rbfCache.FeeRate > b.cfg.maxFeeRate {
+		b.rbfCache.FeeRate = b.cfg.maxFeeRate
 	}
 
+	// The swaps report the fee limit in their status. A batch that was
+	// restored from the database reports a limit it had reached before.
+	b.notifyFeeLimitReached(ctx)
+
 	b.rbfCache.LastHeight = b.currentHeight
 
 	return b.persist(ctx)
 }
 
+// bumpRejectedFeeRate bumps the fee rate of a batch transaction that was
+// rejected from the mempool for paying too little fee. Fee functions that
+// depend on the height don't raise the fee rate until the next block and the
+// default step is below the incremental relay fee, so the fee rate is raised by
+// at least minRejectionFeeRateIncrement. The fee limits still apply.
+func (b *batch) bumpRejectedFeeRate(ctx context.Context) error {
+	feeRate := b.rbfCache.FeeRate
+
+	err := b.updateRbfRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	minFeeRate := feeRate + minRejectionFeeRateIncrement
+	if b.rbfCache.FeeRate >= minFeeRate {
+		return nil
+	}
+
+	// If the fee function didn't bump the fee rate because a fee limit
+	// is reached, we don't bump it either.
+	bumped := b.rbfCache.FeeRate > feeRate
+	if !bumped && b.feeLimitReached() {
+		return nil
+	}
+
+	b.rbfCache.FeeRate = minFeeRate
+	if b.cfg.maxFeeRate != 0 && b.rbfCache.FeeRate > b.cfg.maxFeeRate {
+		b.rbfCache.FeeRate = b.cfg.maxFeeRate
+	}
+
+	if !bumped {
+		b.rbfCache.FeeBumps++
+	}
+
+	b.log.Infof("raised fee rate of rejected batch tx to %v",
+		b.rbfCache.FeeRate)
+
+	b.notifyFeeLimitReached(ctx)
+
+	return b.persist(ctx)
+}
+
+// notifyFeeLimitReached reports to the swaps of the batch that the fee rate of
+// the batch transaction isn't bumped any further, once the fee limits are
+// reached.
+func (b *batch) notifyFeeLimitReached(ctx context.Context) {
+	if !b.feeLimitReached() || b.feeLimitNotified {
+		return
+	}
+
+	b.feeLimitNotified = true
+	b.notifySweepsPublishErr(ctx, fmt.Errorf("%w: fee rate %v after %v "+
+		"bumps", ErrFeeLimitReached, b.rbfCache.FeeRate,
+		b.rbfCache.FeeBumps))
+}
+
+// sweepFeeRate returns the highest fee rate that the batch's sweeps asked to
+// be swept with, or zero if none of them did.
+func (b *batch) sweepFeeRate() chainfee.SatPerKWeight {
+	var feeRate chainfee.SatPerKWeight
+	for _, sweep := range b.sweeps {
+		if sweep.feeRate > feeRate {
+			feeRate = sweep.feeRate
+		}
+	}
+
+	return feeRate
+}
+
+// nextFeeRate returns the fee rate of the next batch transaction as computed
+// by the batch's fee function. The fee rate is never lowered.
+func (b *batch) nextFeeRate() chainfee.SatPerKWeight {
+	// A batch that was restored from the database escalates from its
+	// last persisted fee rate.
+	if b.feeStart.FeeRate == 0 {
+		b.feeStart = b.rbfCache
+	}
+
+	feeFunction := b.cfg.feeFunction
+	if feeFunction == nil {
+		feeFunction = StepFeeFunction{Step: defaultFeeRateStep}
+	}
+
+	deadline := int32(math.MaxInt32)
+	for _, sweep := range b.sweeps {
+		if sweep.timeout < deadline {
+			deadline = sweep.timeout
+		}
+	}
+
+	feeRate := feeFunction.FeeRate(FeeParams{
+		StartFeeRate: b.feeStart.FeeRate,
+		StartHeight:  b.feeStart.LastHeight,
+		LastFeeRate:  b.rbfCache.FeeRate,
+		Height:       b.currentHeight,
+		Deadline:     deadline,
+		MaxFeeRate:   b.cfg.maxFeeRate,
+	})
+	if feeRate < b.rbfCache.FeeRate {
+		return b.rbfCache.FeeRate
+	}
+
+	return feeRate
+}
+
+// feeLimitReached returns true if the fee rate of the batch transaction may
+// not be bumped any further.
+func (b *batch) feeLimitReached() bool {
+	if b.cfg.maxFeeBumps != 0 && b.rbfCache.FeeBumps >= b.cfg.maxFeeBumps {
+		return true
+	}
+
+	return b.cfg.maxFeeRate != 0 && b.rbfCache.FeeRate >= b.cfg.maxFeeRate
+}
+
 // monitorSpend monitors the primary sweep's outpoint for spends. The reason we
 // monitor the primary sweep's outpoint is because the primary sweep was the
 // first sweep that entered this batch, therefore it is present in all the
@@ -1172,6 +1584,7 @@ func (b *batch) handleSpend(ctx context.Context, spendTx *wire.MsgTx) error {
 	)
 	b.batchTxid = &txHash
 	b.batchPkScript = spendTx.TxOut[0].PkScript
+	b.spendDetails = make(map[lntypes.Hash]*SpendDetail, len(b.sweeps))
 
 	// As a previous version of the batch transaction may get confirmed,
 	// which does not contain the latest sweeps, we need to detect the
@@ -1212,12 +1625,24 @@ func (b *batch) handleSpend(ctx context.Context, spendTx *wire.MsgTx) error {
 
 	for _, sweep := range notifyList {
 		sweep := sweep
+		b.sweepLog(sweep.swapHash).Infof("sweep spent by batch tx %v",
+			txHash)
+
 		// Save the sweep as completed.
 		err := b.persistSweep(ctx, sweep, true)
 		if err != nil {
 			return err
 		}
 
+		spendDetail := SpendDetail{
+			Tx: spendTx,
+			OnChainFeePortion: getFeePortionPaidBySweep(
+				spendTx, feePortionPaidPerSweep,
+				roundingDifference, &sweep,
+			),
+		}
+		b.spendDetails[sweep.swapHash] = &spendDetail
+
 		// If the sweep's notifier is empty then this means that a swap
 		// is not waiting to read an update from it, so we can skip
 		// the notification part.
@@ -1227,14 +1652,6 @@ func (b *batch) handleSpend(ctx context.Context, spendTx *wire.MsgTx) error {
 			continue
 		}
 
-		spendDetail := SpendDetail{
-			Tx: spendTx,
-			OnChainFeePortion: getFeePortionPaidBySweep(
-				spendTx, feePortionPaidPerSweep,
-				roundingDifference, &sweep,
-			),
-		}
-
 		// Dispatch the sweep notifier, we don't care about the outcome
 		// of this action so we don't wait for it.
 		go sweep.notifySweepSpend(ctx, &spendDetail)
@@ -1252,8 +1669,8 @@ func (b *batch) handleSpend(ctx context.Context, spendTx *wire.MsgTx) error {
 
 			err := b.purger(&sweep)
 			if err != nil {
-				b.log.Errorf("unable to purge sweep %x:  %v",
-					sweep.SwapHash[:6], err)
+				b.sweepLog(sweep.SwapHash).Errorf("unable to "+
+					"purge sweep: %v", err)
 			}
 		}
 	}()
@@ -1277,6 +1694,22 @@ func (b *batch) handleSpend(ctx context.Context, spendTx *wire.MsgTx) error {
 // batch. Here we signal to the batcher that this batch was completed.
 func (b *batch) handleConf(ctx context.Context) error {
 	b.log.Infof("confirmed")
+	for _, sweep := range b.sweeps {
+		sweep := sweep
+		b.sweepLog(sweep.swapHash).Infof("sweep confirmed")
+
+		// Let the swap know that its spend is final, if it waits for
+		// that.
+		spendDetail, ok := b.spendDetails[sweep.swapHash]
+		if !ok || sweep.notifier == nil ||
+			sweep.notifier.ConfChan == nil {
+
+			continue
+		}
+
+		go sweep.notifySweepConf(ctx, spendDetail)
+	}
+
 	b.state = Confirmed
 
 	return b.store.ConfirmBatch(ctx, b.id)
@@ -1312,6 +1745,7 @@ func (b *batch) persist(ctx context.Context) error {
 	bch.LastRbfHeight = b.rbfCache.LastHeight
 	bch.LastRbfSatPerKw = int32(b.rbfCache.FeeRate)
 	bch.MaxTimeoutDistance = b.cfg.maxTimeoutDistance
+	bch.FeeBumps = int32(b.rbfCache.FeeBumps)
 
 	return b.store.UpdateSweepBatch(ctx, bch)
 }
@@ -1376,6 +1810,37 @@ func (s *sweep) notifySweepSpend(ctx context.Context,
 	}
 }
 
+// notifySweepConf writes the spend details of the confirmed batch tx to the
+// sweep's notifier confirmation channel.
+func (s *sweep) notifySweepConf(ctx context.Context,
+	spendDetail *SpendDetail) {
+
+	select {
+	// Try to write the update to the confirmation channel.
+	case s.notifier.ConfChan <- spendDetail:
+
+	// If a quit signal was provided by the swap, continue.
+	case <-s.notifier.QuitChan:
+
+	// If the context was canceled, return.
+	case <-ctx.Done():
+	}
+}
+
+// notifyPublishErr writes the publish error to the sweep's notifier channel.
+func (s *sweep) notifyPublishErr(ctx context.Context, err error) {
+	select {
+	// Try to write the error to the notification channel.
+	case s.notifier.PublishErrChan <- err:
+
+	// If a quit signal was provided by the swap, continue.
+	case <-s.notifier.QuitChan:
+
+	// If the context was canceled, return.
+	case <-ctx.Done():
+	}
+}
+
 func (b *batch) writeToErrChan(err error) {
 	select {
 	case b.errChan <- err:
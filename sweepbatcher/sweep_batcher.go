@@ -2,6 +2,8 @@ package sweepbatcher
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -9,10 +11,12 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
@@ -130,14 +134,41 @@ type SpendNotifier struct {
 	// SpendErrChan is a channel where spend errors are received.
 	SpendErrChan chan error
 
+	// PublishErrChan is a channel where errors are received that keep
+	// the sweep from being published or its fee rate from being bumped,
+	// such as the repeated rejection of the batch tx from the mempool or
+	// ErrFeeLimitReached. The batch keeps retrying, so these errors don't
+	// end the notification. It may be nil.
+	PublishErrChan chan error
+
+	// ConfChan is a channel where the spend details are received again
+	// once the spending tx has batchConfHeight confirmations, after which
+	// the spend is considered final. If the spending tx is reorged out
+	// before, the new spend is sent to SpendChan first. It may be nil.
+	ConfChan chan *SpendDetail
+
 	// QuitChan is a channel that can be closed to stop the notifier.
 	QuitChan chan bool
 }
 
 var (
 	ErrBatcherShuttingDown = fmt.Errorf("batcher shutting down")
+
+	// ErrSweepNotFound is returned when a sweep isn't part of any pending
+	// batch.
+	ErrSweepNotFound = fmt.Errorf("sweep not found in a pending batch")
 )
 
+// feeBumpRequest is a request to bump the fee rate of the batch that sweeps a
+// swap.
+type feeBumpRequest struct {
+	swapHash lntypes.Hash
+	feeRate  chainfee.SatPerKWeight
+
+	// errChan receives the result of the fee bump.
+	errChan chan error
+}
+
 // Batcher is a system that is responsible for accepting sweep requests and
 // placing them in appropriate batches. It will spin up new batches as needed.
 type Batcher struct {
@@ -147,6 +178,10 @@ type Batcher struct {
 	// sweepReqs is a channel where sweep requests are received.
 	sweepReqs chan SweepRequest
 
+	// feeBumpReqs is a channel where manual fee bump requests are
+	// received.
+	feeBumpReqs chan feeBumpRequest
+
 	// errChan is a channel where errors are received.
 	errChan chan error
 
@@ -183,21 +218,93 @@ type Batcher struct {
 	// interacting with swaps.
 	swapStore loopdb.SwapStore
 
+	// maxFeeRate is the highest fee rate that batch transactions may be
+	// published with. A zero value doesn't limit the fee rate.
+	maxFeeRate chainfee.SatPerKWeight
+
+	// maxFeeBumps is the number of times that the fee rate of a batch
+	// transaction may be bumped. A zero value doesn't limit the bumps.
+	maxFeeBumps int
+
+	// publishDelay is the delay between receiving a new block and
+	// publishing a batch transaction, during which sweeps that become
+	// ready in the same block join the batch. A zero value uses the
+	// network's default delay.
+	publishDelay time.Duration
+
+	// feeFunction computes the fee rates of batch transactions that
+	// didn't confirm. If nil, the fee rate is bumped by a fixed step.
+	feeFunction FeeFunction
+
+	// staticFeeRate is the initial fee rate of batch transactions that
+	// is used instead of the wallet's estimate. A zero value uses the
+	// wallet's estimate.
+	staticFeeRate chainfee.SatPerKWeight
+
 	// wg is a waitgroup that is used to wait for all the goroutines to
 	// exit.
 	wg sync.WaitGroup
 }
 
+// BatcherOption is a functional option that configures the Batcher.
+type BatcherOption func(*Batcher)
+
+// WithMaxFeeRate limits the fee rate that batch transactions are published
+// with. Once the limit is reached, the fee rate is no longer bumped.
+func WithMaxFeeRate(feeRate chainfee.SatPerKWeight) BatcherOption {
+	return func(b *Batcher) {
+		b.maxFeeRate = feeRate
+	}
+}
+
+// WithMaxFeeBumps limits the number of times that the fee rate of a batch
+// transaction is bumped. Once the limit is reached, the batch transaction is
+// republished with the last fee rate.
+func WithMaxFeeBumps(maxFeeBumps int) BatcherOption {
+	return func(b *Batcher) {
+		b.maxFeeBumps = maxFeeBumps
+	}
+}
+
+// WithPublishDelay sets the delay between receiving a new block and
+// publishing a batch transaction. A longer delay lets more sweeps that become
+// ready around the same block join a single transaction.
+func WithPublishDelay(delay time.Duration) BatcherOption {
+	return func(b *Batcher) {
+		b.publishDelay = delay
+	}
+}
+
+// WithFeeFunction sets the function that computes the fee rate of a batch
+// transaction that didn't confirm. By default, the fee rate is bumped by a
+// fixed step per block.
+func WithFeeFunction(feeFunction FeeFunction) BatcherOption {
+	return func(b *Batcher) {
+		b.feeFunction = feeFunction
+	}
+}
+
+// WithStaticFeeRate sets a fixed fee rate that batch transactions are first
+// published with instead of the wallet's fee estimate. It gives deterministic
+// fees in integration tests and must only be used on regtest and simnet.
+func WithStaticFeeRate(feeRate chainfee.SatPerKWeight) BatcherOption {
+	return func(b *Batcher) {
+		b.staticFeeRate = feeRate
+	}
+}
+
 // NewBatcher creates a new Batcher instance.
 func NewBatcher(wallet lndclient.WalletKitClient,
 	chainNotifier lndclient.ChainNotifierClient,
 	signerClient lndclient.SignerClient, musig2ServerSigner MuSig2SignSweep,
 	verifySchnorrSig VerifySchnorrSig, chainparams *chaincfg.Params,
-	store BatcherStore, swapStore loopdb.SwapStore) *Batcher {
+	store BatcherStore, swapStore loopdb.SwapStore,
+	opts ...BatcherOption) *Batcher {
 
-	return &Batcher{
+	batcher := &Batcher{
 		batches:          make(map[int32]*batch),
 		sweepReqs:        make(chan SweepRequest),
+		feeBumpReqs:      make(chan feeBumpRequest),
 		errChan:          make(chan error, 1),
 		quit:             make(chan struct{}),
 		wallet:           wallet,
@@ -209,6 +316,12 @@ func NewBatcher(wallet lndclient.WalletKitClient,
 		store:            store,
 		swapStore:        swapStore,
 	}
+
+	for _, opt := range opts {
+		opt(batcher)
+	}
+
+	return batcher
 }
 
 // Run starts the batcher and processes incoming sweep requests.
@@ -251,6 +364,9 @@ func (b *Batcher) Run(ctx context.Context) error {
 				return err
 			}
 
+		case req := <-b.feeBumpReqs:
+			req.errChan <- b.handleFeeBump(runCtx, req)
+
 		case err := <-b.errChan:
 			return err
 
@@ -272,6 +388,82 @@ func (b *Batcher) AddSweep(sweepReq *SweepRequest) error {
 	}
 }
 
+// BumpSweepFee raises the fee rate of the batch transaction that sweeps the
+// swap with the given hash and republishes it right away. The fee rate must
+// be higher than the batch's current fee rate and must not exceed the max fee
+// rate. As the batch transaction spends all sweeps of the batch, the other
+// sweeps of the batch benefit from the bump too.
+func (b *Batcher) BumpSweepFee(ctx context.Context, swapHash lntypes.Hash,
+	feeRate chainfee.SatPerKWeight) error {
+
+	req := feeBumpRequest{
+		swapHash: swapHash,
+		feeRate:  feeRate,
+		errChan:  make(chan error, 1),
+	}
+
+	select {
+	case b.feeBumpReqs <- req:
+
+	case <-b.quit:
+		return ErrBatcherShuttingDown
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-req.errChan:
+		return err
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SweepTxid returns the txid of the confirmed batch transaction that swept the
+// swap with the given hash. It returns nil if the sweep of the swap hasn't
+// been confirmed yet or if the swap wasn't swept by the batcher.
+func (b *Batcher) SweepTxid(ctx context.Context, swapHash lntypes.Hash) (
+	*chainhash.Hash, error) {
+
+	completed, err := b.store.GetSweepStatus(ctx, swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !completed {
+		return nil, nil
+	}
+
+	parentBatch, err := b.store.GetParentBatch(ctx, swapHash)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &parentBatch.BatchTxid, nil
+}
+
+// handleFeeBump bumps the fee rate of the batch that contains the requested
+// sweep.
+func (b *Batcher) handleFeeBump(ctx context.Context,
+	req feeBumpRequest) error {
+
+	for _, batch := range b.batches {
+		if !batch.sweepExists(req.swapHash) {
+			continue
+		}
+
+		return batch.bumpFee(ctx, req.feeRate)
+	}
+
+	return ErrSweepNotFound
+}
+
 // handleSweep handles a sweep request by either placing it in an existing
 // batch, or by spinning up a new batch for it.
 func (b *Batcher) handleSweep(ctx context.Context, sweep *sweep,
@@ -282,8 +474,8 @@ func (b *Batcher) handleSweep(ctx context.Context, sweep *sweep,
 		return err
 	}
 
-	log.Infof("Batcher handling sweep %x, completed=%v", sweep.swapHash[:6],
-		completed)
+	sweepPrefixLogger(sweep.swapHash, log).Infof("Batcher handling "+
+		"sweep, completed=%v", completed)
 
 	// If the sweep has already been completed in a confirmed batch then we
 	// can't attach its notifier to the batch as that is no longer running.
@@ -360,10 +552,17 @@ func (b *Batcher) spinUpBatch(ctx context.Context) (*batch, error) {
 	cfg := batchConfig{
 		maxTimeoutDistance: defaultMaxTimeoutDistance,
 		batchConfTarget:    defaultBatchConfTarget,
+		maxFeeRate:         b.maxFeeRate,
+		maxFeeBumps:        b.maxFeeBumps,
+		feeFunction:        b.feeFunction,
+		staticFeeRate:      b.staticFeeRate,
 	}
 
-	switch b.chainParams {
-	case &chaincfg.MainNetParams:
+	switch {
+	case b.publishDelay != 0:
+		cfg.batchPublishDelay = b.publishDelay
+
+	case b.chainParams == &chaincfg.MainNetParams:
 		cfg.batchPublishDelay = defaultMainnetPublishDelay
 
 	default:
@@ -410,11 +609,17 @@ func (b *Batcher) spinUpBatchFromDB(ctx context.Context, batch *batch) error {
 	cfg := batchConfig{
 		maxTimeoutDistance: batch.cfg.maxTimeoutDistance,
 		batchConfTarget:    defaultBatchConfTarget,
+		maxFeeRate:         b.maxFeeRate,
+		maxFeeBumps:        b.maxFeeBumps,
+		batchPublishDelay:  b.publishDelay,
+		feeFunction:        b.feeFunction,
+		staticFeeRate:      b.staticFeeRate,
 	}
 
 	rbfCache := rbfCache{
 		LastHeight: batch.rbfCache.LastHeight,
 		FeeRate:    batch.rbfCache.FeeRate,
+		FeeBumps:   batch.rbfCache.FeeBumps,
 	}
 
 	dbSweeps, err := b.store.FetchBatchSweeps(ctx, batch.id)
@@ -510,6 +715,7 @@ func (b *Batcher) FetchUnconfirmedBatches(ctx context.Context) ([]*batch,
 		rbfCache := rbfCache{
 			LastHeight: bch.LastRbfHeight,
 			FeeRate:    chainfee.SatPerKWeight(bch.LastRbfSatPerKw),
+			FeeBumps:   int(bch.FeeBumps),
 		}
 		batch.rbfCache = rbfCache
 
@@ -529,9 +735,6 @@ func (b *Batcher) FetchUnconfirmedBatches(ctx context.Context) ([]*batch,
 func (b *Batcher) monitorSpendAndNotify(ctx context.Context, sweep *sweep,
 	notifier *SpendNotifier) error {
 
-	spendCtx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
 	// First get the batch that completed the sweep.
 	parentBatch, err := b.store.GetParentBatch(ctx, sweep.swapHash)
 	if err != nil {
@@ -544,19 +747,32 @@ func (b *Batcher) monitorSpendAndNotify(ctx context.Context, sweep *sweep,
 		return err
 	}
 
+	// The registrations are canceled once the goroutine below is done
+	// monitoring.
+	spendCtx, cancel := context.WithCancel(ctx)
+
 	spendChan, spendErr, err := b.chainNotifier.RegisterSpendNtfn(
 		spendCtx, &sweep.outpoint, sweep.htlc.PkScript,
 		sweep.initiationHeight,
 	)
 	if err != nil {
+		cancel()
 		return err
 	}
 
 	b.wg.Add(1)
 	go func() {
+		defer cancel()
 		defer b.wg.Done()
-		log.Infof("Batcher monitoring spend for swap %x",
-			sweep.swapHash[:6])
+		sweepPrefixLogger(sweep.swapHash, log).Infof("Batcher " +
+			"monitoring spend for swap")
+
+		var (
+			spendDetail *SpendDetail
+			confChan    chan *chainntnfs.TxConfirmation
+			confErr     chan error
+			reorgChan   chan struct{}
+		)
 
 		for {
 			select {
@@ -573,7 +789,7 @@ func (b *Batcher) monitorSpendAndNotify(ctx context.Context, sweep *sweep,
 				// Notify the requester of the spend
 				// with the spend details, including the fee
 				// portion for this particular sweep.
-				spendDetail := &SpendDetail{
+				spendDetail = &SpendDetail{
 					Tx: spendTx,
 					OnChainFeePortion: getFeePortionPaidBySweep( // nolint:lll
 						spendTx, feePortionPerSweep,
@@ -586,8 +802,56 @@ func (b *Batcher) monitorSpendAndNotify(ctx context.Context, sweep *sweep,
 				case <-ctx.Done():
 				}
 
+				if notifier.ConfChan == nil {
+					return
+				}
+
+				// The requester also waits for the spend to
+				// be final, so we watch the confirmations of
+				// the spending tx like the batch does.
+				txHash := spendTx.TxHash()
+				reorgChan = make(chan struct{}, 1)
+				confChan, confErr, err = b.chainNotifier.RegisterConfirmationsNtfn( // nolint:lll
+					spendCtx, &txHash,
+					spendTx.TxOut[0].PkScript,
+					batchConfHeight, spend.SpendingHeight,
+					lndclient.WithReOrgChan(reorgChan),
+				)
+				if err != nil {
+					_ = b.writeToErrChan(ctx, err)
+					return
+				}
+
+			case <-confChan:
+				select {
+				case notifier.ConfChan <- spendDetail:
+				case <-ctx.Done():
+				}
+
+				return
+
+			case err := <-confErr:
+				_ = b.writeToErrChan(ctx, err)
 				return
 
+			// The spending tx was reorged out. The batch publishes
+			// the sweep again, so we wait for the new spend.
+			case <-reorgChan:
+				sweepPrefixLogger(sweep.swapHash, log).Infof(
+					"Spend of sweep reorged out",
+				)
+
+				confChan, confErr, reorgChan = nil, nil, nil
+				spendChan, spendErr, err = b.chainNotifier.RegisterSpendNtfn( // nolint:lll
+					spendCtx, &sweep.outpoint,
+					sweep.htlc.PkScript,
+					sweep.initiationHeight,
+				)
+				if err != nil {
+					_ = b.writeToErrChan(ctx, err)
+					return
+				}
+
 			case err := <-spendErr:
 				select {
 				case notifier.SpendErrChan <- err:
@@ -653,6 +917,7 @@ func (b *Batcher) convertSweep(dbSweep *dbSweep) (*sweep, error) {
 		protocolVersion:        swap.Contract.ProtocolVersion,
 		isExternalAddr:         swap.Contract.IsExternalAddr,
 		destAddr:               swap.Contract.DestAddr,
+		feeRate:                swap.Contract.SweepFeeRate,
 	}, nil
 }
 
@@ -700,5 +965,6 @@ func (b *Batcher) fetchSweep(ctx context.Context,
 		protocolVersion:        swap.Contract.ProtocolVersion,
 		isExternalAddr:         swap.Contract.IsExternalAddr,
 		destAddr:               swap.Contract.DestAddr,
+		feeRate:                swap.Contract.SweepFeeRate,
 	}, nil
 }
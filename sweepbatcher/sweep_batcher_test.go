@@ -2,6 +2,8 @@ package sweepbatcher
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -13,7 +15,11 @@ import (
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -984,3 +990,414 @@ func TestSweepBatcherComposite(t *testing.T) {
 	require.True(t, batcherStore.AssertSweepStored(sweepReq5.SwapHash))
 	require.True(t, batcherStore.AssertSweepStored(sweepReq6.SwapHash))
 }
+
+// TestIsFeeRejection tests that mempool rejections because of a too low fee
+// are told apart from other publish errors, including rejected replacements
+// of a previously published batch tx.
+func TestIsFeeRejection(t *testing.T) {
+	require.True(t, isFeeRejection(fmt.Errorf(
+		"%w: min relay fee not met", lnwallet.ErrMempoolFee,
+	), false))
+	require.True(t, isFeeRejection(status.Error(
+		codes.Unknown, lnwallet.ErrMempoolFee.Error()+
+			": min relay fee not met",
+	), false))
+	require.False(t, isFeeRejection(status.Error(
+		codes.Unknown, "bad-txns-inputs-missingorspent",
+	), true))
+
+	// Only the messages of RPC errors without a status code are matched,
+	// as those are the publish errors that lnd returns.
+	require.False(t, isFeeRejection(status.Error(
+		codes.Unavailable, lnwallet.ErrMempoolFee.Error(),
+	), false))
+	require.False(t, isFeeRejection(
+		errors.New(lnwallet.ErrMempoolFee.Error()), false,
+	))
+
+	// lnd reports a replacement that doesn't pay enough fee as a double
+	// spend, which is only a fee rejection if the batch tx replaces a
+	// previous version.
+	require.True(t, isFeeRejection(lnwallet.ErrDoubleSpend, true))
+	require.True(t, isFeeRejection(status.Error(
+		codes.Unknown, lnwallet.ErrDoubleSpend.Error(),
+	), true))
+	require.True(t, isFeeRejection(status.Error(
+		codes.Unknown, "insufficient fee, rejecting replacement",
+	), true))
+	require.False(t, isFeeRejection(lnwallet.ErrDoubleSpend, false))
+	require.False(t, isFeeRejection(status.Error(
+		codes.Unknown, "insufficient fee, rejecting replacement",
+	), false))
+}
+
+// TestNotifySweepsPublishErr tests that publish errors of a batch are reported
+// to the swaps of its sweeps that listen for them.
+func TestNotifySweepsPublishErr(t *testing.T) {
+	publishErrChan := make(chan error, 1)
+	b := &batch{
+		sweeps: map[lntypes.Hash]sweep{
+			{1}: {
+				notifier: &SpendNotifier{
+					PublishErrChan: publishErrChan,
+				},
+			},
+			{2}: {
+				notifier: &SpendNotifier{},
+			},
+			{3}: {},
+		},
+	}
+
+	b.notifySweepsPublishErr(context.Background(), lnwallet.ErrMempoolFee)
+
+	select {
+	case err := <-publishErrChan:
+		require.ErrorIs(t, err, lnwallet.ErrMempoolFee)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("publish error not reported")
+	}
+}
+
+// TestHandleConfNotifiesSweeps tests that the swaps of a batch that wait for
+// the confirmation of their spend are notified once the batch confirmed.
+func TestHandleConfNotifiesSweeps(t *testing.T) {
+	ctx := context.Background()
+	store := NewStoreMock()
+
+	id, err := store.InsertSweepBatch(ctx, &dbBatch{})
+	require.NoError(t, err)
+
+	spendDetail := &SpendDetail{
+		Tx:                wire.NewMsgTx(2),
+		OnChainFeePortion: 100,
+	}
+
+	confChan := make(chan *SpendDetail, 1)
+	b := &batch{
+		id: id,
+		sweeps: map[lntypes.Hash]sweep{
+			{1}: {
+				swapHash: lntypes.Hash{1},
+				notifier: &SpendNotifier{
+					ConfChan: confChan,
+				},
+			},
+			{2}: {
+				swapHash: lntypes.Hash{2},
+				notifier: &SpendNotifier{},
+			},
+		},
+		spendDetails: map[lntypes.Hash]*SpendDetail{
+			{1}: spendDetail,
+			{2}: spendDetail,
+		},
+		store: store,
+		log:   batchPrefixLogger("test"),
+	}
+
+	require.NoError(t, b.handleConf(ctx))
+	require.Equal(t, Confirmed, b.state)
+
+	select {
+	case detail := <-confChan:
+		require.Equal(t, spendDetail, detail)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("confirmation not reported")
+	}
+}
+
+// TestUpdateRbfRateLimits tests that the fee rate of a batch stops being
+// bumped once the configured fee limits are reached.
+func TestUpdateRbfRateLimits(t *testing.T) {
+	ctx := context.Background()
+
+	newBatch := func(cfg *batchConfig) *batch {
+		return &batch{
+			cfg: cfg,
+			rbfCache: rbfCache{
+				FeeRate: 1000,
+			},
+			store: NewStoreMock(),
+			log:   batchPrefixLogger("test"),
+		}
+	}
+
+	// Without limits, the fee rate is bumped on every update.
+	b := newBatch(&batchConfig{})
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.updateRbfRate(ctx))
+	}
+	require.Equal(t, 1000+3*defaultFeeRateStep, b.rbfCache.FeeRate)
+
+	// The number of bumps is limited.
+	b = newBatch(&batchConfig{
+		maxFeeBumps: 2,
+	})
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.updateRbfRate(ctx))
+	}
+	require.Equal(t, 1000+2*defaultFeeRateStep, b.rbfCache.FeeRate)
+
+	// The fee rate is capped at the maximum.
+	maxFeeRate := 1000 + defaultFeeRateStep/2
+	b = newBatch(&batchConfig{
+		maxFeeRate: maxFeeRate,
+	})
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.updateRbfRate(ctx))
+	}
+	require.Equal(t, maxFeeRate, b.rbfCache.FeeRate)
+
+	// Updates that don't raise the fee rate are not counted as bumps.
+	b = newBatch(&batchConfig{
+		maxFeeBumps: 2,
+		maxFeeRate:  5000,
+		feeFunction: LinearFeeFunction{},
+	})
+	b.currentHeight = 100
+	b.rbfCache.LastHeight = 100
+	b.feeStart = b.rbfCache
+	b.sweeps = map[lntypes.Hash]sweep{
+		{1}: {timeout: 200},
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.updateRbfRate(ctx))
+	}
+	require.Equal(t, chainfee.SatPerKWeight(1000), b.rbfCache.FeeRate)
+	require.Zero(t, b.rbfCache.FeeBumps)
+}
+
+// TestUpdateRbfRateRestoredFeeBumps tests that the fee bumps of a batch are
+// persisted, so that a batch restored at its limit isn't bumped any further,
+// and that the swaps of the batch are told about the limit once.
+func TestUpdateRbfRateRestoredFeeBumps(t *testing.T) {
+	ctx := context.Background()
+	store := NewStoreMock()
+
+	publishErrChan := make(chan error, 1)
+	b := &batch{
+		cfg: &batchConfig{
+			maxFeeBumps: 2,
+		},
+		rbfCache: rbfCache{
+			FeeRate:  1000,
+			FeeBumps: 2,
+		},
+		sweeps: map[lntypes.Hash]sweep{
+			{1}: {
+				notifier: &SpendNotifier{
+					PublishErrChan: publishErrChan,
+				},
+			},
+		},
+		store: store,
+		log:   batchPrefixLogger("test"),
+	}
+
+	require.NoError(t, b.updateRbfRate(ctx))
+	require.Equal(t, chainfee.SatPerKWeight(1000), b.rbfCache.FeeRate)
+	require.EqualValues(t, 2, store.batches[b.id].FeeBumps)
+
+	select {
+	case err := <-publishErrChan:
+		require.ErrorIs(t, err, ErrFeeLimitReached)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("fee limit not reported")
+	}
+
+	// The limit is only reported once.
+	require.NoError(t, b.updateRbfRate(ctx))
+	select {
+	case err := <-publishErrChan:
+		t.Fatalf("unexpected publish error: %v", err)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestBumpRejectedFeeRate tests that the fee rate of a rejected batch tx is
+// raised by at least the incremental relay fee, regardless of the fee
+// function, and that the fee limits still apply.
+func TestBumpRejectedFeeRate(t *testing.T) {
+	ctx := context.Background()
+
+	newBatch := func(cfg *batchConfig) *batch {
+		b := &batch{
+			cfg: cfg,
+			rbfCache: rbfCache{
+				FeeRate:    1000,
+				LastHeight: 100,
+			},
+			currentHeight: 100,
+			sweeps: map[lntypes.Hash]sweep{
+				{1}: {timeout: 200},
+			},
+			store: NewStoreMock(),
+			log:   batchPrefixLogger("test"),
+		}
+		b.feeStart = b.rbfCache
+
+		return b
+	}
+
+	// The default step is below the incremental relay fee.
+	b := newBatch(&batchConfig{})
+	require.NoError(t, b.bumpRejectedFeeRate(ctx))
+	require.Equal(t, 1000+minRejectionFeeRateIncrement, b.rbfCache.FeeRate)
+	require.Equal(t, 1, b.rbfCache.FeeBumps)
+
+	// A fee function that depends on the height doesn't raise the fee
+	// rate within the same block.
+	b = newBatch(&batchConfig{
+		maxFeeRate:  5000,
+		feeFunction: LinearFeeFunction{},
+	})
+	require.NoError(t, b.bumpRejectedFeeRate(ctx))
+	require.Equal(t, 1000+minRejectionFeeRateIncrement, b.rbfCache.FeeRate)
+	require.Equal(t, 1, b.rbfCache.FeeBumps)
+
+	// The fee rate is capped at the maximum.
+	b = newBatch(&batchConfig{
+		maxFeeRate: 1100,
+	})
+	require.NoError(t, b.bumpRejectedFeeRate(ctx))
+	require.Equal(t, chainfee.SatPerKWeight(1100), b.rbfCache.FeeRate)
+
+	// Once the fee bumps are used up, the fee rate isn't raised.
+	b = newBatch(&batchConfig{
+		maxFeeBumps: 1,
+	})
+	b.rbfCache.FeeBumps = 1
+	require.NoError(t, b.bumpRejectedFeeRate(ctx))
+	require.Equal(t, chainfee.SatPerKWeight(1000), b.rbfCache.FeeRate)
+}
+
+// TestUpdateRbfRateSweepFeeRate tests that the fee rate requested by a sweep
+// is used as the initial fee rate of the batch and as a floor for later bumps.
+func TestUpdateRbfRateSweepFeeRate(t *testing.T) {
+	ctx := context.Background()
+
+	b := &batch{
+		cfg: &batchConfig{},
+		sweeps: map[lntypes.Hash]sweep{
+			{1}: {feeRate: 3000},
+		},
+		store: NewStoreMock(),
+		log:   batchPrefixLogger("test"),
+	}
+
+	// The requested fee rate is used instead of the wallet's estimate.
+	require.NoError(t, b.updateRbfRate(ctx))
+	require.Equal(t, chainfee.SatPerKWeight(3000), b.rbfCache.FeeRate)
+
+	// Without a confirmation, the fee rate is bumped as usual.
+	require.NoError(t, b.updateRbfRate(ctx))
+	require.Equal(t, 3000+defaultFeeRateStep, b.rbfCache.FeeRate)
+
+	// A sweep asking for a higher fee rate raises the batch's fee rate.
+	b.sweeps[lntypes.Hash{2}] = sweep{feeRate: 10000}
+	require.NoError(t, b.updateRbfRate(ctx))
+	require.Equal(t, chainfee.SatPerKWeight(10000), b.rbfCache.FeeRate)
+}
+
+// TestUpdateRbfRateStaticFeeRate tests that a static fee rate is used as the
+// initial fee rate of a batch instead of the wallet's estimate, unless a sweep
+// asks for a fee rate.
+func TestUpdateRbfRateStaticFeeRate(t *testing.T) {
+	ctx := context.Background()
+
+	b := &batch{
+		cfg: &batchConfig{
+			staticFeeRate: 2000,
+		},
+		sweeps: map[lntypes.Hash]sweep{
+			{1}: {},
+		},
+		store: NewStoreMock(),
+		log:   batchPrefixLogger("test"),
+	}
+
+	// The batch has no wallet, so the static fee rate must be used.
+	require.NoError(t, b.updateRbfRate(ctx))
+	require.Equal(t, chainfee.SatPerKWeight(2000), b.rbfCache.FeeRate)
+
+	// A fee rate requested by a sweep takes precedence.
+	b.rbfCache = rbfCache{}
+	b.sweeps[lntypes.Hash{1}] = sweep{feeRate: 3000}
+	require.NoError(t, b.updateRbfRate(ctx))
+	require.Equal(t, chainfee.SatPerKWeight(3000), b.rbfCache.FeeRate)
+}
+
+// TestBumpSweepFee tests that the fee rate of a pending sweep's batch can be
+// bumped manually within the fee limits.
+func TestBumpSweepFee(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := loopdb.NewStoreMock(t)
+
+	batcherStore := NewStoreMock()
+
+	batcher := NewBatcher(lnd.WalletKit, lnd.ChainNotifier, lnd.Signer,
+		testMuSig2SignSweep, nil, lnd.ChainParams, batcherStore, store,
+		WithMaxFeeRate(5000))
+	go func() {
+		err := batcher.Run(ctx)
+		if !strings.Contains(err.Error(), "context canceled") {
+			require.NoError(t, err)
+		}
+	}()
+
+	sweepReq := SweepRequest{
+		SwapHash: lntypes.Hash{1, 1, 1},
+		Value:    111,
+		Outpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1, 1},
+			Index: 1,
+		},
+		Notifier: &dummyNotifier,
+	}
+
+	swap := &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			CltvExpiry:      111,
+			AmountRequested: 111,
+		},
+		SwapInvoice:     swapInvoice,
+		SweepConfTarget: 111,
+	}
+
+	err := store.CreateLoopOut(ctx, sweepReq.SwapHash, swap)
+	require.NoError(t, err)
+	store.AssertLoopOutStored()
+
+	batcher.sweepReqs <- sweepReq
+
+	// Once the batch is created, it monitors the primary sweep's spend.
+	<-lnd.RegisterSpendChannel
+
+	// A sweep that isn't batched can't be bumped.
+	err = batcher.BumpSweepFee(ctx, lntypes.Hash{2}, 2000)
+	require.ErrorIs(t, err, ErrSweepNotFound)
+
+	// The batch wasn't published yet, so the bump only sets the fee rate
+	// that the batch starts from.
+	require.NoError(t, batcher.BumpSweepFee(ctx, sweepReq.SwapHash, 2000))
+
+	for _, batch := range batcher.batches {
+		require.Equal(
+			t, chainfee.SatPerKWeight(2000), batch.rbfCache.FeeRate,
+		)
+	}
+
+	// The fee rate must rise and must not exceed the max fee rate.
+	require.Error(t, batcher.BumpSweepFee(ctx, sweepReq.SwapHash, 2000))
+	require.Error(t, batcher.BumpSweepFee(ctx, sweepReq.SwapHash, 6000))
+}
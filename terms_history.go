@@ -0,0 +1,114 @@
+package loop
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// defaultTermsHistorySize is the default number of loop out terms snapshots
+// that are kept in the terms history.
+const defaultTermsHistorySize = 100
+
+// TermsSnapshot is a set of loop out terms that was observed from the server,
+// along with the time at which the terms were first fetched.
+type TermsSnapshot struct {
+	LoopOutTerms
+
+	// Time is the time at which the terms were first fetched.
+	Time time.Time
+}
+
+// termsHistory keeps the loop out terms that were observed from the server in
+// the store. A new snapshot is only added when the terms changed since the
+// last snapshot, so the history shows when and how the server's terms
+// changed. Once the history holds the maximum number of snapshots, the oldest
+// snapshot is dropped. A nil history doesn't record any terms.
+type termsHistory struct {
+	store loopdb.SwapStore
+
+	size int
+
+	// last holds the terms that were recorded last, so that unchanged
+	// terms don't need to be compared with the store.
+	last *LoopOutTerms
+
+	mu sync.Mutex
+}
+
+// newTermsHistory returns a terms history that keeps up to size snapshots in
+// the store.
+func newTermsHistory(store loopdb.SwapStore, size int) *termsHistory {
+	return &termsHistory{
+		store: store,
+		size:  size,
+	}
+}
+
+// add records the terms if they differ from the most recent snapshot.
+func (h *termsHistory) add(ctx context.Context, terms *LoopOutTerms,
+	now time.Time) error {
+
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.last != nil && *h.last == *terms {
+		return nil
+	}
+
+	added, err := h.store.AddTermsSnapshot(ctx, &loopdb.TermsSnapshot{
+		Time:            now,
+		MinSwapAmount:   terms.MinSwapAmount,
+		MaxSwapAmount:   terms.MaxSwapAmount,
+		MinCltvDelta:    terms.MinCltvDelta,
+		MaxCltvDelta:    terms.MaxCltvDelta,
+		AmountIncrement: terms.AmountIncrement,
+	}, h.size)
+	if err != nil {
+		return err
+	}
+
+	if added && h.last != nil {
+		log.Infof("Loop out terms changed from %+v to %+v", *h.last,
+			*terms)
+	}
+
+	last := *terms
+	h.last = &last
+
+	return nil
+}
+
+// history returns the stored snapshots, oldest first.
+func (h *termsHistory) history(ctx context.Context) ([]TermsSnapshot, error) {
+	if h == nil {
+		return nil, nil
+	}
+
+	stored, err := h.store.FetchTermsSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]TermsSnapshot, len(stored))
+	for i, snapshot := range stored {
+		snapshots[i] = TermsSnapshot{
+			LoopOutTerms: LoopOutTerms{
+				MinSwapAmount:   snapshot.MinSwapAmount,
+				MaxSwapAmount:   snapshot.MaxSwapAmount,
+				MinCltvDelta:    snapshot.MinCltvDelta,
+				MaxCltvDelta:    snapshot.MaxCltvDelta,
+				AmountIncrement: snapshot.AmountIncrement,
+			},
+			Time: snapshot.Time,
+		}
+	}
+
+	return snapshots, nil
+}
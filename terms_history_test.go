@@ -0,0 +1,64 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTermsHistory tests that only changed terms are added to the history,
+// that the oldest snapshots are dropped once the history is full and that a
+// new history continues with the stored snapshots.
+func TestTermsHistory(t *testing.T) {
+	ctx := context.Background()
+	store := loopdb.NewStoreMock(t)
+
+	history := newTermsHistory(store, 2)
+	snapshots, err := history.history(ctx)
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	start := time.Unix(1000, 0)
+	terms := &LoopOutTerms{
+		MinSwapAmount: 10000,
+		MaxSwapAmount: 100000,
+	}
+
+	require.NoError(t, history.add(ctx, terms, start))
+	require.NoError(t, history.add(ctx, terms, start.Add(time.Minute)))
+
+	snapshots, err = history.history(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []TermsSnapshot{
+		{LoopOutTerms: *terms, Time: start},
+	}, snapshots)
+
+	changed := *terms
+	changed.MaxSwapAmount = 200000
+	require.NoError(t, history.add(ctx, &changed, start.Add(2*time.Minute)))
+
+	// After a restart, unchanged terms aren't added again.
+	history = newTermsHistory(store, 2)
+	require.NoError(t, history.add(ctx, &changed, start.Add(3*time.Minute)))
+
+	reverted := *terms
+	require.NoError(t, history.add(ctx, &reverted, start.Add(4*time.Minute)))
+
+	snapshots, err = history.history(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []TermsSnapshot{
+		{LoopOutTerms: changed, Time: start.Add(2 * time.Minute)},
+		{LoopOutTerms: *terms, Time: start.Add(4 * time.Minute)},
+	}, snapshots)
+
+	// A client without a history doesn't record terms.
+	var none *termsHistory
+	require.NoError(t, none.add(ctx, terms, start))
+
+	snapshots, err = none.history(ctx)
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+}
@@ -2,8 +2,10 @@ package test
 
 import (
 	"bytes"
+	"reflect"
 	"sync"
 	"time"
+	"unsafe"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -33,6 +35,39 @@ type ConfRegistration struct {
 	HeightHint int32
 	NumConfs   int32
 	ConfChan   chan *chainntnfs.TxConfirmation
+
+	// ReOrgChan is the channel that the registration asked to be notified
+	// on if the tx is reorged out, with lndclient.WithReOrgChan. Tests
+	// send on it to simulate a reorg. It is nil if no reorg channel was
+	// set.
+	ReOrgChan chan struct{}
+}
+
+// reOrgChan returns the channel that the lndclient.WithReOrgChan option sets,
+// or nil if none of the options sets one. lndclient doesn't expose the
+// options, so each option is applied to a new options value that is read with
+// reflection.
+func reOrgChan(opts []lndclient.NotifierOption) chan struct{} {
+	for _, opt := range opts {
+		options := reflect.New(reflect.TypeOf(opt).In(0).Elem())
+		reflect.ValueOf(opt).Call([]reflect.Value{options})
+
+		field := options.Elem().FieldByName("reOrgChan")
+		if !field.IsValid() {
+			continue
+		}
+
+		// The field is unexported, so it is read through its address.
+		reOrgChan := reflect.NewAt(
+			field.Type(), unsafe.Pointer(field.UnsafeAddr()),
+		).Elem().Interface().(chan struct{})
+
+		if reOrgChan != nil {
+			return reOrgChan
+		}
+	}
+
+	return nil
 }
 
 func (c *mockChainNotifier) RegisterSpendNtfn(ctx context.Context,
@@ -123,6 +158,7 @@ func (c *mockChainNotifier) RegisterConfirmationsNtfn(ctx context.Context,
 		HeightHint: heightHint,
 		NumConfs:   numConfs,
 		ConfChan:   make(chan *chainntnfs.TxConfirmation, 1),
+		ReOrgChan:  reOrgChan(opts),
 	}
 
 	c.Lock()
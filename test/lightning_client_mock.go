@@ -250,6 +250,30 @@ func (h *mockLightningClient) ClosedChannels(_ context.Context) ([]lndclient.Clo
 	return h.lnd.ClosedChannels, nil
 }
 
+// SubscribeChannelEvents returns the mock's channel event channel.
+func (h *mockLightningClient) SubscribeChannelEvents(_ context.Context) (
+	<-chan *lndclient.ChannelEventUpdate, <-chan error, error) {
+
+	return h.lnd.ChannelEventChannel, make(chan error), nil
+}
+
+// QueryRoutes records the request and returns an empty route, or the mock's
+// query routes error if it is set.
+func (h *mockLightningClient) QueryRoutes(_ context.Context,
+	req lndclient.QueryRoutesRequest) (*lndclient.QueryRoutesResponse,
+	error) {
+
+	h.lnd.lock.Lock()
+	h.lnd.QueryRoutesRequests = append(h.lnd.QueryRoutesRequests, req)
+	h.lnd.lock.Unlock()
+
+	if h.lnd.QueryRoutesErr != nil {
+		return nil, h.lnd.QueryRoutesErr
+	}
+
+	return &lndclient.QueryRoutesResponse{}, nil
+}
+
 // ForwardingHistory returns the mock's set of forwarding events.
 func (h *mockLightningClient) ForwardingHistory(_ context.Context,
 	_ lndclient.ForwardingHistoryRequest) (*lndclient.ForwardingHistoryResponse,
@@ -62,6 +62,8 @@ func NewMockLnd() *LndMockServices {
 
 		SignOutputRawChannel: make(chan SignOutputRawRequest),
 
+		ChannelEventChannel: make(chan *lndclient.ChannelEventUpdate),
+
 		FailInvoiceChannel:   make(chan lntypes.Hash, 2),
 		blockHeightListeners: make([]chan int32, 0),
 		Height:               testStartingHeight,
@@ -151,6 +153,8 @@ type LndMockServices struct {
 
 	SignOutputRawChannel chan SignOutputRawRequest
 
+	ChannelEventChannel chan *lndclient.ChannelEventUpdate
+
 	Height       int32
 	NodePubkey   string
 	Signature    []byte
@@ -171,6 +175,12 @@ type LndMockServices struct {
 	Payments            []lndclient.Payment
 	MissionControlState []lndclient.MissionControlEntry
 
+	// QueryRoutesErr is returned by QueryRoutes if set.
+	QueryRoutesErr error
+
+	// QueryRoutesRequests records the requests of QueryRoutes.
+	QueryRoutesRequests []lndclient.QueryRoutesRequest
+
 	WaitForFinished func()
 
 	lock sync.Mutex
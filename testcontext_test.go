@@ -103,6 +103,13 @@ func newSwapClient(config *clientConfig) *Client {
 		sweeper:      sweeper,
 		executor:     executor,
 		resumeReady:  make(chan struct{}),
+		abandonChans: make(map[lntypes.Hash]chan struct{}),
+		abandoned:    make(map[lntypes.Hash]struct{}),
+		termsHistory: newTermsHistory(
+			config.Store, defaultTermsHistorySize,
+		),
+		liquidity:     newLiquidityTracker(lndServices),
+		subscriptions: newSwapSubscriptions(),
 	}
 }
 